@@ -5,18 +5,22 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	_ "embed"
 	_ "radgifa/docs"
+	"radgifa/internal/config"
+	"radgifa/internal/lifecycle"
 	"radgifa/internal/server"
+	"radgifa/internal/telemetry"
 )
 
 // @title Radgifa API
 // @version 0.1.0
-// @description API for group decision making - Struggling to reach a decision with your friends? Radgifa is here to help you make an informed choice.
+// @description API for group decision making - Struggling to reach a decision with your friends? Radgifa is here to help you make an informed choice. Errors are RFC 7807 problem details (application/problem+json) with a stable code.
 // @termsOfService http://swagger.io/terms/
 
 // @contact.name API Support
@@ -50,7 +54,7 @@ var (
 	finalStartupMessage = fmt.Sprintf("%s\n%s", coloredBanner, startupText)
 )
 
-func gracefulShutdown(apiServer *server.Server, done chan bool) {
+func gracefulShutdown(components *lifecycle.Manager, timeout time.Duration, done chan bool) {
 	// Create context that listens for the interrupt signal from the OS.
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -61,17 +65,16 @@ func gracefulShutdown(apiServer *server.Server, done chan bool) {
 	log.Println("shutting down gracefully, press Ctrl+C again to force")
 	stop() // Allow Ctrl+C to force shutdown
 
-	// The context is used to inform the server it has 5 seconds to finish
-	// the request it is currently handling
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	// Every component, from the HTTP server to the tracer, has to stop
+	// within the configured deadline
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
-	if err := apiServer.ShutdownHTTP(ctx); err != nil {
-		log.Printf("Server forced to shutdown with error: %v", err)
-	}
-
-	// Close KVManager and other resources
-	if err := apiServer.Shutdown(); err != nil {
-		log.Printf("Error closing resources: %v", err)
+	for _, result := range components.Shutdown(ctx) {
+		if result.Err != nil {
+			log.Printf("%s did not stop cleanly after %s: %v", result.Name, result.Duration, result.Err)
+			continue
+		}
+		log.Printf("%s stopped in %s", result.Name, result.Duration)
 	}
 
 	log.Println("Server exiting")
@@ -81,20 +84,44 @@ func gracefulShutdown(apiServer *server.Server, done chan bool) {
 }
 
 func main() {
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "print" {
+		os.Exit(printConfig(os.Args[3:]))
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("invalid configuration:\n%v", err)
+	}
 
-	server := server.NewServer()
+	// Components are stopped in reverse order: the tracer goes last so the
+	// spans of the shutdown itself are flushed
+	components := lifecycle.New()
+
+	shutdownTracing, err := telemetry.Setup(context.Background(), cfg.Tracing, appVersion)
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
+	components.Register("tracing", shutdownTracing)
+
+	server, err := server.NewServer(cfg, server.WithLifecycle(components))
+	if err != nil {
+		log.Fatalf("failed to start: %v", err)
+	}
 
 	// Create a done channel to signal when the shutdown is complete
 	done := make(chan bool, 1)
 
 	// Run graceful shutdown in a separate goroutine
-	go gracefulShutdown(server, done)
+	go gracefulShutdown(components, cfg.Server.ShutdownTimeout(), done)
 
 	// Print startup message
 	fmt.Println(finalStartupMessage)
 
 	// Start the server
-	err := server.ListenAndServe()
+	err = server.ListenAndServe()
 	if err != nil && err != http.ErrServerClosed {
 		panic(fmt.Sprintf("http server error: %s", err))
 	}
@@ -103,3 +130,22 @@ func main() {
 	<-done
 	log.Println("Graceful shutdown complete.")
 }
+
+// printConfig implements "config print": it shows the effective settings with
+// secrets redacted and reports validation problems on stderr
+func printConfig(args []string) int {
+	cfg, err := config.Load(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration: %v\n", err)
+		return 1
+	}
+	if err := cfg.Print(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "could not print configuration: %v\n", err)
+		return 1
+	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration:\n%v\n", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,217 @@
+// Command radgifa is the API client CLI. Unlike radgifactl it goes through
+// the HTTP API, with the permissions of the user it is logged in as, so it
+// can run anywhere the API is reachable. It prints JSON so its output can be
+// scripted.
+//
+//	radgifa [-url URL] [-token TOKEN] <command> <subcommand> [flags]
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"radgifa/pkg/client"
+)
+
+const usage = `Usage: radgifa [-url URL] [-token TOKEN] <command> <subcommand> [flags]
+
+Commands:
+  login -username U [-password P]
+  questionnaire create -file F
+  questionnaire publish -id ID
+  questionnaire close -id ID
+  questionnaire invite -id ID
+  questionnaire results -id ID
+  token create -name N [-scopes S] [-expires D]
+  token list
+  token revoke -id ID
+  webhook create -id ID -url URL [-events E]
+  webhook list -id ID
+  webhook delete -id ID -webhook W
+  webhook deliveries -id ID -webhook W [-limit N] [-cursor C]
+  webhook redeliver -id ID -webhook W -delivery D
+  webhook listen -secret S [-addr A] [-status N]
+
+-url defaults to $RADGIFA_URL, then http://localhost:8080, and includes the
+route prefix of the server if any. The token, which login prints, or a
+personal access token for scripts, is read from $RADGIFA_TOKEN, or from the
+first line of stdin with -token -. Avoid passing it as -token TOKEN: the
+arguments of a process are visible to other users in ps.
+
+  export RADGIFA_TOKEN=$(radgifa login -username john | jq -r .token)
+  radgifa token create -name nightly -scopes read -expires 720h | jq -r .token
+  pass show radgifa | radgifa -token - questionnaire results -id ID
+
+Without -password, login reads $RADGIFA_PASSWORD. The file given to create is
+YAML or JSON, see "questionnaire create -h".
+
+webhook listen receives webhooks locally and prints them, checking their
+signature with the secret webhook create returned:
+
+  radgifa webhook create -id ID -url http://localhost:9000 | jq -r .secret
+  radgifa webhook listen -secret whsec_...
+`
+
+// command runs a subcommand with its own arguments and returns the value to
+// print as JSON
+type command func(env *environment, args []string) (any, error)
+
+var commands = map[string]map[string]command{
+	"questionnaire": {
+		"create":  questionnaireCreate,
+		"publish": questionnairePublish,
+		"close":   questionnaireClose,
+		"invite":  questionnaireInvite,
+		"results": questionnaireResults,
+	},
+	"token": {
+		"create": tokenCreate,
+		"list":   tokenList,
+		"revoke": tokenRevoke,
+	},
+	"webhook": {
+		"create":     webhookCreate,
+		"list":       webhookList,
+		"delete":     webhookDelete,
+		"deliveries": webhookDeliveries,
+		"redeliver":  webhookRedeliver,
+		"listen":     webhookListen,
+	},
+}
+
+// environment is what every command gets: the API client and the context
+// cancelled on interrupt
+type environment struct {
+	ctx    context.Context
+	client *client.Client
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	fs := newFlagSet("radgifa")
+	fs.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	baseURL := fs.String("url", envOr("RADGIFA_URL", "http://localhost:8080"), "URL of the server")
+	token := fs.String("token", os.Getenv("RADGIFA_TOKEN"), `token of the session, "-" to read it from stdin, $RADGIFA_TOKEN by default`)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return fail(err)
+	}
+
+	cmd, cmdArgs, err := lookup(fs.Args())
+	if err != nil {
+		fmt.Fprint(os.Stderr, usage)
+		return fail(err)
+	}
+
+	if *token == "-" {
+		if *token, err = readToken(os.Stdin); err != nil {
+			return fail(err)
+		}
+	}
+	c, err := client.New(*baseURL, client.WithToken(*token), client.WithUserAgent("radgifa-cli"))
+	if err != nil {
+		return fail(err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	result, err := cmd(&environment{ctx: ctx, client: c}, cmdArgs)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return fail(err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		return fail(err)
+	}
+	return 0
+}
+
+func lookup(args []string) (command, []string, error) {
+	if len(args) > 0 && args[0] == "login" {
+		return login, args[1:], nil
+	}
+	if len(args) == 0 {
+		return nil, nil, errors.New("missing command")
+	}
+	group, ok := commands[args[0]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command %q", args[0])
+	}
+	if len(args) < 2 {
+		return nil, nil, fmt.Errorf("missing subcommand for %q", args[0])
+	}
+	cmd, ok := group[args[1]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown subcommand %q", strings.Join(args[:2], " "))
+	}
+	return cmd, args[2:], nil
+}
+
+func login(env *environment, args []string) (any, error) {
+	fs := newFlagSet("login")
+	username := fs.String("username", "", "username")
+	password := fs.String("password", os.Getenv("RADGIFA_PASSWORD"), "password, $RADGIFA_PASSWORD by default")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *username == "" || *password == "" {
+		return nil, errors.New("-username and -password are required")
+	}
+	return env.client.Login(env.ctx, *username, *password)
+}
+
+// fail prints err as a JSON object on stderr and returns the exit code. API
+// errors keep their problem document.
+func fail(err error) int {
+	var apiErr *client.Error
+	if errors.As(err, &apiErr) {
+		json.NewEncoder(os.Stderr).Encode(map[string]any{"error": err.Error(), "problem": apiErr})
+		return 1
+	}
+	json.NewEncoder(os.Stderr).Encode(map[string]string{"error": err.Error()})
+	return 1
+}
+
+// newFlagSet returns a flag set for a subcommand that reports errors instead
+// of exiting
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
+
+// readToken reads a token from the first line of r
+func readToken(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("reading the token from stdin: %w", err)
+	}
+	token := strings.TrimSpace(line)
+	if token == "" {
+		return "", errors.New("-token -: no token on stdin")
+	}
+	return token, nil
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
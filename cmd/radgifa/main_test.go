@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"radgifa/pkg/client"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		args     []string
+		wantArgs []string
+		wantErr  string
+	}{
+		{args: []string{"login", "-username", "john"}, wantArgs: []string{"-username", "john"}},
+		{args: []string{"questionnaire", "publish", "-id", "q1"}, wantArgs: []string{"-id", "q1"}},
+		{args: []string{"webhook", "listen"}, wantArgs: []string{}},
+		{args: nil, wantErr: "missing command"},
+		{args: []string{"poll"}, wantErr: `unknown command "poll"`},
+		{args: []string{"token"}, wantErr: `missing subcommand for "token"`},
+		{args: []string{"token", "rotate"}, wantErr: `unknown subcommand "token rotate"`},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			cmd, args, err := lookup(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("lookup() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || cmd == nil {
+				t.Fatalf("lookup() = %v, %v, want a command", cmd, err)
+			}
+			if strings.Join(args, " ") != strings.Join(tt.wantArgs, " ") {
+				t.Errorf("args = %q, want %q", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestReadToken(t *testing.T) {
+	tests := []struct {
+		stdin   string
+		want    string
+		wantErr bool
+	}{
+		{"rgf_secret\n", "rgf_secret", false},
+		{"  rgf_secret  ", "rgf_secret", false},
+		{"rgf_first\nrgf_second\n", "rgf_first", false},
+		{"", "", true},
+		{"\n", "", true},
+	}
+	for _, tt := range tests {
+		got, err := readToken(strings.NewReader(tt.stdin))
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("readToken(%q) = %q, %v, want %q", tt.stdin, got, err, tt.want)
+		}
+	}
+}
+
+func TestRequiredFlags(t *testing.T) {
+	env := &environment{ctx: context.Background()}
+	tests := []struct {
+		name string
+		cmd  command
+		args []string
+	}{
+		{"questionnaire publish", questionnairePublish, nil},
+		{"questionnaire create", questionnaireCreate, nil},
+		{"token create", tokenCreate, nil},
+		{"token revoke", tokenRevoke, nil},
+		{"webhook create", webhookCreate, []string{"-id", "q1"}},
+		{"webhook deliveries", webhookDeliveries, []string{"-id", "q1"}},
+		{"webhook redeliver", webhookRedeliver, []string{"-id", "q1", "-webhook", "w1"}},
+		{"webhook listen", webhookListen, []string{"-secret", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// No client: the command must fail before calling the API
+			if _, err := tt.cmd(env, tt.args); err == nil || !strings.Contains(err.Error(), "required") {
+				t.Errorf("error = %v, want a missing flag", err)
+			}
+		})
+	}
+}
+
+func TestReadQuestionnaireFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{"yaml", write("pizza.yaml", "title: Pizza night\nquestions:\n  - text: Coming?\n"), ""},
+		{"json", write("pizza.json", `{"title":"Pizza night"}`), ""},
+		{"no title", write("untitled.yml", "description: What do we order?\n"), "title is required"},
+		{"unknown format", write("pizza.txt", "title: Pizza night\n"), "unsupported format"},
+		{"invalid", write("broken.json", `{"title":`), "parsing questionnaire file"},
+		{"missing", filepath.Join(dir, "missing.yaml"), "no such file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := readQuestionnaireFile(tt.path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || file.Title != "Pizza night" {
+				t.Fatalf("readQuestionnaireFile() = %+v, %v", file, err)
+			}
+		})
+	}
+}
+
+func TestQuestionnaireCreate(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/api/v1/questionnaires":
+			json.NewEncoder(w).Encode("q1")
+		case "/api/v1/questionnaires/q1/sections":
+			json.NewEncoder(w).Encode(client.Section{ID: "s1"})
+		default:
+			json.NewEncoder(w).Encode(client.CreatedQuestion{QuestionID: "x"})
+		}
+	}))
+	defer api.Close()
+
+	path := filepath.Join(t.TempDir(), "pizza.yaml")
+	err := os.WriteFile(path, []byte(`title: Pizza night
+questions:
+  - text: Coming?
+    required: true
+sections:
+  - title: Toppings
+    questions:
+      - text: Pineapple?
+      - text: Anchovies?
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := client.New(api.URL, client.WithToken("rgf_test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := questionnaireCreate(&environment{ctx: context.Background(), client: c}, []string{"-file", path})
+	if err != nil {
+		t.Fatalf("questionnaireCreate() error = %v", err)
+	}
+	if got != (createResult{ID: "q1", Sections: 1, Questions: 3}) {
+		t.Errorf("result = %+v, want 1 section and 3 questions", got)
+	}
+	if len(requests) != 5 || !strings.Contains(requests[4], `"section_id":"s1"`) {
+		t.Errorf("requests = %q, want the section questions created in s1", requests)
+	}
+}
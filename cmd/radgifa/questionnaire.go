@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"radgifa/pkg/client"
+
+	"gopkg.in/yaml.v3"
+)
+
+const questionnaireFileUsage = `The questionnaire file is YAML (.yaml, .yml) or JSON (.json):
+
+  title: Pizza night
+  description: What do we order?
+  lock_after_submit: false
+  questions:             # outside any section
+    - text: Are you coming?
+      required: true
+  sections:
+    - title: Toppings
+      questions:
+        - text: Pineapple?
+        - text: Anchovies?
+`
+
+// questionnaireFile describes a questionnaire to create
+type questionnaireFile struct {
+	Title           string         `json:"title" yaml:"title"`
+	Description     string         `json:"description" yaml:"description"`
+	LockAfterSubmit bool           `json:"lock_after_submit" yaml:"lock_after_submit"`
+	Questions       []questionFile `json:"questions" yaml:"questions"`
+	Sections        []sectionFile  `json:"sections" yaml:"sections"`
+}
+
+type sectionFile struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Questions   []questionFile `json:"questions" yaml:"questions"`
+}
+
+type questionFile struct {
+	Text     string `json:"text" yaml:"text"`
+	Required bool   `json:"required" yaml:"required"`
+}
+
+type createResult struct {
+	ID        string `json:"id"`
+	Sections  int    `json:"sections"`
+	Questions int    `json:"questions"`
+}
+
+func questionnaireCreate(env *environment, args []string) (any, error) {
+	fs := newFlagSet("questionnaire create")
+	path := fs.String("file", "", "YAML or JSON file describing the questionnaire")
+	fs.Usage = func() {
+		fs.PrintDefaults()
+		fmt.Fprint(fs.Output(), "\n"+questionnaireFileUsage)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *path == "" {
+		return nil, errors.New("-file is required")
+	}
+	file, err := readQuestionnaireFile(*path)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := env.client.CreateQuestionnaire(env.ctx, client.NewQuestionnaire{
+		Title:           file.Title,
+		Description:     file.Description,
+		LockAfterSubmit: file.LockAfterSubmit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Later failures leave the questionnaire half built, its ID tells what
+	// to delete
+	result := createResult{ID: id}
+	fail := func(err error) (any, error) {
+		return nil, fmt.Errorf("questionnaire %s created incomplete: %w", id, err)
+	}
+	for _, q := range file.Questions {
+		if _, err := env.client.CreateQuestion(env.ctx, id, client.NewQuestion{Text: q.Text, Required: q.Required}); err != nil {
+			return fail(err)
+		}
+		result.Questions++
+	}
+	for _, s := range file.Sections {
+		section, err := env.client.CreateSection(env.ctx, id, client.NewSection{Title: s.Title, Description: s.Description})
+		if err != nil {
+			return fail(err)
+		}
+		result.Sections++
+		for _, q := range s.Questions {
+			if _, err := env.client.CreateQuestion(env.ctx, id, client.NewQuestion{SectionID: section.ID, Text: q.Text, Required: q.Required}); err != nil {
+				return fail(err)
+			}
+			result.Questions++
+		}
+	}
+	return result, nil
+}
+
+func readQuestionnaireFile(path string) (*questionnaireFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := new(questionnaireFile)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, file)
+	case ".json":
+		err = json.Unmarshal(data, file)
+	default:
+		return nil, fmt.Errorf("questionnaire file %s: unsupported format, use .yaml, .yml or .json", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing questionnaire file %s: %w", path, err)
+	}
+	if strings.TrimSpace(file.Title) == "" {
+		return nil, fmt.Errorf("questionnaire file %s: title is required", path)
+	}
+	return file, nil
+}
+
+func questionnairePublish(env *environment, args []string) (any, error) {
+	id, err := parseQuestionnaireID("questionnaire publish", args)
+	if err != nil {
+		return nil, err
+	}
+	return env.client.PublishQuestionnaire(env.ctx, id)
+}
+
+func questionnaireClose(env *environment, args []string) (any, error) {
+	id, err := parseQuestionnaireID("questionnaire close", args)
+	if err != nil {
+		return nil, err
+	}
+	return env.client.CloseQuestionnaire(env.ctx, id)
+}
+
+func questionnaireInvite(env *environment, args []string) (any, error) {
+	id, err := parseQuestionnaireID("questionnaire invite", args)
+	if err != nil {
+		return nil, err
+	}
+	return env.client.Invite(env.ctx, id)
+}
+
+type resultsResult struct {
+	ID        string                     `json:"id"`
+	Title     string                     `json:"title"`
+	Members   int                        `json:"members"`
+	Submitted int                        `json:"submitted"`
+	Questions []questionResult           `json:"questions"`
+	Progress  []client.MemberCompletion  `json:"progress"`
+	Sections  []client.SectionCompletion `json:"sections"`
+}
+
+// questionResult counts the answers of a question by value
+type questionResult struct {
+	ID      string                     `json:"id"`
+	Text    string                     `json:"text"`
+	Section string                     `json:"section,omitempty"`
+	Answers map[client.AnswerValue]int `json:"answers"`
+}
+
+func questionnaireResults(env *environment, args []string) (any, error) {
+	id, err := parseQuestionnaireID("questionnaire results", args)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := env.client.GetQuestionnaire(env.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	questions, err := env.client.AllQuestions(env.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	completion, err := env.client.Completion(env.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := resultsResult{
+		ID:        q.ID,
+		Title:     q.Title,
+		Members:   len(completion.Members),
+		Submitted: completion.Submitted,
+		Questions: make([]questionResult, len(questions)),
+		Progress:  completion.Members,
+		Sections:  completion.Sections,
+	}
+	for i, question := range questions {
+		counts := map[client.AnswerValue]int{client.Yes: 0, client.No: 0, client.Pass: 0}
+		for _, a := range question.Edges.Answers {
+			counts[a.AnswerValue]++
+		}
+		result.Questions[i] = questionResult{ID: question.ID, Text: question.Text, Answers: counts}
+		if question.Edges.Section != nil {
+			result.Questions[i].Section = question.Edges.Section.Title
+		}
+	}
+	return result, nil
+}
+
+func parseQuestionnaireID(name string, args []string) (string, error) {
+	fs := newFlagSet(name)
+	id := fs.String("id", "", "questionnaire ID")
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if *id == "" {
+		return "", errors.New("-id is required")
+	}
+	return *id, nil
+}
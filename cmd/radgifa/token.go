@@ -0,0 +1,49 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"radgifa/pkg/client"
+)
+
+func tokenCreate(env *environment, args []string) (any, error) {
+	fs := newFlagSet("token create")
+	name := fs.String("name", "", "name of the token")
+	scopes := fs.String("scopes", client.ScopeRead, "comma separated scopes: read, questionnaires:write, answers:write")
+	expires := fs.Duration("expires", 0, "lifetime of the token, e.g. 720h, none by default")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *name == "" {
+		return nil, errors.New("-name is required")
+	}
+	token := client.NewAccessToken{Name: *name, Scopes: strings.Split(*scopes, ",")}
+	if *expires > 0 {
+		token.ExpiresAt = time.Now().Add(*expires).UnixMilli()
+	}
+	return env.client.CreateAccessToken(env.ctx, token)
+}
+
+func tokenList(env *environment, args []string) (any, error) {
+	if err := newFlagSet("token list").Parse(args); err != nil {
+		return nil, err
+	}
+	return env.client.ListAccessTokens(env.ctx)
+}
+
+func tokenRevoke(env *environment, args []string) (any, error) {
+	fs := newFlagSet("token revoke")
+	id := fs.String("id", "", "token ID")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == "" {
+		return nil, errors.New("-id is required")
+	}
+	if err := env.client.RevokeAccessToken(env.ctx, *id); err != nil {
+		return nil, err
+	}
+	return map[string]string{"revoked": *id}, nil
+}
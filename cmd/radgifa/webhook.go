@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"radgifa/pkg/client"
+)
+
+func webhookCreate(env *environment, args []string) (any, error) {
+	fs := newFlagSet("webhook create")
+	id := fs.String("id", "", "questionnaire ID")
+	url := fs.String("url", "", "URL the events are POSTed to")
+	events := fs.String("events", "member.joined,answers.submitted,questionnaire.published,questionnaire.closed", "comma separated events")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == "" || *url == "" {
+		return nil, errors.New("-id and -url are required")
+	}
+	return env.client.CreateWebhook(env.ctx, *id, client.NewWebhook{URL: *url, Events: strings.Split(*events, ",")})
+}
+
+func webhookList(env *environment, args []string) (any, error) {
+	id, err := parseQuestionnaireID("webhook list", args)
+	if err != nil {
+		return nil, err
+	}
+	return env.client.ListWebhooks(env.ctx, id)
+}
+
+func webhookDelete(env *environment, args []string) (any, error) {
+	fs := newFlagSet("webhook delete")
+	id := fs.String("id", "", "questionnaire ID")
+	webhookID := fs.String("webhook", "", "webhook ID")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == "" || *webhookID == "" {
+		return nil, errors.New("-id and -webhook are required")
+	}
+	if err := env.client.DeleteWebhook(env.ctx, *id, *webhookID); err != nil {
+		return nil, err
+	}
+	return map[string]string{"deleted": *webhookID}, nil
+}
+
+func webhookDeliveries(env *environment, args []string) (any, error) {
+	fs := newFlagSet("webhook deliveries")
+	id := fs.String("id", "", "questionnaire ID")
+	webhookID := fs.String("webhook", "", "webhook ID")
+	limit := fs.Int("limit", 20, "page size, 1 to 100")
+	cursor := fs.String("cursor", "", "next_cursor of the previous page")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == "" || *webhookID == "" {
+		return nil, errors.New("-id and -webhook are required")
+	}
+	return env.client.ListWebhookDeliveries(env.ctx, *id, *webhookID, client.ListOptions{Limit: *limit, Cursor: *cursor})
+}
+
+func webhookRedeliver(env *environment, args []string) (any, error) {
+	fs := newFlagSet("webhook redeliver")
+	id := fs.String("id", "", "questionnaire ID")
+	webhookID := fs.String("webhook", "", "webhook ID")
+	deliveryID := fs.String("delivery", "", "delivery ID")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == "" || *webhookID == "" || *deliveryID == "" {
+		return nil, errors.New("-id, -webhook and -delivery are required")
+	}
+	return env.client.Redeliver(env.ctx, *id, *webhookID, *deliveryID)
+}
+
+// webhookListen receives webhooks on a local address, to try them out. It
+// prints every request as a line of JSON, and answers 401 to those with a
+// wrong signature and -status to the others, so retries can be tested too.
+// It returns how many requests it received once interrupted.
+func webhookListen(env *environment, args []string) (any, error) {
+	fs := newFlagSet("webhook listen")
+	addr := fs.String("addr", "localhost:9000", "address to listen on")
+	secret := fs.String("secret", os.Getenv("RADGIFA_WEBHOOK_SECRET"), "secret of the webhook, $RADGIFA_WEBHOOK_SECRET by default")
+	status := fs.Int("status", http.StatusNoContent, "status to answer the valid requests with")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *secret == "" {
+		return nil, errors.New("-secret is required")
+	}
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "listening on http://%s\n", ln.Addr())
+
+	var mu sync.Mutex
+	var count int
+	out := json.NewEncoder(os.Stdout)
+	srv := &http.Server{
+		ReadHeaderTimeout: 10 * time.Second,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			valid := client.VerifyWebhookSignature(*secret, body, r.Header.Get(client.WebhookSignatureHeader))
+			var payload any = string(body)
+			if json.Valid(body) {
+				payload = json.RawMessage(body)
+			}
+			mu.Lock()
+			count++
+			out.Encode(map[string]any{
+				"event":           r.Header.Get(client.WebhookEventHeader),
+				"delivery":        r.Header.Get(client.WebhookDeliveryHeader),
+				"valid_signature": valid,
+				"payload":         payload,
+			})
+			mu.Unlock()
+			if !valid {
+				http.Error(w, "invalid signature", http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(*status)
+		}),
+	}
+	go func() {
+		<-env.ctx.Done()
+		srv.Close()
+	}()
+	if err := srv.Serve(ln); !errors.Is(err, http.ErrServerClosed) {
+		return nil, err
+	}
+	return map[string]int{"received": count}, nil
+}
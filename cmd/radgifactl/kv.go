@@ -0,0 +1,58 @@
+package main
+
+import (
+	"radgifa/internal/server"
+)
+
+type purgeResult struct {
+	Before server.KVStats `json:"before"`
+	After  server.KVStats `json:"after"`
+}
+
+func kvStats(env *environment, args []string) (any, error) {
+	if err := parseFlags(newFlagSet("kv stats"), args); err != nil {
+		return nil, err
+	}
+	kv, err := env.kv()
+	if err != nil {
+		return nil, err
+	}
+	return kv.Stats()
+}
+
+func kvList(env *environment, args []string) (any, error) {
+	fs := newFlagSet("kv list")
+	prefix := fs.String("prefix", "", "only list keys starting with this prefix")
+	limit := fs.Int("limit", 100, "maximum number of keys, 0 for all")
+	if err := parseFlags(fs, args); err != nil {
+		return nil, err
+	}
+	kv, err := env.kv()
+	if err != nil {
+		return nil, err
+	}
+	return kv.List([]byte(*prefix), *limit)
+}
+
+// purge drops expired invitation tokens and reclaims their disk space
+func purge(env *environment, args []string) (any, error) {
+	if err := parseFlags(newFlagSet("purge"), args); err != nil {
+		return nil, err
+	}
+	kv, err := env.kv()
+	if err != nil {
+		return nil, err
+	}
+	before, err := kv.Stats()
+	if err != nil {
+		return nil, err
+	}
+	if err := kv.Purge(); err != nil {
+		return nil, err
+	}
+	after, err := kv.Stats()
+	if err != nil {
+		return nil, err
+	}
+	return purgeResult{Before: before, After: after}, nil
+}
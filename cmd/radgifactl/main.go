@@ -0,0 +1,182 @@
+// Command radgifactl is the operator CLI. It works directly against the
+// database and the KV store described by the regular configuration, and
+// prints JSON so its output can be scripted.
+//
+//	radgifactl [config flags] <command> <subcommand> [flags]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"radgifa/internal/config"
+	"radgifa/internal/database"
+	"radgifa/internal/server"
+)
+
+const usage = `Usage: radgifactl [config flags] <command> <subcommand> [flags]
+
+Commands:
+  user create -username U -name N [-display-name D] [-password-stdin]
+  user reset-password -username U [-password-stdin]
+  questionnaire publish -id ID
+  questionnaire unpublish -id ID
+  kv stats
+  kv list [-prefix P] [-limit N]
+  purge
+
+Config flags are the same as the server's (see "main -h"). With
+-password-stdin the password is the first line of stdin, otherwise a random
+password is generated and printed once. Passwords are not accepted as
+arguments, which other users see in ps. The KV commands need exclusive
+access to the store, so stop the server before running them.
+`
+
+// command runs a subcommand with its own arguments and returns the value to
+// print as JSON
+type command func(env *environment, args []string) (any, error)
+
+var commands = map[string]map[string]command{
+	"user": {
+		"create":         userCreate,
+		"reset-password": userResetPassword,
+	},
+	"questionnaire": {
+		"publish":   questionnairePublish,
+		"unpublish": questionnaireUnpublish,
+	},
+	"kv": {
+		"stats": kvStats,
+		"list":  kvList,
+	},
+}
+
+// environment opens the database and the KV store lazily, so each command
+// only touches what it needs
+type environment struct {
+	ctx       context.Context
+	cfg       *config.Config
+	stdin     io.Reader
+	service   database.Service
+	kvmanager server.KVAdmin
+}
+
+func (e *environment) db() (database.Service, error) {
+	if e.service == nil {
+		service, err := database.Open(e.cfg)
+		if err != nil {
+			return nil, err
+		}
+		e.service = service
+	}
+	return e.service, nil
+}
+
+func (e *environment) kv() (server.KVAdmin, error) {
+	if e.kvmanager == nil {
+		kvmanager, err := server.OpenKVManager(e.cfg.KV.StoragePath)
+		if err != nil {
+			return nil, fmt.Errorf("opening KV store at %s (is the server running?): %w", e.cfg.KV.StoragePath, err)
+		}
+		e.kvmanager = kvmanager
+	}
+	return e.kvmanager, nil
+}
+
+func (e *environment) close() {
+	if e.service != nil {
+		e.service.Close()
+	}
+	if e.kvmanager != nil {
+		e.kvmanager.Close()
+	}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	cfg, rest, err := config.Parse(args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			fmt.Fprint(os.Stderr, usage)
+			return 0
+		}
+		return fail(err)
+	}
+
+	cmd, cmdArgs, err := lookup(rest)
+	if err != nil {
+		fmt.Fprint(os.Stderr, usage)
+		return fail(err)
+	}
+
+	env := &environment{ctx: context.Background(), cfg: cfg, stdin: os.Stdin}
+	defer env.close()
+
+	result, err := cmd(env, cmdArgs)
+	if err != nil {
+		return fail(err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		return fail(err)
+	}
+	return 0
+}
+
+// lookup returns the command named by the first arguments and the
+// arguments left for it
+func lookup(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, errors.New("missing command")
+	}
+	if args[0] == "purge" {
+		return purge, args[1:], nil
+	}
+	group, ok := commands[args[0]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown command %q", args[0])
+	}
+	if len(args) < 2 {
+		return nil, nil, fmt.Errorf("missing subcommand for %q", args[0])
+	}
+	cmd, ok := group[args[1]]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown subcommand %q", strings.Join(args[:2], " "))
+	}
+	return cmd, args[2:], nil
+}
+
+// fail prints err as a JSON object on stderr and returns the exit code
+func fail(err error) int {
+	json.NewEncoder(os.Stderr).Encode(map[string]string{"error": err.Error()})
+	return 1
+}
+
+// newFlagSet returns a flag set for a subcommand that reports errors instead
+// of exiting
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
+
+// parseFlags parses args into fs and rejects anything left over, so a
+// mistyped flag value is not silently ignored
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%s: unexpected argument %q", fs.Name(), fs.Arg(0))
+	}
+	return nil
+}
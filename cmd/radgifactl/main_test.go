@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"radgifa/internal/config"
+	"radgifa/internal/server"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantCmd  command
+		wantArgs []string
+		wantErr  string
+	}{
+		{name: "subcommand", args: []string{"user", "create", "-username", "alice"}, wantCmd: userCreate, wantArgs: []string{"-username", "alice"}},
+		{name: "subcommand without flags", args: []string{"kv", "stats"}, wantCmd: kvStats, wantArgs: []string{}},
+		{name: "purge", args: []string{"purge"}, wantCmd: purge, wantArgs: []string{}},
+		{name: "purge with flags", args: []string{"purge", "-h"}, wantCmd: purge, wantArgs: []string{"-h"}},
+		{name: "missing command", args: nil, wantErr: "missing command"},
+		{name: "unknown command", args: []string{"member"}, wantErr: `unknown command "member"`},
+		{name: "missing subcommand", args: []string{"kv"}, wantErr: `missing subcommand for "kv"`},
+		{name: "unknown subcommand", args: []string{"kv", "drop"}, wantErr: `unknown subcommand "kv drop"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, args, err := lookup(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("lookup(%q) error = %v, want %q", tt.args, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("lookup(%q) error = %v", tt.args, err)
+			}
+			if reflect.ValueOf(cmd).Pointer() != reflect.ValueOf(tt.wantCmd).Pointer() {
+				t.Errorf("lookup(%q) returned the wrong command", tt.args)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("lookup(%q) args = %q, want %q", tt.args, args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+// TestFlagErrors covers the argument checks that run before the database is
+// opened; the environment has no configuration, so reaching it would panic
+func TestFlagErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmd     command
+		args    []string
+		stdin   string
+		wantErr string
+	}{
+		{name: "user create without username", cmd: userCreate, args: []string{"-name", "Alice", "-password-stdin"}, stdin: "Str0ng-passw0rd\n", wantErr: "username"},
+		{name: "user create with password argument", cmd: userCreate, args: []string{"-username", "alice", "-name", "Alice", "-password", "Str0ng-passw0rd"}, wantErr: "flag provided but not defined: -password"},
+		{name: "user create with short username", cmd: userCreate, args: []string{"-username", "al", "-name", "Alice"}, wantErr: "username must be at least 3 characters"},
+		{name: "user create with unknown flag", cmd: userCreate, args: []string{"-email", "a@example.com"}, wantErr: "flag provided but not defined"},
+		{name: "user create with stray argument", cmd: userCreate, args: []string{"-username", "alice", "Alice"}, wantErr: `unexpected argument "Alice"`},
+		{name: "reset-password without username", cmd: userResetPassword, args: nil, wantErr: "-username is required"},
+		{name: "reset-password with weak password", cmd: userResetPassword, args: []string{"-username", "alice", "-password-stdin"}, stdin: "abc\n", wantErr: "password"},
+		{name: "reset-password with empty stdin", cmd: userResetPassword, args: []string{"-username", "alice", "-password-stdin"}, wantErr: "no password on stdin"},
+		{name: "publish without id", cmd: questionnairePublish, args: nil, wantErr: "-id is required"},
+		{name: "publish with invalid id", cmd: questionnairePublish, args: []string{"-id", "nope"}, wantErr: "invalid questionnaire ID"},
+		{name: "unpublish without id", cmd: questionnaireUnpublish, args: nil, wantErr: "-id is required"},
+		{name: "kv list with bad limit", cmd: kvList, args: []string{"-limit", "many"}, wantErr: "invalid value"},
+		{name: "purge with stray argument", cmd: purge, args: []string{"now"}, wantErr: `purge: unexpected argument "now"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := &environment{ctx: context.Background(), stdin: strings.NewReader(tt.stdin)}
+			_, err := tt.cmd(env, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeneratePasswordIsValid(t *testing.T) {
+	password, err := generatePassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := server.NewUserRequest{Name: "-", Username: "alice", Password: password}
+	req.Sanitize()
+	if err := validate(&req); err != nil {
+		t.Errorf("generated password %q fails validation: %v", password, err)
+	}
+}
+
+func TestReadPassword(t *testing.T) {
+	tests := []struct {
+		stdin   string
+		want    string
+		wantErr bool
+	}{
+		{"Str0ng-passw0rd\n", "Str0ng-passw0rd", false},
+		{"Str0ng-passw0rd\r\n", "Str0ng-passw0rd", false},
+		{" spaced out ", " spaced out ", false},
+		{"first\nsecond\n", "first", false},
+		{"", "", true},
+		{"\n", "", true},
+	}
+	for _, tt := range tests {
+		got, err := readPassword(strings.NewReader(tt.stdin))
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("readPassword(%q) = %q, %v, want %q", tt.stdin, got, err, tt.want)
+		}
+	}
+}
+
+// newKVEnvironment seeds a Badger store in a temporary directory and returns
+// an environment pointing at it
+func newKVEnvironment(t *testing.T) *environment {
+	t.Helper()
+	dir := t.TempDir()
+
+	kv, err := server.OpenKVManager(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"invite:a", "invite:b", "idempotency:c"} {
+		if err := kv.InsertPersistent([]byte(key), []byte("value")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := kv.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Default()
+	cfg.KV.StoragePath = dir
+	env := &environment{ctx: context.Background(), cfg: &cfg}
+	t.Cleanup(env.close)
+	return env
+}
+
+func TestKVCommands(t *testing.T) {
+	t.Run("stats", func(t *testing.T) {
+		result, err := kvStats(newKVEnvironment(t), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats := result.(server.KVStats); stats.Keys != 3 {
+			t.Errorf("keys = %d, want 3", stats.Keys)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantKeys []string
+	}{
+		{name: "all", args: nil, wantKeys: []string{"idempotency:c", "invite:a", "invite:b"}},
+		{name: "prefix", args: []string{"-prefix", "invite:"}, wantKeys: []string{"invite:a", "invite:b"}},
+		{name: "limit", args: []string{"-limit", "1"}, wantKeys: []string{"idempotency:c"}},
+		{name: "no match", args: []string{"-prefix", "session:"}, wantKeys: nil},
+	}
+	for _, tt := range tests {
+		t.Run("list "+tt.name, func(t *testing.T) {
+			result, err := kvList(newKVEnvironment(t), tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var keys []string
+			for _, entry := range result.([]server.KVEntry) {
+				keys = append(keys, entry.Key)
+			}
+			if !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("keys = %q, want %q", keys, tt.wantKeys)
+			}
+		})
+	}
+
+	t.Run("purge", func(t *testing.T) {
+		result, err := purge(newKVEnvironment(t), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := result.(purgeResult)
+		if res.Before.Keys != 3 || res.After.Keys != 3 {
+			t.Errorf("keys before/after = %d/%d, want 3/3: live keys must survive a purge", res.Before.Keys, res.After.Keys)
+		}
+	})
+}
+
+func TestKVStoreInUse(t *testing.T) {
+	env := newKVEnvironment(t)
+	held, err := server.OpenKVManager(env.cfg.KV.StoragePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer held.Close()
+
+	_, err = kvStats(env, nil)
+	if err == nil || !strings.Contains(err.Error(), "is the server running?") {
+		t.Fatalf("error = %v, want a hint that the store is in use", err)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+)
+
+type questionnaireResult struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	IsPublished bool   `json:"is_published"`
+}
+
+func questionnairePublish(env *environment, args []string) (any, error) {
+	id, err := parseQuestionnaireID("questionnaire publish", args)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := env.db()
+	if err != nil {
+		return nil, err
+	}
+	q, err := service.GetQuestionnaire(id, env.ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("questionnaire %s not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if q.IsPublished {
+		return nil, fmt.Errorf("questionnaire %s is already published", id)
+	}
+
+	// Publishing on behalf of the owner also enrols them as a member
+	updated, err := service.PublishQuestionnaire(id, q.Edges.Owner.ID, env.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return questionnaireResult{ID: updated.ID.String(), Title: updated.Title, IsPublished: updated.IsPublished}, nil
+}
+
+func questionnaireUnpublish(env *environment, args []string) (any, error) {
+	id, err := parseQuestionnaireID("questionnaire unpublish", args)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := env.db()
+	if err != nil {
+		return nil, err
+	}
+	updated, err := service.UnpublishQuestionnaire(id, env.ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("questionnaire %s not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return questionnaireResult{ID: updated.ID.String(), Title: updated.Title, IsPublished: updated.IsPublished}, nil
+}
+
+func parseQuestionnaireID(name string, args []string) (uuid.UUID, error) {
+	fs := newFlagSet(name)
+	idStr := fs.String("id", "", "questionnaire ID (required)")
+	if err := parseFlags(fs, args); err != nil {
+		return uuid.Nil, err
+	}
+	if *idStr == "" {
+		return uuid.Nil, errors.New("-id is required")
+	}
+	id, err := uuid.Parse(*idStr)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid questionnaire ID: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"radgifa/ent"
+	"radgifa/internal/server"
+)
+
+const passwordAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz23456789"
+
+type userResult struct {
+	ID          string `json:"id"`
+	Username    string `json:"username"`
+	Name        string `json:"name,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	Password    string `json:"password,omitempty"`
+}
+
+func userCreate(env *environment, args []string) (any, error) {
+	fs := newFlagSet("user create")
+	req := server.NewUserRequest{}
+	fs.StringVar(&req.Username, "username", "", "username (required)")
+	fs.StringVar(&req.Name, "name", "", "full name (required)")
+	fs.StringVar(&req.DisplayName, "display-name", "", "display name")
+	passwordStdin := fs.Bool("password-stdin", false, "read the password from stdin instead of generating one")
+	if err := parseFlags(fs, args); err != nil {
+		return nil, err
+	}
+
+	password, generated, err := newPassword(env, *passwordStdin)
+	if err != nil {
+		return nil, err
+	}
+	req.Password = password
+
+	req.Sanitize()
+	if err := validate(&req); err != nil {
+		return nil, err
+	}
+
+	service, err := env.db()
+	if err != nil {
+		return nil, err
+	}
+	available, err := service.IsUsernameAvailable(req.Username, env.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, fmt.Errorf("username %q is already taken", req.Username)
+	}
+
+	user, err := service.CreateUser(req.Name, req.DisplayName, req.Username, req.Password, env.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := userResult{
+		ID:          user.ID.String(),
+		Username:    user.Username,
+		Name:        user.Name,
+		DisplayName: user.DisplayName,
+	}
+	if generated {
+		result.Password = req.Password
+	}
+	return result, nil
+}
+
+func userResetPassword(env *environment, args []string) (any, error) {
+	fs := newFlagSet("user reset-password")
+	username := fs.String("username", "", "username (required)")
+	passwordStdin := fs.Bool("password-stdin", false, "read the new password from stdin instead of generating one")
+	if err := parseFlags(fs, args); err != nil {
+		return nil, err
+	}
+	if *username == "" {
+		return nil, errors.New("-username is required")
+	}
+
+	password, generated, err := newPassword(env, *passwordStdin)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reuse the registration rules for the new password
+	check := server.NewUserRequest{Name: "-", Username: *username, Password: password}
+	check.Sanitize()
+	if err := validate(&check); err != nil {
+		return nil, err
+	}
+
+	service, err := env.db()
+	if err != nil {
+		return nil, err
+	}
+	user, err := service.GetUserByUsername(check.Username, env.ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("user %q not found", check.Username)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := service.UpdateUserPassword(user.ID, password, env.ctx); err != nil {
+		return nil, err
+	}
+
+	result := userResult{ID: user.ID.String(), Username: user.Username}
+	if generated {
+		result.Password = password
+	}
+	return result, nil
+}
+
+// newPassword reads the password from the first line of stdin when fromStdin
+// is set, and generates one otherwise. Passwords are never taken from the
+// arguments, which other users see in ps.
+func newPassword(env *environment, fromStdin bool) (password string, generated bool, err error) {
+	if fromStdin {
+		password, err = readPassword(env.stdin)
+		return password, false, err
+	}
+	password, err = generatePassword()
+	return password, true, err
+}
+
+// readPassword reads a password from the first line of r
+func readPassword(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("reading the password from stdin: %w", err)
+	}
+	password := strings.TrimRight(line, "\r\n")
+	if password == "" {
+		return "", errors.New("-password-stdin: no password on stdin")
+	}
+	return password, nil
+}
+
+// validate runs the same validation rules as the HTTP API and reports the
+// invalid fields
+func validate(i any) error {
+	err := server.NewValidator().Validate(i)
+	var p *server.Problem
+	if !errors.As(err, &p) || len(p.Errors) == 0 {
+		return err
+	}
+	messages := make([]string, len(p.Errors))
+	for i, fe := range p.Errors {
+		messages[i] = fe.Message
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// generatePassword returns a random password that satisfies the password
+// strength rules
+func generatePassword() (string, error) {
+	max := big.NewInt(int64(len(passwordAlphabet)))
+	for {
+		buf := make([]byte, 16)
+		for i := range buf {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			buf[i] = passwordAlphabet[n.Int64()]
+		}
+		password := string(buf)
+		if validate(&server.NewUserRequest{Name: "-", Username: "check", Password: password}) == nil {
+			return password, nil
+		}
+	}
+}
@@ -4,6 +4,8 @@ package answer
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -156,3 +158,21 @@ func newMemberStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, MemberTable, MemberColumn),
 	)
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e AnswerValue) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *AnswerValue) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = AnswerValue(str)
+	if err := AnswerValueValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid AnswerValue", str)
+	}
+	return nil
+}
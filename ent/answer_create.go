@@ -10,6 +10,8 @@ import (
 	"radgifa/ent/member"
 	"radgifa/ent/question"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -20,6 +22,7 @@ type AnswerCreate struct {
 	config
 	mutation *AnswerMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetAnswerValue sets the "answer_value" field.
@@ -194,6 +197,7 @@ func (_c *AnswerCreate) createSpec() (*Answer, *sqlgraph.CreateSpec) {
 		_node = &Answer{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(answer.Table, sqlgraph.NewFieldSpec(answer.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -247,11 +251,215 @@ func (_c *AnswerCreate) createSpec() (*Answer, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Answer.Create().
+//		SetAnswerValue(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnswerUpsert) {
+//			SetAnswerValue(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnswerCreate) OnConflict(opts ...sql.ConflictOption) *AnswerUpsertOne {
+	_c.conflict = opts
+	return &AnswerUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnswerCreate) OnConflictColumns(columns ...string) *AnswerUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnswerUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnswerUpsertOne is the builder for "upsert"-ing
+	//  one Answer node.
+	AnswerUpsertOne struct {
+		create *AnswerCreate
+	}
+
+	// AnswerUpsert is the "OnConflict" setter.
+	AnswerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAnswerValue sets the "answer_value" field.
+func (u *AnswerUpsert) SetAnswerValue(v answer.AnswerValue) *AnswerUpsert {
+	u.Set(answer.FieldAnswerValue, v)
+	return u
+}
+
+// UpdateAnswerValue sets the "answer_value" field to the value that was provided on create.
+func (u *AnswerUpsert) UpdateAnswerValue() *AnswerUpsert {
+	u.SetExcluded(answer.FieldAnswerValue)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnswerUpsert) SetUpdatedAt(v int64) *AnswerUpsert {
+	u.Set(answer.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnswerUpsert) UpdateUpdatedAt() *AnswerUpsert {
+	u.SetExcluded(answer.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *AnswerUpsert) AddUpdatedAt(v int64) *AnswerUpsert {
+	u.Add(answer.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(answer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnswerUpsertOne) UpdateNewValues() *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(answer.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(answer.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnswerUpsertOne) Ignore() *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnswerUpsertOne) DoNothing() *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnswerCreate.OnConflict
+// documentation for more info.
+func (u *AnswerUpsertOne) Update(set func(*AnswerUpsert)) *AnswerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnswerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAnswerValue sets the "answer_value" field.
+func (u *AnswerUpsertOne) SetAnswerValue(v answer.AnswerValue) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetAnswerValue(v)
+	})
+}
+
+// UpdateAnswerValue sets the "answer_value" field to the value that was provided on create.
+func (u *AnswerUpsertOne) UpdateAnswerValue() *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateAnswerValue()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnswerUpsertOne) SetUpdatedAt(v int64) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *AnswerUpsertOne) AddUpdatedAt(v int64) *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnswerUpsertOne) UpdateUpdatedAt() *AnswerUpsertOne {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AnswerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnswerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnswerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnswerUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AnswerUpsertOne.ID is not supported by MySQL driver. Use AnswerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnswerUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // AnswerCreateBulk is the builder for creating many Answer entities in bulk.
 type AnswerCreateBulk struct {
 	config
 	err      error
 	builders []*AnswerCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Answer entities in the database.
@@ -281,6 +489,7 @@ func (_c *AnswerCreateBulk) Save(ctx context.Context) ([]*Answer, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -330,3 +539,155 @@ func (_c *AnswerCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Answer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnswerUpsert) {
+//			SetAnswerValue(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnswerCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnswerUpsertBulk {
+	_c.conflict = opts
+	return &AnswerUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnswerCreateBulk) OnConflictColumns(columns ...string) *AnswerUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnswerUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnswerUpsertBulk is the builder for "upsert"-ing
+// a bulk of Answer nodes.
+type AnswerUpsertBulk struct {
+	create *AnswerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(answer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnswerUpsertBulk) UpdateNewValues() *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(answer.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(answer.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Answer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnswerUpsertBulk) Ignore() *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnswerUpsertBulk) DoNothing() *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnswerCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnswerUpsertBulk) Update(set func(*AnswerUpsert)) *AnswerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnswerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAnswerValue sets the "answer_value" field.
+func (u *AnswerUpsertBulk) SetAnswerValue(v answer.AnswerValue) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetAnswerValue(v)
+	})
+}
+
+// UpdateAnswerValue sets the "answer_value" field to the value that was provided on create.
+func (u *AnswerUpsertBulk) UpdateAnswerValue() *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateAnswerValue()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnswerUpsertBulk) SetUpdatedAt(v int64) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *AnswerUpsertBulk) AddUpdatedAt(v int64) *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnswerUpsertBulk) UpdateUpdatedAt() *AnswerUpsertBulk {
+	return u.Update(func(s *AnswerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AnswerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnswerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnswerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnswerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
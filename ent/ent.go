@@ -6,11 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"radgifa/ent/accesstoken"
 	"radgifa/ent/answer"
 	"radgifa/ent/member"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
 	"radgifa/ent/user"
+	"radgifa/ent/webhook"
+	"radgifa/ent/webhookdelivery"
 	"reflect"
 	"sync"
 
@@ -77,11 +82,16 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			answer.Table:        answer.ValidColumn,
-			member.Table:        member.ValidColumn,
-			question.Table:      question.ValidColumn,
-			questionnaire.Table: questionnaire.ValidColumn,
-			user.Table:          user.ValidColumn,
+			accesstoken.Table:     accesstoken.ValidColumn,
+			answer.Table:          answer.ValidColumn,
+			member.Table:          member.ValidColumn,
+			question.Table:        question.ValidColumn,
+			questionnaire.Table:   questionnaire.ValidColumn,
+			rule.Table:            rule.ValidColumn,
+			section.Table:         section.ValidColumn,
+			user.Table:            user.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
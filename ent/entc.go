@@ -0,0 +1,31 @@
+//go:build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+)
+
+func main() {
+	ex, err := entgql.NewExtension(
+		entgql.WithSchemaGenerator(),
+		entgql.WithSchemaPath("../internal/graph/ent.graphql"),
+		entgql.WithConfigPath("../gqlgen.yml"),
+		entgql.WithWhereInputs(true),
+	)
+	if err != nil {
+		log.Fatalf("creating entgql extension: %v", err)
+	}
+	err = entc.Generate("./schema",
+		&gen.Config{},
+		entc.Extensions(ex),
+		entc.FeatureNames("sql/lock", "sql/upsert", "intercept", "namedges"),
+	)
+	if err != nil {
+		log.Fatalf("running ent codegen: %v", err)
+	}
+}
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (_m *Answer) Question(ctx context.Context) (*Question, error) {
+	result, err := _m.Edges.QuestionOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryQuestion().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Answer) Member(ctx context.Context) (*Member, error) {
+	result, err := _m.Edges.MemberOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryMember().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Member) User(ctx context.Context) (*User, error) {
+	result, err := _m.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Member) Questionnaire(ctx context.Context) (*Questionnaire, error) {
+	result, err := _m.Edges.QuestionnaireOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryQuestionnaire().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Member) Answers(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *AnswerOrder, where *AnswerWhereInput,
+) (*AnswerConnection, error) {
+	opts := []AnswerPaginateOption{
+		WithAnswerOrder(orderBy),
+		WithAnswerFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[2][alias]
+	if nodes, err := _m.NamedAnswers(alias); err == nil || hasTotalCount {
+		pager, err := newAnswerPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &AnswerConnection{Edges: []*AnswerEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryAnswers().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Question) Questionnaire(ctx context.Context) (*Questionnaire, error) {
+	result, err := _m.Edges.QuestionnaireOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryQuestionnaire().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Question) Section(ctx context.Context) (*Section, error) {
+	result, err := _m.Edges.SectionOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySection().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *Question) Answers(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *AnswerOrder,
+) (*AnswerConnection, error) {
+	opts := []AnswerPaginateOption{
+		WithAnswerOrder(orderBy),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[2][alias]
+	if nodes, err := _m.NamedAnswers(alias); err == nil || hasTotalCount {
+		pager, err := newAnswerPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &AnswerConnection{Edges: []*AnswerEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryAnswers().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Question) Rules(ctx context.Context) (result []*Rule, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedRules(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.RulesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryRules().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Question) Dependents(ctx context.Context) (result []*Rule, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedDependents(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.DependentsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryDependents().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Questionnaire) Owner(ctx context.Context) (*User, error) {
+	result, err := _m.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Questionnaire) Members(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *MemberOrder,
+) (*MemberConnection, error) {
+	opts := []MemberPaginateOption{
+		WithMemberOrder(orderBy),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[1][alias]
+	if nodes, err := _m.NamedMembers(alias); err == nil || hasTotalCount {
+		pager, err := newMemberPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &MemberConnection{Edges: []*MemberEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryMembers().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Questionnaire) Questions(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *QuestionOrder, where *QuestionWhereInput,
+) (*QuestionConnection, error) {
+	opts := []QuestionPaginateOption{
+		WithQuestionOrder(orderBy),
+		WithQuestionFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[2][alias]
+	if nodes, err := _m.NamedQuestions(alias); err == nil || hasTotalCount {
+		pager, err := newQuestionPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &QuestionConnection{Edges: []*QuestionEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryQuestions().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Questionnaire) Sections(ctx context.Context) (result []*Section, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedSections(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.SectionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySections().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Rule) Question(ctx context.Context) (*Question, error) {
+	result, err := _m.Edges.QuestionOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryQuestion().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Rule) Source(ctx context.Context) (*Question, error) {
+	result, err := _m.Edges.SourceOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QuerySource().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Section) Questionnaire(ctx context.Context) (*Questionnaire, error) {
+	result, err := _m.Edges.QuestionnaireOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryQuestionnaire().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Section) Questions(ctx context.Context) (result []*Question, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedQuestions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.QuestionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryQuestions().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *User) Questionnaires(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *QuestionnaireOrder,
+) (*QuestionnaireConnection, error) {
+	opts := []QuestionnairePaginateOption{
+		WithQuestionnaireOrder(orderBy),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[0][alias]
+	if nodes, err := _m.NamedQuestionnaires(alias); err == nil || hasTotalCount {
+		pager, err := newQuestionnairePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &QuestionnaireConnection{Edges: []*QuestionnaireEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryQuestionnaires().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *User) Memberships(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *MemberOrder,
+) (*MemberConnection, error) {
+	opts := []MemberPaginateOption{
+		WithMemberOrder(orderBy),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[1][alias]
+	if nodes, err := _m.NamedMemberships(alias); err == nil || hasTotalCount {
+		pager, err := newMemberPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &MemberConnection{Edges: []*MemberEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryMemberships().Paginate(ctx, after, first, before, last, opts...)
+}
@@ -25,6 +25,8 @@ type Member struct {
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// Something that only the member knows so they can prove who they are
 	UniqueIdentifier string `json:"unique_identifier,omitempty"`
+	// When the member submitted the questionnaire, cleared if they change an answer afterwards
+	CompletedAt *int64 `json:"completed_at,omitempty"`
 	// It is generated as a string the clear text is send to the member only once, then only the hash is stored. Is not requiered if the member is related to a user.
 	PassCode []byte `json:"pass_code,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -46,6 +48,10 @@ type MemberEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+
+	namedAnswers map[string][]*Answer
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -86,7 +92,7 @@ func (*Member) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case member.FieldPassCode:
 			values[i] = new([]byte)
-		case member.FieldCreatedAt:
+		case member.FieldCreatedAt, member.FieldCompletedAt:
 			values[i] = new(sql.NullInt64)
 		case member.FieldDisplayName, member.FieldUniqueIdentifier:
 			values[i] = new(sql.NullString)
@@ -135,6 +141,13 @@ func (_m *Member) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UniqueIdentifier = value.String
 			}
+		case member.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(int64)
+				*_m.CompletedAt = value.Int64
+			}
 		case member.FieldPassCode:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field pass_code", values[i])
@@ -215,11 +228,40 @@ func (_m *Member) String() string {
 	builder.WriteString("unique_identifier=")
 	builder.WriteString(_m.UniqueIdentifier)
 	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("pass_code=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PassCode))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedAnswers returns the Answers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Member) NamedAnswers(name string) ([]*Answer, error) {
+	if _m.Edges.namedAnswers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedAnswers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Member) appendNamedAnswers(name string, edges ...*Answer) {
+	if _m.Edges.namedAnswers == nil {
+		_m.Edges.namedAnswers = make(map[string][]*Answer)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedAnswers[name] = []*Answer{}
+	} else {
+		_m.Edges.namedAnswers[name] = append(_m.Edges.namedAnswers[name], edges...)
+	}
+}
+
 // Members is a parsable slice of Member.
 type Members []*Member
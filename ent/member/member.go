@@ -19,6 +19,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUniqueIdentifier holds the string denoting the unique_identifier field in the database.
 	FieldUniqueIdentifier = "unique_identifier"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
 	// FieldPassCode holds the string denoting the pass_code field in the database.
 	FieldPassCode = "pass_code"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -58,6 +60,7 @@ var Columns = []string{
 	FieldDisplayName,
 	FieldCreatedAt,
 	FieldUniqueIdentifier,
+	FieldCompletedAt,
 	FieldPassCode,
 }
 
@@ -113,6 +116,11 @@ func ByUniqueIdentifier(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUniqueIdentifier, opts...).ToFunc()
 }
 
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -70,6 +70,11 @@ func UniqueIdentifier(v string) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldUniqueIdentifier, v))
 }
 
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v int64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldCompletedAt, v))
+}
+
 // PassCode applies equality check predicate on the "pass_code" field. It's identical to PassCodeEQ.
 func PassCode(v []byte) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldPassCode, v))
@@ -245,6 +250,56 @@ func UniqueIdentifierContainsFold(v string) predicate.Member {
 	return predicate.Member(sql.FieldContainsFold(FieldUniqueIdentifier, v))
 }
 
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v int64) predicate.Member {
+	return predicate.Member(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v int64) predicate.Member {
+	return predicate.Member(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...int64) predicate.Member {
+	return predicate.Member(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...int64) predicate.Member {
+	return predicate.Member(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v int64) predicate.Member {
+	return predicate.Member(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v int64) predicate.Member {
+	return predicate.Member(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v int64) predicate.Member {
+	return predicate.Member(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v int64) predicate.Member {
+	return predicate.Member(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.Member {
+	return predicate.Member(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.Member {
+	return predicate.Member(sql.FieldNotNull(FieldCompletedAt))
+}
+
 // PassCodeEQ applies the EQ predicate on the "pass_code" field.
 func PassCodeEQ(v []byte) predicate.Member {
 	return predicate.Member(sql.FieldEQ(FieldPassCode, v))
@@ -11,6 +11,8 @@ import (
 	"radgifa/ent/questionnaire"
 	"radgifa/ent/user"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type MemberCreate struct {
 	config
 	mutation *MemberMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetDisplayName sets the "display_name" field.
@@ -49,6 +52,20 @@ func (_c *MemberCreate) SetUniqueIdentifier(v string) *MemberCreate {
 	return _c
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (_c *MemberCreate) SetCompletedAt(v int64) *MemberCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *MemberCreate) SetNillableCompletedAt(v *int64) *MemberCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
 // SetPassCode sets the "pass_code" field.
 func (_c *MemberCreate) SetPassCode(v []byte) *MemberCreate {
 	_c.mutation.SetPassCode(v)
@@ -207,6 +224,7 @@ func (_c *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
 		_node = &Member{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(member.Table, sqlgraph.NewFieldSpec(member.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -223,6 +241,10 @@ func (_c *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
 		_spec.SetField(member.FieldUniqueIdentifier, field.TypeString, value)
 		_node.UniqueIdentifier = value
 	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(member.FieldCompletedAt, field.TypeInt64, value)
+		_node.CompletedAt = &value
+	}
 	if value, ok := _c.mutation.PassCode(); ok {
 		_spec.SetField(member.FieldPassCode, field.TypeBytes, value)
 		_node.PassCode = value
@@ -280,11 +302,280 @@ func (_c *MemberCreate) createSpec() (*Member, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Member.Create().
+//		SetDisplayName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberUpsert) {
+//			SetDisplayName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MemberCreate) OnConflict(opts ...sql.ConflictOption) *MemberUpsertOne {
+	_c.conflict = opts
+	return &MemberUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MemberCreate) OnConflictColumns(columns ...string) *MemberUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MemberUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MemberUpsertOne is the builder for "upsert"-ing
+	//  one Member node.
+	MemberUpsertOne struct {
+		create *MemberCreate
+	}
+
+	// MemberUpsert is the "OnConflict" setter.
+	MemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDisplayName sets the "display_name" field.
+func (u *MemberUpsert) SetDisplayName(v string) *MemberUpsert {
+	u.Set(member.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateDisplayName() *MemberUpsert {
+	u.SetExcluded(member.FieldDisplayName)
+	return u
+}
+
+// SetUniqueIdentifier sets the "unique_identifier" field.
+func (u *MemberUpsert) SetUniqueIdentifier(v string) *MemberUpsert {
+	u.Set(member.FieldUniqueIdentifier, v)
+	return u
+}
+
+// UpdateUniqueIdentifier sets the "unique_identifier" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateUniqueIdentifier() *MemberUpsert {
+	u.SetExcluded(member.FieldUniqueIdentifier)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *MemberUpsert) SetCompletedAt(v int64) *MemberUpsert {
+	u.Set(member.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *MemberUpsert) UpdateCompletedAt() *MemberUpsert {
+	u.SetExcluded(member.FieldCompletedAt)
+	return u
+}
+
+// AddCompletedAt adds v to the "completed_at" field.
+func (u *MemberUpsert) AddCompletedAt(v int64) *MemberUpsert {
+	u.Add(member.FieldCompletedAt, v)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *MemberUpsert) ClearCompletedAt() *MemberUpsert {
+	u.SetNull(member.FieldCompletedAt)
+	return u
+}
+
+// SetPassCode sets the "pass_code" field.
+func (u *MemberUpsert) SetPassCode(v []byte) *MemberUpsert {
+	u.Set(member.FieldPassCode, v)
+	return u
+}
+
+// UpdatePassCode sets the "pass_code" field to the value that was provided on create.
+func (u *MemberUpsert) UpdatePassCode() *MemberUpsert {
+	u.SetExcluded(member.FieldPassCode)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(member.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberUpsertOne) UpdateNewValues() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(member.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(member.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MemberUpsertOne) Ignore() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberUpsertOne) DoNothing() *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberCreate.OnConflict
+// documentation for more info.
+func (u *MemberUpsertOne) Update(set func(*MemberUpsert)) *MemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *MemberUpsertOne) SetDisplayName(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateDisplayName() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetUniqueIdentifier sets the "unique_identifier" field.
+func (u *MemberUpsertOne) SetUniqueIdentifier(v string) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUniqueIdentifier(v)
+	})
+}
+
+// UpdateUniqueIdentifier sets the "unique_identifier" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateUniqueIdentifier() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUniqueIdentifier()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *MemberUpsertOne) SetCompletedAt(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// AddCompletedAt adds v to the "completed_at" field.
+func (u *MemberUpsertOne) AddCompletedAt(v int64) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdateCompletedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *MemberUpsertOne) ClearCompletedAt() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetPassCode sets the "pass_code" field.
+func (u *MemberUpsertOne) SetPassCode(v []byte) *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetPassCode(v)
+	})
+}
+
+// UpdatePassCode sets the "pass_code" field to the value that was provided on create.
+func (u *MemberUpsertOne) UpdatePassCode() *MemberUpsertOne {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdatePassCode()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MemberUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MemberUpsertOne.ID is not supported by MySQL driver. Use MemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MemberUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // MemberCreateBulk is the builder for creating many Member entities in bulk.
 type MemberCreateBulk struct {
 	config
 	err      error
 	builders []*MemberCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Member entities in the database.
@@ -314,6 +605,7 @@ func (_c *MemberCreateBulk) Save(ctx context.Context) ([]*Member, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -363,3 +655,190 @@ func (_c *MemberCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Member.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MemberUpsert) {
+//			SetDisplayName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *MemberUpsertBulk {
+	_c.conflict = opts
+	return &MemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MemberCreateBulk) OnConflictColumns(columns ...string) *MemberUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// MemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of Member nodes.
+type MemberUpsertBulk struct {
+	create *MemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(member.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MemberUpsertBulk) UpdateNewValues() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(member.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(member.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Member.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MemberUpsertBulk) Ignore() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MemberUpsertBulk) DoNothing() *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *MemberUpsertBulk) Update(set func(*MemberUpsert)) *MemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *MemberUpsertBulk) SetDisplayName(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateDisplayName() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetUniqueIdentifier sets the "unique_identifier" field.
+func (u *MemberUpsertBulk) SetUniqueIdentifier(v string) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetUniqueIdentifier(v)
+	})
+}
+
+// UpdateUniqueIdentifier sets the "unique_identifier" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateUniqueIdentifier() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateUniqueIdentifier()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *MemberUpsertBulk) SetCompletedAt(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// AddCompletedAt adds v to the "completed_at" field.
+func (u *MemberUpsertBulk) AddCompletedAt(v int64) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.AddCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdateCompletedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *MemberUpsertBulk) ClearCompletedAt() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetPassCode sets the "pass_code" field.
+func (u *MemberUpsertBulk) SetPassCode(v []byte) *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.SetPassCode(v)
+	})
+}
+
+// UpdatePassCode sets the "pass_code" field to the value that was provided on create.
+func (u *MemberUpsertBulk) UpdatePassCode() *MemberUpsertBulk {
+	return u.Update(func(s *MemberUpsert) {
+		s.UpdatePassCode()
+	})
+}
+
+// Exec executes the query.
+func (u *MemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
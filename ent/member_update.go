@@ -59,6 +59,33 @@ func (_u *MemberUpdate) SetNillableUniqueIdentifier(v *string) *MemberUpdate {
 	return _u
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (_u *MemberUpdate) SetCompletedAt(v int64) *MemberUpdate {
+	_u.mutation.ResetCompletedAt()
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *MemberUpdate) SetNillableCompletedAt(v *int64) *MemberUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// AddCompletedAt adds value to the "completed_at" field.
+func (_u *MemberUpdate) AddCompletedAt(v int64) *MemberUpdate {
+	_u.mutation.AddCompletedAt(v)
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *MemberUpdate) ClearCompletedAt() *MemberUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
 // SetPassCode sets the "pass_code" field.
 func (_u *MemberUpdate) SetPassCode(v []byte) *MemberUpdate {
 	_u.mutation.SetPassCode(v)
@@ -201,6 +228,15 @@ func (_u *MemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UniqueIdentifier(); ok {
 		_spec.SetField(member.FieldUniqueIdentifier, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(member.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletedAt(); ok {
+		_spec.AddField(member.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(member.FieldCompletedAt, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.PassCode(); ok {
 		_spec.SetField(member.FieldPassCode, field.TypeBytes, value)
 	}
@@ -355,6 +391,33 @@ func (_u *MemberUpdateOne) SetNillableUniqueIdentifier(v *string) *MemberUpdateO
 	return _u
 }
 
+// SetCompletedAt sets the "completed_at" field.
+func (_u *MemberUpdateOne) SetCompletedAt(v int64) *MemberUpdateOne {
+	_u.mutation.ResetCompletedAt()
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *MemberUpdateOne) SetNillableCompletedAt(v *int64) *MemberUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// AddCompletedAt adds value to the "completed_at" field.
+func (_u *MemberUpdateOne) AddCompletedAt(v int64) *MemberUpdateOne {
+	_u.mutation.AddCompletedAt(v)
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *MemberUpdateOne) ClearCompletedAt() *MemberUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
 // SetPassCode sets the "pass_code" field.
 func (_u *MemberUpdateOne) SetPassCode(v []byte) *MemberUpdateOne {
 	_u.mutation.SetPassCode(v)
@@ -527,6 +590,15 @@ func (_u *MemberUpdateOne) sqlSave(ctx context.Context) (_node *Member, err erro
 	if value, ok := _u.mutation.UniqueIdentifier(); ok {
 		_spec.SetField(member.FieldUniqueIdentifier, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(member.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletedAt(); ok {
+		_spec.AddField(member.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(member.FieldCompletedAt, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.PassCode(); ok {
 		_spec.SetField(member.FieldPassCode, field.TypeBytes, value)
 	}
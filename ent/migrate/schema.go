@@ -8,6 +8,32 @@ import (
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeBytes, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "expires_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "user_access_tokens", Type: field.TypeUUID},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{AccessTokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// AnswersColumns holds the columns for the "answers" table.
 	AnswersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -36,6 +62,13 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "answer_member_answers_question_answers",
+				Unique:  true,
+				Columns: []*schema.Column{AnswersColumns[4], AnswersColumns[5]},
+			},
+		},
 	}
 	// MembersColumns holds the columns for the "members" table.
 	MembersColumns = []*schema.Column{
@@ -43,6 +76,7 @@ var (
 		{Name: "display_name", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "unique_identifier", Type: field.TypeString},
+		{Name: "completed_at", Type: field.TypeInt64, Nullable: true},
 		{Name: "pass_code", Type: field.TypeBytes},
 		{Name: "questionnaire_members", Type: field.TypeUUID},
 		{Name: "user_memberships", Type: field.TypeUUID, Nullable: true},
@@ -55,13 +89,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "members_questionnaires_members",
-				Columns:    []*schema.Column{MembersColumns[5]},
+				Columns:    []*schema.Column{MembersColumns[6]},
 				RefColumns: []*schema.Column{QuestionnairesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "members_users_memberships",
-				Columns:    []*schema.Column{MembersColumns[6]},
+				Columns:    []*schema.Column{MembersColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -70,17 +104,20 @@ var (
 			{
 				Name:    "member_unique_identifier_questionnaire_members",
 				Unique:  true,
-				Columns: []*schema.Column{MembersColumns[3], MembersColumns[5]},
+				Columns: []*schema.Column{MembersColumns[3], MembersColumns[6]},
 			},
 		},
 	}
 	// QuestionsColumns holds the columns for the "questions" table.
 	QuestionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "theme", Type: field.TypeString, Size: 255},
 		{Name: "created_at", Type: field.TypeInt64},
 		{Name: "text", Type: field.TypeString},
+		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "questionnaire_questions", Type: field.TypeUUID},
+		{Name: "section_questions", Type: field.TypeUUID, Nullable: true},
 	}
 	// QuestionsTable holds the schema information for the "questions" table.
 	QuestionsTable = &schema.Table{
@@ -90,10 +127,23 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "questions_questionnaires_questions",
-				Columns:    []*schema.Column{QuestionsColumns[4]},
+				Columns:    []*schema.Column{QuestionsColumns[6]},
 				RefColumns: []*schema.Column{QuestionnairesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "questions_sections_questions",
+				Columns:    []*schema.Column{QuestionsColumns[7]},
+				RefColumns: []*schema.Column{SectionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "question_position_questionnaire_questions",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionsColumns[4], QuestionsColumns[6]},
+			},
 		},
 	}
 	// QuestionnairesColumns holds the columns for the "questionnaires" table.
@@ -102,7 +152,10 @@ var (
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "is_published", Type: field.TypeBool, Default: false},
+		{Name: "lock_after_submit", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "closed_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "user_questionnaires", Type: field.TypeUUID},
 	}
 	// QuestionnairesTable holds the schema information for the "questionnaires" table.
@@ -113,12 +166,70 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "questionnaires_users_questionnaires",
-				Columns:    []*schema.Column{QuestionnairesColumns[5]},
+				Columns:    []*schema.Column{QuestionnairesColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// RulesColumns holds the columns for the "rules" table.
+	RulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "answer_values", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "question_rules", Type: field.TypeUUID},
+		{Name: "question_dependents", Type: field.TypeUUID},
+	}
+	// RulesTable holds the schema information for the "rules" table.
+	RulesTable = &schema.Table{
+		Name:       "rules",
+		Columns:    RulesColumns,
+		PrimaryKey: []*schema.Column{RulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rules_questions_rules",
+				Columns:    []*schema.Column{RulesColumns[3]},
+				RefColumns: []*schema.Column{QuestionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "rules_questions_dependents",
+				Columns:    []*schema.Column{RulesColumns[4]},
+				RefColumns: []*schema.Column{QuestionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SectionsColumns holds the columns for the "sections" table.
+	SectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "questionnaire_sections", Type: field.TypeUUID},
+	}
+	// SectionsTable holds the schema information for the "sections" table.
+	SectionsTable = &schema.Table{
+		Name:       "sections",
+		Columns:    SectionsColumns,
+		PrimaryKey: []*schema.Column{SectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sections_questionnaires_sections",
+				Columns:    []*schema.Column{SectionsColumns[5]},
+				RefColumns: []*schema.Column{QuestionnairesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "section_position_questionnaire_sections",
+				Unique:  false,
+				Columns: []*schema.Column{SectionsColumns[3], SectionsColumns[5]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -134,21 +245,91 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "questionnaire_webhooks", Type: field.TypeUUID},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_questionnaires_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[5]},
+				RefColumns: []*schema.Column{QuestionnairesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeInt64},
+		{Name: "last_attempt_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "webhook_deliveries", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3], WebhookDeliveriesColumns[5]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
 		AnswersTable,
 		MembersTable,
 		QuestionsTable,
 		QuestionnairesTable,
+		RulesTable,
+		SectionsTable,
 		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
+	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	AnswersTable.ForeignKeys[0].RefTable = MembersTable
 	AnswersTable.ForeignKeys[1].RefTable = QuestionsTable
 	MembersTable.ForeignKeys[0].RefTable = QuestionnairesTable
 	MembersTable.ForeignKeys[1].RefTable = UsersTable
 	QuestionsTable.ForeignKeys[0].RefTable = QuestionnairesTable
+	QuestionsTable.ForeignKeys[1].RefTable = SectionsTable
 	QuestionnairesTable.ForeignKeys[0].RefTable = UsersTable
+	RulesTable.ForeignKeys[0].RefTable = QuestionsTable
+	RulesTable.ForeignKeys[1].RefTable = QuestionsTable
+	SectionsTable.ForeignKeys[0].RefTable = QuestionnairesTable
+	WebhooksTable.ForeignKeys[0].RefTable = QuestionnairesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
@@ -6,12 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"radgifa/ent/accesstoken"
 	"radgifa/ent/answer"
 	"radgifa/ent/member"
 	"radgifa/ent/predicate"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
 	"radgifa/ent/user"
+	"radgifa/ent/webhook"
+	"radgifa/ent/webhookdelivery"
 	"sync"
 
 	"entgo.io/ent"
@@ -28,45 +33,54 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAnswer        = "Answer"
-	TypeMember        = "Member"
-	TypeQuestion      = "Question"
-	TypeQuestionnaire = "Questionnaire"
-	TypeUser          = "User"
+	TypeAccessToken     = "AccessToken"
+	TypeAnswer          = "Answer"
+	TypeMember          = "Member"
+	TypeQuestion        = "Question"
+	TypeQuestionnaire   = "Questionnaire"
+	TypeRule            = "Rule"
+	TypeSection         = "Section"
+	TypeUser            = "User"
+	TypeWebhook         = "Webhook"
+	TypeWebhookDelivery = "WebhookDelivery"
 )
 
-// AnswerMutation represents an operation that mutates the Answer nodes in the graph.
-type AnswerMutation struct {
+// AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
+type AccessTokenMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *uuid.UUID
-	answer_value    *answer.AnswerValue
+	name            *string
+	token_hash      *[]byte
+	prefix          *string
+	scopes          *[]string
+	appendscopes    []string
 	created_at      *int64
 	addcreated_at   *int64
-	updated_at      *int64
-	addupdated_at   *int64
+	expires_at      *int64
+	addexpires_at   *int64
+	last_used_at    *int64
+	addlast_used_at *int64
 	clearedFields   map[string]struct{}
-	question        *uuid.UUID
-	clearedquestion bool
-	member          *uuid.UUID
-	clearedmember   bool
+	user            *uuid.UUID
+	cleareduser     bool
 	done            bool
-	oldValue        func(context.Context) (*Answer, error)
-	predicates      []predicate.Answer
+	oldValue        func(context.Context) (*AccessToken, error)
+	predicates      []predicate.AccessToken
 }
 
-var _ ent.Mutation = (*AnswerMutation)(nil)
+var _ ent.Mutation = (*AccessTokenMutation)(nil)
 
-// answerOption allows management of the mutation configuration using functional options.
-type answerOption func(*AnswerMutation)
+// accesstokenOption allows management of the mutation configuration using functional options.
+type accesstokenOption func(*AccessTokenMutation)
 
-// newAnswerMutation creates new mutation for the Answer entity.
-func newAnswerMutation(c config, op Op, opts ...answerOption) *AnswerMutation {
-	m := &AnswerMutation{
+// newAccessTokenMutation creates new mutation for the AccessToken entity.
+func newAccessTokenMutation(c config, op Op, opts ...accesstokenOption) *AccessTokenMutation {
+	m := &AccessTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAnswer,
+		typ:           TypeAccessToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -75,20 +89,20 @@ func newAnswerMutation(c config, op Op, opts ...answerOption) *AnswerMutation {
 	return m
 }
 
-// withAnswerID sets the ID field of the mutation.
-func withAnswerID(id uuid.UUID) answerOption {
-	return func(m *AnswerMutation) {
+// withAccessTokenID sets the ID field of the mutation.
+func withAccessTokenID(id uuid.UUID) accesstokenOption {
+	return func(m *AccessTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Answer
+			value *AccessToken
 		)
-		m.oldValue = func(ctx context.Context) (*Answer, error) {
+		m.oldValue = func(ctx context.Context) (*AccessToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Answer.Get(ctx, id)
+					value, err = m.Client().AccessToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -97,10 +111,10 @@ func withAnswerID(id uuid.UUID) answerOption {
 	}
 }
 
-// withAnswer sets the old Answer of the mutation.
-func withAnswer(node *Answer) answerOption {
-	return func(m *AnswerMutation) {
-		m.oldValue = func(context.Context) (*Answer, error) {
+// withAccessToken sets the old AccessToken of the mutation.
+func withAccessToken(node *AccessToken) accesstokenOption {
+	return func(m *AccessTokenMutation) {
+		m.oldValue = func(context.Context) (*AccessToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -109,7 +123,7 @@ func withAnswer(node *Answer) answerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AnswerMutation) Client() *Client {
+func (m AccessTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -117,7 +131,7 @@ func (m AnswerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AnswerMutation) Tx() (*Tx, error) {
+func (m AccessTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -127,14 +141,14 @@ func (m AnswerMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Answer entities.
-func (m *AnswerMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AccessToken entities.
+func (m *AccessTokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AnswerMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AccessTokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -145,7 +159,7 @@ func (m *AnswerMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AnswerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AccessTokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -154,56 +168,179 @@ func (m *AnswerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Answer.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccessToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAnswerValue sets the "answer_value" field.
-func (m *AnswerMutation) SetAnswerValue(av answer.AnswerValue) {
-	m.answer_value = &av
+// SetName sets the "name" field.
+func (m *AccessTokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AnswerValue returns the value of the "answer_value" field in the mutation.
-func (m *AnswerMutation) AnswerValue() (r answer.AnswerValue, exists bool) {
-	v := m.answer_value
+// Name returns the value of the "name" field in the mutation.
+func (m *AccessTokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswerValue returns the old "answer_value" field's value of the Answer entity.
-// If the Answer object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnswerMutation) OldAnswerValue(ctx context.Context) (v answer.AnswerValue, err error) {
+func (m *AccessTokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswerValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswerValue requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswerValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.AnswerValue, nil
+	return oldValue.Name, nil
 }
 
-// ResetAnswerValue resets all changes to the "answer_value" field.
-func (m *AnswerMutation) ResetAnswerValue() {
-	m.answer_value = nil
+// ResetName resets all changes to the "name" field.
+func (m *AccessTokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *AccessTokenMutation) SetTokenHash(b []byte) {
+	m.token_hash = &b
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *AccessTokenMutation) TokenHash() (r []byte, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessTokenMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *AccessTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *AccessTokenMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *AccessTokenMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessTokenMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *AccessTokenMutation) ResetPrefix() {
+	m.prefix = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *AccessTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *AccessTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *AccessTokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *AccessTokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *AccessTokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *AnswerMutation) SetCreatedAt(i int64) {
+func (m *AccessTokenMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AnswerMutation) CreatedAt() (r int64, exists bool) {
+func (m *AccessTokenMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -211,10 +348,10 @@ func (m *AnswerMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Answer entity.
-// If the Answer object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnswerMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *AccessTokenMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -229,7 +366,7 @@ func (m *AnswerMutation) OldCreatedAt(ctx context.Context) (v int64, err error)
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *AnswerMutation) AddCreatedAt(i int64) {
+func (m *AccessTokenMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -238,7 +375,7 @@ func (m *AnswerMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *AnswerMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *AccessTokenMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -247,154 +384,199 @@ func (m *AnswerMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AnswerMutation) ResetCreatedAt() {
+func (m *AccessTokenMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *AnswerMutation) SetUpdatedAt(i int64) {
-	m.updated_at = &i
-	m.addupdated_at = nil
+// SetExpiresAt sets the "expires_at" field.
+func (m *AccessTokenMutation) SetExpiresAt(i int64) {
+	m.expires_at = &i
+	m.addexpires_at = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AnswerMutation) UpdatedAt() (r int64, exists bool) {
-	v := m.updated_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AccessTokenMutation) ExpiresAt() (r int64, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Answer entity.
-// If the Answer object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnswerMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+func (m *AccessTokenMutation) OldExpiresAt(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// AddUpdatedAt adds i to the "updated_at" field.
-func (m *AnswerMutation) AddUpdatedAt(i int64) {
-	if m.addupdated_at != nil {
-		*m.addupdated_at += i
+// AddExpiresAt adds i to the "expires_at" field.
+func (m *AccessTokenMutation) AddExpiresAt(i int64) {
+	if m.addexpires_at != nil {
+		*m.addexpires_at += i
 	} else {
-		m.addupdated_at = &i
+		m.addexpires_at = &i
 	}
 }
 
-// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
-func (m *AnswerMutation) AddedUpdatedAt() (r int64, exists bool) {
-	v := m.addupdated_at
+// AddedExpiresAt returns the value that was added to the "expires_at" field in this mutation.
+func (m *AccessTokenMutation) AddedExpiresAt() (r int64, exists bool) {
+	v := m.addexpires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AnswerMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	m.addupdated_at = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *AccessTokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.addexpires_at = nil
+	m.clearedFields[accesstoken.FieldExpiresAt] = struct{}{}
 }
 
-// SetQuestionID sets the "question" edge to the Question entity by id.
-func (m *AnswerMutation) SetQuestionID(id uuid.UUID) {
-	m.question = &id
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *AccessTokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldExpiresAt]
+	return ok
 }
 
-// ClearQuestion clears the "question" edge to the Question entity.
-func (m *AnswerMutation) ClearQuestion() {
-	m.clearedquestion = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AccessTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	m.addexpires_at = nil
+	delete(m.clearedFields, accesstoken.FieldExpiresAt)
 }
 
-// QuestionCleared reports if the "question" edge to the Question entity was cleared.
-func (m *AnswerMutation) QuestionCleared() bool {
-	return m.clearedquestion
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AccessTokenMutation) SetLastUsedAt(i int64) {
+	m.last_used_at = &i
+	m.addlast_used_at = nil
 }
 
-// QuestionID returns the "question" edge ID in the mutation.
-func (m *AnswerMutation) QuestionID() (id uuid.UUID, exists bool) {
-	if m.question != nil {
-		return *m.question, true
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AccessTokenMutation) LastUsedAt() (r int64, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// QuestionIDs returns the "question" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// QuestionID instead. It exists only for internal usage by the builders.
-func (m *AnswerMutation) QuestionIDs() (ids []uuid.UUID) {
-	if id := m.question; id != nil {
-		ids = append(ids, *id)
+// OldLastUsedAt returns the old "last_used_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessTokenMutation) OldLastUsedAt(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetQuestion resets all changes to the "question" edge.
-func (m *AnswerMutation) ResetQuestion() {
-	m.question = nil
-	m.clearedquestion = false
+// AddLastUsedAt adds i to the "last_used_at" field.
+func (m *AccessTokenMutation) AddLastUsedAt(i int64) {
+	if m.addlast_used_at != nil {
+		*m.addlast_used_at += i
+	} else {
+		m.addlast_used_at = &i
+	}
 }
 
-// SetMemberID sets the "member" edge to the Member entity by id.
-func (m *AnswerMutation) SetMemberID(id uuid.UUID) {
-	m.member = &id
+// AddedLastUsedAt returns the value that was added to the "last_used_at" field in this mutation.
+func (m *AccessTokenMutation) AddedLastUsedAt() (r int64, exists bool) {
+	v := m.addlast_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearMember clears the "member" edge to the Member entity.
-func (m *AnswerMutation) ClearMember() {
-	m.clearedmember = true
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *AccessTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.addlast_used_at = nil
+	m.clearedFields[accesstoken.FieldLastUsedAt] = struct{}{}
 }
 
-// MemberCleared reports if the "member" edge to the Member entity was cleared.
-func (m *AnswerMutation) MemberCleared() bool {
-	return m.clearedmember
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *AccessTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldLastUsedAt]
+	return ok
 }
 
-// MemberID returns the "member" edge ID in the mutation.
-func (m *AnswerMutation) MemberID() (id uuid.UUID, exists bool) {
-	if m.member != nil {
-		return *m.member, true
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AccessTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	m.addlast_used_at = nil
+	delete(m.clearedFields, accesstoken.FieldLastUsedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *AccessTokenMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *AccessTokenMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AccessTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *AccessTokenMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// MemberIDs returns the "member" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MemberID instead. It exists only for internal usage by the builders.
-func (m *AnswerMutation) MemberIDs() (ids []uuid.UUID) {
-	if id := m.member; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AccessTokenMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMember resets all changes to the "member" edge.
-func (m *AnswerMutation) ResetMember() {
-	m.member = nil
-	m.clearedmember = false
+// ResetUser resets all changes to the "user" edge.
+func (m *AccessTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the AnswerMutation builder.
-func (m *AnswerMutation) Where(ps ...predicate.Answer) {
+// Where appends a list predicates to the AccessTokenMutation builder.
+func (m *AccessTokenMutation) Where(ps ...predicate.AccessToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AnswerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AccessTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AnswerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Answer, len(ps))
+func (m *AccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -402,33 +584,45 @@ func (m *AnswerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AnswerMutation) Op() Op {
+func (m *AccessTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AnswerMutation) SetOp(op Op) {
+func (m *AccessTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Answer).
-func (m *AnswerMutation) Type() string {
+// Type returns the node type of this mutation (AccessToken).
+func (m *AccessTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AnswerMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.answer_value != nil {
-		fields = append(fields, answer.FieldAnswerValue)
+func (m *AccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, accesstoken.FieldName)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, accesstoken.FieldTokenHash)
+	}
+	if m.prefix != nil {
+		fields = append(fields, accesstoken.FieldPrefix)
+	}
+	if m.scopes != nil {
+		fields = append(fields, accesstoken.FieldScopes)
 	}
 	if m.created_at != nil {
-		fields = append(fields, answer.FieldCreatedAt)
+		fields = append(fields, accesstoken.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, answer.FieldUpdatedAt)
+	if m.expires_at != nil {
+		fields = append(fields, accesstoken.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, accesstoken.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -436,14 +630,22 @@ func (m *AnswerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AnswerMutation) Field(name string) (ent.Value, bool) {
+func (m *AccessTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case answer.FieldAnswerValue:
-		return m.AnswerValue()
-	case answer.FieldCreatedAt:
+	case accesstoken.FieldName:
+		return m.Name()
+	case accesstoken.FieldTokenHash:
+		return m.TokenHash()
+	case accesstoken.FieldPrefix:
+		return m.Prefix()
+	case accesstoken.FieldScopes:
+		return m.Scopes()
+	case accesstoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case answer.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case accesstoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case accesstoken.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -451,57 +653,96 @@ func (m *AnswerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AnswerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case answer.FieldAnswerValue:
-		return m.OldAnswerValue(ctx)
-	case answer.FieldCreatedAt:
+	case accesstoken.FieldName:
+		return m.OldName(ctx)
+	case accesstoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case accesstoken.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case accesstoken.FieldScopes:
+		return m.OldScopes(ctx)
+	case accesstoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case answer.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case accesstoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case accesstoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Answer field %s", name)
+	return nil, fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AnswerMutation) SetField(name string, value ent.Value) error {
+func (m *AccessTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case answer.FieldAnswerValue:
-		v, ok := value.(answer.AnswerValue)
+	case accesstoken.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnswerValue(v)
+		m.SetName(v)
 		return nil
-	case answer.FieldCreatedAt:
+	case accesstoken.FieldTokenHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case accesstoken.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case accesstoken.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case accesstoken.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case answer.FieldUpdatedAt:
+	case accesstoken.FieldExpiresAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetExpiresAt(v)
+		return nil
+	case accesstoken.FieldLastUsedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Answer field %s", name)
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AnswerMutation) AddedFields() []string {
+func (m *AccessTokenMutation) AddedFields() []string {
 	var fields []string
 	if m.addcreated_at != nil {
-		fields = append(fields, answer.FieldCreatedAt)
+		fields = append(fields, accesstoken.FieldCreatedAt)
 	}
-	if m.addupdated_at != nil {
-		fields = append(fields, answer.FieldUpdatedAt)
+	if m.addexpires_at != nil {
+		fields = append(fields, accesstoken.FieldExpiresAt)
+	}
+	if m.addlast_used_at != nil {
+		fields = append(fields, accesstoken.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -509,12 +750,14 @@ func (m *AnswerMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AnswerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AccessTokenMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case answer.FieldCreatedAt:
+	case accesstoken.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case answer.FieldUpdatedAt:
-		return m.AddedUpdatedAt()
+	case accesstoken.FieldExpiresAt:
+		return m.AddedExpiresAt()
+	case accesstoken.FieldLastUsedAt:
+		return m.AddedLastUsedAt()
 	}
 	return nil, false
 }
@@ -522,84 +765,111 @@ func (m *AnswerMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AnswerMutation) AddField(name string, value ent.Value) error {
+func (m *AccessTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case answer.FieldCreatedAt:
+	case accesstoken.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
-	case answer.FieldUpdatedAt:
+	case accesstoken.FieldExpiresAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUpdatedAt(v)
+		m.AddExpiresAt(v)
+		return nil
+	case accesstoken.FieldLastUsedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Answer numeric field %s", name)
+	return fmt.Errorf("unknown AccessToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AnswerMutation) ClearedFields() []string {
-	return nil
+func (m *AccessTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(accesstoken.FieldExpiresAt) {
+		fields = append(fields, accesstoken.FieldExpiresAt)
+	}
+	if m.FieldCleared(accesstoken.FieldLastUsedAt) {
+		fields = append(fields, accesstoken.FieldLastUsedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AnswerMutation) FieldCleared(name string) bool {
+func (m *AccessTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AnswerMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Answer nullable field %s", name)
+func (m *AccessTokenMutation) ClearField(name string) error {
+	switch name {
+	case accesstoken.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case accesstoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AnswerMutation) ResetField(name string) error {
+func (m *AccessTokenMutation) ResetField(name string) error {
 	switch name {
-	case answer.FieldAnswerValue:
-		m.ResetAnswerValue()
+	case accesstoken.FieldName:
+		m.ResetName()
 		return nil
-	case answer.FieldCreatedAt:
+	case accesstoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case accesstoken.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case accesstoken.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case accesstoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case answer.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case accesstoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case accesstoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Answer field %s", name)
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AnswerMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.question != nil {
-		edges = append(edges, answer.EdgeQuestion)
-	}
-	if m.member != nil {
-		edges = append(edges, answer.EdgeMember)
+func (m *AccessTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, accesstoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AnswerMutation) AddedIDs(name string) []ent.Value {
+func (m *AccessTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case answer.EdgeQuestion:
-		if id := m.question; id != nil {
-			return []ent.Value{*id}
-		}
-	case answer.EdgeMember:
-		if id := m.member; id != nil {
+	case accesstoken.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -607,104 +877,90 @@ func (m *AnswerMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AnswerMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AccessTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AnswerMutation) RemovedIDs(name string) []ent.Value {
+func (m *AccessTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AnswerMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedquestion {
-		edges = append(edges, answer.EdgeQuestion)
-	}
-	if m.clearedmember {
-		edges = append(edges, answer.EdgeMember)
+func (m *AccessTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, accesstoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AnswerMutation) EdgeCleared(name string) bool {
+func (m *AccessTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case answer.EdgeQuestion:
-		return m.clearedquestion
-	case answer.EdgeMember:
-		return m.clearedmember
+	case accesstoken.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AnswerMutation) ClearEdge(name string) error {
+func (m *AccessTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case answer.EdgeQuestion:
-		m.ClearQuestion()
-		return nil
-	case answer.EdgeMember:
-		m.ClearMember()
+	case accesstoken.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Answer unique edge %s", name)
+	return fmt.Errorf("unknown AccessToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AnswerMutation) ResetEdge(name string) error {
+func (m *AccessTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case answer.EdgeQuestion:
-		m.ResetQuestion()
-		return nil
-	case answer.EdgeMember:
-		m.ResetMember()
+	case accesstoken.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Answer edge %s", name)
+	return fmt.Errorf("unknown AccessToken edge %s", name)
 }
 
-// MemberMutation represents an operation that mutates the Member nodes in the graph.
-type MemberMutation struct {
+// AnswerMutation represents an operation that mutates the Answer nodes in the graph.
+type AnswerMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	display_name         *string
-	created_at           *int64
-	addcreated_at        *int64
-	unique_identifier    *string
-	pass_code            *[]byte
-	clearedFields        map[string]struct{}
-	user                 *uuid.UUID
-	cleareduser          bool
-	questionnaire        *uuid.UUID
-	clearedquestionnaire bool
-	answers              map[uuid.UUID]struct{}
-	removedanswers       map[uuid.UUID]struct{}
-	clearedanswers       bool
-	done                 bool
-	oldValue             func(context.Context) (*Member, error)
-	predicates           []predicate.Member
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	answer_value    *answer.AnswerValue
+	created_at      *int64
+	addcreated_at   *int64
+	updated_at      *int64
+	addupdated_at   *int64
+	clearedFields   map[string]struct{}
+	question        *uuid.UUID
+	clearedquestion bool
+	member          *uuid.UUID
+	clearedmember   bool
+	done            bool
+	oldValue        func(context.Context) (*Answer, error)
+	predicates      []predicate.Answer
 }
 
-var _ ent.Mutation = (*MemberMutation)(nil)
+var _ ent.Mutation = (*AnswerMutation)(nil)
 
-// memberOption allows management of the mutation configuration using functional options.
-type memberOption func(*MemberMutation)
+// answerOption allows management of the mutation configuration using functional options.
+type answerOption func(*AnswerMutation)
 
-// newMemberMutation creates new mutation for the Member entity.
-func newMemberMutation(c config, op Op, opts ...memberOption) *MemberMutation {
-	m := &MemberMutation{
+// newAnswerMutation creates new mutation for the Answer entity.
+func newAnswerMutation(c config, op Op, opts ...answerOption) *AnswerMutation {
+	m := &AnswerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMember,
+		typ:           TypeAnswer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -713,20 +969,20 @@ func newMemberMutation(c config, op Op, opts ...memberOption) *MemberMutation {
 	return m
 }
 
-// withMemberID sets the ID field of the mutation.
-func withMemberID(id uuid.UUID) memberOption {
-	return func(m *MemberMutation) {
+// withAnswerID sets the ID field of the mutation.
+func withAnswerID(id uuid.UUID) answerOption {
+	return func(m *AnswerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Member
+			value *Answer
 		)
-		m.oldValue = func(ctx context.Context) (*Member, error) {
+		m.oldValue = func(ctx context.Context) (*Answer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Member.Get(ctx, id)
+					value, err = m.Client().Answer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -735,10 +991,10 @@ func withMemberID(id uuid.UUID) memberOption {
 	}
 }
 
-// withMember sets the old Member of the mutation.
-func withMember(node *Member) memberOption {
-	return func(m *MemberMutation) {
-		m.oldValue = func(context.Context) (*Member, error) {
+// withAnswer sets the old Answer of the mutation.
+func withAnswer(node *Answer) answerOption {
+	return func(m *AnswerMutation) {
+		m.oldValue = func(context.Context) (*Answer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -747,7 +1003,7 @@ func withMember(node *Member) memberOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MemberMutation) Client() *Client {
+func (m AnswerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -755,7 +1011,7 @@ func (m MemberMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MemberMutation) Tx() (*Tx, error) {
+func (m AnswerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -765,14 +1021,14 @@ func (m MemberMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Member entities.
-func (m *MemberMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Answer entities.
+func (m *AnswerMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MemberMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AnswerMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -783,7 +1039,7 @@ func (m *MemberMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AnswerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -792,56 +1048,56 @@ func (m *MemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Member.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Answer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *MemberMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetAnswerValue sets the "answer_value" field.
+func (m *AnswerMutation) SetAnswerValue(av answer.AnswerValue) {
+	m.answer_value = &av
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *MemberMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// AnswerValue returns the value of the "answer_value" field in the mutation.
+func (m *AnswerMutation) AnswerValue() (r answer.AnswerValue, exists bool) {
+	v := m.answer_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Member entity.
-// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// OldAnswerValue returns the old "answer_value" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *AnswerMutation) OldAnswerValue(ctx context.Context) (v answer.AnswerValue, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnswerValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldAnswerValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnswerValue: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.AnswerValue, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *MemberMutation) ResetDisplayName() {
-	m.display_name = nil
+// ResetAnswerValue resets all changes to the "answer_value" field.
+func (m *AnswerMutation) ResetAnswerValue() {
+	m.answer_value = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MemberMutation) SetCreatedAt(i int64) {
+func (m *AnswerMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MemberMutation) CreatedAt() (r int64, exists bool) {
+func (m *AnswerMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -849,10 +1105,10 @@ func (m *MemberMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Member entity.
-// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *AnswerMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -867,7 +1123,7 @@ func (m *MemberMutation) OldCreatedAt(ctx context.Context) (v int64, err error)
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *MemberMutation) AddCreatedAt(i int64) {
+func (m *AnswerMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -876,7 +1132,7 @@ func (m *MemberMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *MemberMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *AnswerMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -885,139 +1141,4413 @@ func (m *MemberMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MemberMutation) ResetCreatedAt() {
+func (m *AnswerMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// SetUniqueIdentifier sets the "unique_identifier" field.
-func (m *MemberMutation) SetUniqueIdentifier(s string) {
-	m.unique_identifier = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AnswerMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
 }
 
-// UniqueIdentifier returns the value of the "unique_identifier" field in the mutation.
-func (m *MemberMutation) UniqueIdentifier() (r string, exists bool) {
-	v := m.unique_identifier
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AnswerMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUniqueIdentifier returns the old "unique_identifier" field's value of the Member entity.
-// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Answer entity.
+// If the Answer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberMutation) OldUniqueIdentifier(ctx context.Context) (v string, err error) {
+func (m *AnswerMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUniqueIdentifier is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUniqueIdentifier requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUniqueIdentifier: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.UniqueIdentifier, nil
-}
-
-// ResetUniqueIdentifier resets all changes to the "unique_identifier" field.
-func (m *MemberMutation) ResetUniqueIdentifier() {
-	m.unique_identifier = nil
+	return oldValue.UpdatedAt, nil
 }
 
-// SetPassCode sets the "pass_code" field.
-func (m *MemberMutation) SetPassCode(b []byte) {
-	m.pass_code = &b
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *AnswerMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
 }
 
-// PassCode returns the value of the "pass_code" field in the mutation.
-func (m *MemberMutation) PassCode() (r []byte, exists bool) {
-	v := m.pass_code
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *AnswerMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassCode returns the old "pass_code" field's value of the Member entity.
-// If the Member object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MemberMutation) OldPassCode(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassCode requires an ID field in the mutation")
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AnswerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
+}
+
+// SetQuestionID sets the "question" edge to the Question entity by id.
+func (m *AnswerMutation) SetQuestionID(id uuid.UUID) {
+	m.question = &id
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *AnswerMutation) ClearQuestion() {
+	m.clearedquestion = true
+}
+
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *AnswerMutation) QuestionCleared() bool {
+	return m.clearedquestion
+}
+
+// QuestionID returns the "question" edge ID in the mutation.
+func (m *AnswerMutation) QuestionID() (id uuid.UUID, exists bool) {
+	if m.question != nil {
+		return *m.question, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassCode: %w", err)
+	return
+}
+
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *AnswerMutation) QuestionIDs() (ids []uuid.UUID) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.PassCode, nil
+	return
 }
 
-// ResetPassCode resets all changes to the "pass_code" field.
-func (m *MemberMutation) ResetPassCode() {
-	m.pass_code = nil
+// ResetQuestion resets all changes to the "question" edge.
+func (m *AnswerMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MemberMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// SetMemberID sets the "member" edge to the Member entity by id.
+func (m *AnswerMutation) SetMemberID(id uuid.UUID) {
+	m.member = &id
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *MemberMutation) ClearUser() {
-	m.cleareduser = true
+// ClearMember clears the "member" edge to the Member entity.
+func (m *AnswerMutation) ClearMember() {
+	m.clearedmember = true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MemberMutation) UserCleared() bool {
-	return m.cleareduser
+// MemberCleared reports if the "member" edge to the Member entity was cleared.
+func (m *AnswerMutation) MemberCleared() bool {
+	return m.clearedmember
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *MemberMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// MemberID returns the "member" edge ID in the mutation.
+func (m *AnswerMutation) MemberID() (id uuid.UUID, exists bool) {
+	if m.member != nil {
+		return *m.member, true
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// MemberIDs returns the "member" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MemberMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
+// MemberID instead. It exists only for internal usage by the builders.
+func (m *AnswerMutation) MemberIDs() (ids []uuid.UUID) {
+	if id := m.member; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *MemberMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetMember resets all changes to the "member" edge.
+func (m *AnswerMutation) ResetMember() {
+	m.member = nil
+	m.clearedmember = false
+}
+
+// Where appends a list predicates to the AnswerMutation builder.
+func (m *AnswerMutation) Where(ps ...predicate.Answer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AnswerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AnswerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Answer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AnswerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AnswerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Answer).
+func (m *AnswerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AnswerMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.answer_value != nil {
+		fields = append(fields, answer.FieldAnswerValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, answer.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, answer.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AnswerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case answer.FieldAnswerValue:
+		return m.AnswerValue()
+	case answer.FieldCreatedAt:
+		return m.CreatedAt()
+	case answer.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AnswerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case answer.FieldAnswerValue:
+		return m.OldAnswerValue(ctx)
+	case answer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case answer.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Answer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnswerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case answer.FieldAnswerValue:
+		v, ok := value.(answer.AnswerValue)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerValue(v)
+		return nil
+	case answer.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case answer.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Answer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AnswerMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, answer.FieldCreatedAt)
+	}
+	if m.addupdated_at != nil {
+		fields = append(fields, answer.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AnswerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case answer.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case answer.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnswerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case answer.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case answer.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Answer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AnswerMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AnswerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AnswerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Answer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AnswerMutation) ResetField(name string) error {
+	switch name {
+	case answer.FieldAnswerValue:
+		m.ResetAnswerValue()
+		return nil
+	case answer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case answer.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Answer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AnswerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.question != nil {
+		edges = append(edges, answer.EdgeQuestion)
+	}
+	if m.member != nil {
+		edges = append(edges, answer.EdgeMember)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AnswerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case answer.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
+		}
+	case answer.EdgeMember:
+		if id := m.member; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AnswerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AnswerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AnswerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedquestion {
+		edges = append(edges, answer.EdgeQuestion)
+	}
+	if m.clearedmember {
+		edges = append(edges, answer.EdgeMember)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AnswerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case answer.EdgeQuestion:
+		return m.clearedquestion
+	case answer.EdgeMember:
+		return m.clearedmember
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AnswerMutation) ClearEdge(name string) error {
+	switch name {
+	case answer.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
+	case answer.EdgeMember:
+		m.ClearMember()
+		return nil
+	}
+	return fmt.Errorf("unknown Answer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AnswerMutation) ResetEdge(name string) error {
+	switch name {
+	case answer.EdgeQuestion:
+		m.ResetQuestion()
+		return nil
+	case answer.EdgeMember:
+		m.ResetMember()
+		return nil
+	}
+	return fmt.Errorf("unknown Answer edge %s", name)
+}
+
+// MemberMutation represents an operation that mutates the Member nodes in the graph.
+type MemberMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	display_name         *string
+	created_at           *int64
+	addcreated_at        *int64
+	unique_identifier    *string
+	completed_at         *int64
+	addcompleted_at      *int64
+	pass_code            *[]byte
+	clearedFields        map[string]struct{}
+	user                 *uuid.UUID
+	cleareduser          bool
+	questionnaire        *uuid.UUID
+	clearedquestionnaire bool
+	answers              map[uuid.UUID]struct{}
+	removedanswers       map[uuid.UUID]struct{}
+	clearedanswers       bool
+	done                 bool
+	oldValue             func(context.Context) (*Member, error)
+	predicates           []predicate.Member
+}
+
+var _ ent.Mutation = (*MemberMutation)(nil)
+
+// memberOption allows management of the mutation configuration using functional options.
+type memberOption func(*MemberMutation)
+
+// newMemberMutation creates new mutation for the Member entity.
+func newMemberMutation(c config, op Op, opts ...memberOption) *MemberMutation {
+	m := &MemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemberID sets the ID field of the mutation.
+func withMemberID(id uuid.UUID) memberOption {
+	return func(m *MemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Member
+		)
+		m.oldValue = func(ctx context.Context) (*Member, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Member.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMember sets the old Member of the mutation.
+func withMember(node *Member) memberOption {
+	return func(m *MemberMutation) {
+		m.oldValue = func(context.Context) (*Member, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Member entities.
+func (m *MemberMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemberMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Member.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *MemberMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *MemberMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *MemberMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MemberMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MemberMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *MemberMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *MemberMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MemberMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetUniqueIdentifier sets the "unique_identifier" field.
+func (m *MemberMutation) SetUniqueIdentifier(s string) {
+	m.unique_identifier = &s
+}
+
+// UniqueIdentifier returns the value of the "unique_identifier" field in the mutation.
+func (m *MemberMutation) UniqueIdentifier() (r string, exists bool) {
+	v := m.unique_identifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUniqueIdentifier returns the old "unique_identifier" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldUniqueIdentifier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUniqueIdentifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUniqueIdentifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUniqueIdentifier: %w", err)
+	}
+	return oldValue.UniqueIdentifier, nil
+}
+
+// ResetUniqueIdentifier resets all changes to the "unique_identifier" field.
+func (m *MemberMutation) ResetUniqueIdentifier() {
+	m.unique_identifier = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *MemberMutation) SetCompletedAt(i int64) {
+	m.completed_at = &i
+	m.addcompleted_at = nil
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *MemberMutation) CompletedAt() (r int64, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldCompletedAt(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// AddCompletedAt adds i to the "completed_at" field.
+func (m *MemberMutation) AddCompletedAt(i int64) {
+	if m.addcompleted_at != nil {
+		*m.addcompleted_at += i
+	} else {
+		m.addcompleted_at = &i
+	}
+}
+
+// AddedCompletedAt returns the value that was added to the "completed_at" field in this mutation.
+func (m *MemberMutation) AddedCompletedAt() (r int64, exists bool) {
+	v := m.addcompleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *MemberMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.addcompleted_at = nil
+	m.clearedFields[member.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *MemberMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[member.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *MemberMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	m.addcompleted_at = nil
+	delete(m.clearedFields, member.FieldCompletedAt)
+}
+
+// SetPassCode sets the "pass_code" field.
+func (m *MemberMutation) SetPassCode(b []byte) {
+	m.pass_code = &b
+}
+
+// PassCode returns the value of the "pass_code" field in the mutation.
+func (m *MemberMutation) PassCode() (r []byte, exists bool) {
+	v := m.pass_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassCode returns the old "pass_code" field's value of the Member entity.
+// If the Member object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemberMutation) OldPassCode(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassCode: %w", err)
+	}
+	return oldValue.PassCode, nil
+}
+
+// ResetPassCode resets all changes to the "pass_code" field.
+func (m *MemberMutation) ResetPassCode() {
+	m.pass_code = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MemberMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MemberMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MemberMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MemberMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by id.
+func (m *MemberMutation) SetQuestionnaireID(id uuid.UUID) {
+	m.questionnaire = &id
+}
+
+// ClearQuestionnaire clears the "questionnaire" edge to the Questionnaire entity.
+func (m *MemberMutation) ClearQuestionnaire() {
+	m.clearedquestionnaire = true
+}
+
+// QuestionnaireCleared reports if the "questionnaire" edge to the Questionnaire entity was cleared.
+func (m *MemberMutation) QuestionnaireCleared() bool {
+	return m.clearedquestionnaire
+}
+
+// QuestionnaireID returns the "questionnaire" edge ID in the mutation.
+func (m *MemberMutation) QuestionnaireID() (id uuid.UUID, exists bool) {
+	if m.questionnaire != nil {
+		return *m.questionnaire, true
+	}
+	return
+}
+
+// QuestionnaireIDs returns the "questionnaire" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionnaireID instead. It exists only for internal usage by the builders.
+func (m *MemberMutation) QuestionnaireIDs() (ids []uuid.UUID) {
+	if id := m.questionnaire; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuestionnaire resets all changes to the "questionnaire" edge.
+func (m *MemberMutation) ResetQuestionnaire() {
+	m.questionnaire = nil
+	m.clearedquestionnaire = false
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *MemberMutation) AddAnswerIDs(ids ...uuid.UUID) {
+	if m.answers == nil {
+		m.answers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *MemberMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *MemberMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *MemberMutation) RemoveAnswerIDs(ids ...uuid.UUID) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *MemberMutation) RemovedAnswersIDs() (ids []uuid.UUID) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *MemberMutation) AnswersIDs() (ids []uuid.UUID) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *MemberMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// Where appends a list predicates to the MemberMutation builder.
+func (m *MemberMutation) Where(ps ...predicate.Member) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Member, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Member).
+func (m *MemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MemberMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.display_name != nil {
+		fields = append(fields, member.FieldDisplayName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, member.FieldCreatedAt)
+	}
+	if m.unique_identifier != nil {
+		fields = append(fields, member.FieldUniqueIdentifier)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, member.FieldCompletedAt)
+	}
+	if m.pass_code != nil {
+		fields = append(fields, member.FieldPassCode)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case member.FieldDisplayName:
+		return m.DisplayName()
+	case member.FieldCreatedAt:
+		return m.CreatedAt()
+	case member.FieldUniqueIdentifier:
+		return m.UniqueIdentifier()
+	case member.FieldCompletedAt:
+		return m.CompletedAt()
+	case member.FieldPassCode:
+		return m.PassCode()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case member.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case member.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case member.FieldUniqueIdentifier:
+		return m.OldUniqueIdentifier(ctx)
+	case member.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case member.FieldPassCode:
+		return m.OldPassCode(ctx)
+	}
+	return nil, fmt.Errorf("unknown Member field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case member.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case member.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case member.FieldUniqueIdentifier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUniqueIdentifier(v)
+		return nil
+	case member.FieldCompletedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case member.FieldPassCode:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Member field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MemberMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, member.FieldCreatedAt)
+	}
+	if m.addcompleted_at != nil {
+		fields = append(fields, member.FieldCompletedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MemberMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case member.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case member.FieldCompletedAt:
+		return m.AddedCompletedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case member.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case member.FieldCompletedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Member numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MemberMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(member.FieldCompletedAt) {
+		fields = append(fields, member.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MemberMutation) ClearField(name string) error {
+	switch name {
+	case member.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Member nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MemberMutation) ResetField(name string) error {
+	switch name {
+	case member.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case member.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case member.FieldUniqueIdentifier:
+		m.ResetUniqueIdentifier()
+		return nil
+	case member.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case member.FieldPassCode:
+		m.ResetPassCode()
+		return nil
+	}
+	return fmt.Errorf("unknown Member field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, member.EdgeUser)
+	}
+	if m.questionnaire != nil {
+		edges = append(edges, member.EdgeQuestionnaire)
+	}
+	if m.answers != nil {
+		edges = append(edges, member.EdgeAnswers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case member.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case member.EdgeQuestionnaire:
+		if id := m.questionnaire; id != nil {
+			return []ent.Value{*id}
+		}
+	case member.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedanswers != nil {
+		edges = append(edges, member.EdgeAnswers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MemberMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case member.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, member.EdgeUser)
+	}
+	if m.clearedquestionnaire {
+		edges = append(edges, member.EdgeQuestionnaire)
+	}
+	if m.clearedanswers {
+		edges = append(edges, member.EdgeAnswers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case member.EdgeUser:
+		return m.cleareduser
+	case member.EdgeQuestionnaire:
+		return m.clearedquestionnaire
+	case member.EdgeAnswers:
+		return m.clearedanswers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MemberMutation) ClearEdge(name string) error {
+	switch name {
+	case member.EdgeUser:
+		m.ClearUser()
+		return nil
+	case member.EdgeQuestionnaire:
+		m.ClearQuestionnaire()
+		return nil
+	}
+	return fmt.Errorf("unknown Member unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MemberMutation) ResetEdge(name string) error {
+	switch name {
+	case member.EdgeUser:
+		m.ResetUser()
+		return nil
+	case member.EdgeQuestionnaire:
+		m.ResetQuestionnaire()
+		return nil
+	case member.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	}
+	return fmt.Errorf("unknown Member edge %s", name)
+}
+
+// QuestionMutation represents an operation that mutates the Question nodes in the graph.
+type QuestionMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *int64
+	addcreated_at        *int64
+	text                 *string
+	required             *bool
+	position             *int
+	addposition          *int
+	version              *int
+	addversion           *int
+	clearedFields        map[string]struct{}
+	questionnaire        *uuid.UUID
+	clearedquestionnaire bool
+	section              *uuid.UUID
+	clearedsection       bool
+	answers              map[uuid.UUID]struct{}
+	removedanswers       map[uuid.UUID]struct{}
+	clearedanswers       bool
+	rules                map[uuid.UUID]struct{}
+	removedrules         map[uuid.UUID]struct{}
+	clearedrules         bool
+	dependents           map[uuid.UUID]struct{}
+	removeddependents    map[uuid.UUID]struct{}
+	cleareddependents    bool
+	done                 bool
+	oldValue             func(context.Context) (*Question, error)
+	predicates           []predicate.Question
+}
+
+var _ ent.Mutation = (*QuestionMutation)(nil)
+
+// questionOption allows management of the mutation configuration using functional options.
+type questionOption func(*QuestionMutation)
+
+// newQuestionMutation creates new mutation for the Question entity.
+func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
+	m := &QuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionID sets the ID field of the mutation.
+func withQuestionID(id uuid.UUID) questionOption {
+	return func(m *QuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Question
+		)
+		m.oldValue = func(ctx context.Context) (*Question, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Question.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestion sets the old Question of the mutation.
+func withQuestion(node *Question) questionOption {
+	return func(m *QuestionMutation) {
+		m.oldValue = func(context.Context) (*Question, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Question entities.
+func (m *QuestionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestionMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestionMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *QuestionMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *QuestionMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetText sets the "text" field.
+func (m *QuestionMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *QuestionMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *QuestionMutation) ResetText() {
+	m.text = nil
+}
+
+// SetRequired sets the "required" field.
+func (m *QuestionMutation) SetRequired(b bool) {
+	m.required = &b
+}
+
+// Required returns the value of the "required" field in the mutation.
+func (m *QuestionMutation) Required() (r bool, exists bool) {
+	v := m.required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequired returns the old "required" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequired: %w", err)
+	}
+	return oldValue.Required, nil
+}
+
+// ResetRequired resets all changes to the "required" field.
+func (m *QuestionMutation) ResetRequired() {
+	m.required = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *QuestionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *QuestionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *QuestionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *QuestionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *QuestionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *QuestionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *QuestionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *QuestionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *QuestionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *QuestionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by id.
+func (m *QuestionMutation) SetQuestionnaireID(id uuid.UUID) {
+	m.questionnaire = &id
+}
+
+// ClearQuestionnaire clears the "questionnaire" edge to the Questionnaire entity.
+func (m *QuestionMutation) ClearQuestionnaire() {
+	m.clearedquestionnaire = true
+}
+
+// QuestionnaireCleared reports if the "questionnaire" edge to the Questionnaire entity was cleared.
+func (m *QuestionMutation) QuestionnaireCleared() bool {
+	return m.clearedquestionnaire
+}
+
+// QuestionnaireID returns the "questionnaire" edge ID in the mutation.
+func (m *QuestionMutation) QuestionnaireID() (id uuid.UUID, exists bool) {
+	if m.questionnaire != nil {
+		return *m.questionnaire, true
+	}
+	return
+}
+
+// QuestionnaireIDs returns the "questionnaire" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionnaireID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) QuestionnaireIDs() (ids []uuid.UUID) {
+	if id := m.questionnaire; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuestionnaire resets all changes to the "questionnaire" edge.
+func (m *QuestionMutation) ResetQuestionnaire() {
+	m.questionnaire = nil
+	m.clearedquestionnaire = false
+}
+
+// SetSectionID sets the "section" edge to the Section entity by id.
+func (m *QuestionMutation) SetSectionID(id uuid.UUID) {
+	m.section = &id
+}
+
+// ClearSection clears the "section" edge to the Section entity.
+func (m *QuestionMutation) ClearSection() {
+	m.clearedsection = true
+}
+
+// SectionCleared reports if the "section" edge to the Section entity was cleared.
+func (m *QuestionMutation) SectionCleared() bool {
+	return m.clearedsection
+}
+
+// SectionID returns the "section" edge ID in the mutation.
+func (m *QuestionMutation) SectionID() (id uuid.UUID, exists bool) {
+	if m.section != nil {
+		return *m.section, true
+	}
+	return
+}
+
+// SectionIDs returns the "section" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SectionID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) SectionIDs() (ids []uuid.UUID) {
+	if id := m.section; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSection resets all changes to the "section" edge.
+func (m *QuestionMutation) ResetSection() {
+	m.section = nil
+	m.clearedsection = false
+}
+
+// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
+func (m *QuestionMutation) AddAnswerIDs(ids ...uuid.UUID) {
+	if m.answers == nil {
+		m.answers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the Answer entity.
+func (m *QuestionMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
+func (m *QuestionMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
+func (m *QuestionMutation) RemoveAnswerIDs(ids ...uuid.UUID) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
+func (m *QuestionMutation) RemovedAnswersIDs() (ids []uuid.UUID) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *QuestionMutation) AnswersIDs() (ids []uuid.UUID) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *QuestionMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// AddRuleIDs adds the "rules" edge to the Rule entity by ids.
+func (m *QuestionMutation) AddRuleIDs(ids ...uuid.UUID) {
+	if m.rules == nil {
+		m.rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRules clears the "rules" edge to the Rule entity.
+func (m *QuestionMutation) ClearRules() {
+	m.clearedrules = true
+}
+
+// RulesCleared reports if the "rules" edge to the Rule entity was cleared.
+func (m *QuestionMutation) RulesCleared() bool {
+	return m.clearedrules
+}
+
+// RemoveRuleIDs removes the "rules" edge to the Rule entity by IDs.
+func (m *QuestionMutation) RemoveRuleIDs(ids ...uuid.UUID) {
+	if m.removedrules == nil {
+		m.removedrules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.rules, ids[i])
+		m.removedrules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRules returns the removed IDs of the "rules" edge to the Rule entity.
+func (m *QuestionMutation) RemovedRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RulesIDs returns the "rules" edge IDs in the mutation.
+func (m *QuestionMutation) RulesIDs() (ids []uuid.UUID) {
+	for id := range m.rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRules resets all changes to the "rules" edge.
+func (m *QuestionMutation) ResetRules() {
+	m.rules = nil
+	m.clearedrules = false
+	m.removedrules = nil
+}
+
+// AddDependentIDs adds the "dependents" edge to the Rule entity by ids.
+func (m *QuestionMutation) AddDependentIDs(ids ...uuid.UUID) {
+	if m.dependents == nil {
+		m.dependents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.dependents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDependents clears the "dependents" edge to the Rule entity.
+func (m *QuestionMutation) ClearDependents() {
+	m.cleareddependents = true
+}
+
+// DependentsCleared reports if the "dependents" edge to the Rule entity was cleared.
+func (m *QuestionMutation) DependentsCleared() bool {
+	return m.cleareddependents
+}
+
+// RemoveDependentIDs removes the "dependents" edge to the Rule entity by IDs.
+func (m *QuestionMutation) RemoveDependentIDs(ids ...uuid.UUID) {
+	if m.removeddependents == nil {
+		m.removeddependents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.dependents, ids[i])
+		m.removeddependents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDependents returns the removed IDs of the "dependents" edge to the Rule entity.
+func (m *QuestionMutation) RemovedDependentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddependents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DependentsIDs returns the "dependents" edge IDs in the mutation.
+func (m *QuestionMutation) DependentsIDs() (ids []uuid.UUID) {
+	for id := range m.dependents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDependents resets all changes to the "dependents" edge.
+func (m *QuestionMutation) ResetDependents() {
+	m.dependents = nil
+	m.cleareddependents = false
+	m.removeddependents = nil
+}
+
+// Where appends a list predicates to the QuestionMutation builder.
+func (m *QuestionMutation) Where(ps ...predicate.Question) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Question, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Question).
+func (m *QuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, question.FieldCreatedAt)
+	}
+	if m.text != nil {
+		fields = append(fields, question.FieldText)
+	}
+	if m.required != nil {
+		fields = append(fields, question.FieldRequired)
+	}
+	if m.position != nil {
+		fields = append(fields, question.FieldPosition)
+	}
+	if m.version != nil {
+		fields = append(fields, question.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldCreatedAt:
+		return m.CreatedAt()
+	case question.FieldText:
+		return m.Text()
+	case question.FieldRequired:
+		return m.Required()
+	case question.FieldPosition:
+		return m.Position()
+	case question.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case question.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case question.FieldText:
+		return m.OldText(ctx)
+	case question.FieldRequired:
+		return m.OldRequired(ctx)
+	case question.FieldPosition:
+		return m.OldPosition(ctx)
+	case question.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown Question field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case question.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case question.FieldRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequired(v)
+		return nil
+	case question.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case question.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, question.FieldCreatedAt)
+	}
+	if m.addposition != nil {
+		fields = append(fields, question.FieldPosition)
+	}
+	if m.addversion != nil {
+		fields = append(fields, question.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case question.FieldPosition:
+		return m.AddedPosition()
+	case question.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case question.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case question.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Question nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionMutation) ResetField(name string) error {
+	switch name {
+	case question.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case question.FieldText:
+		m.ResetText()
+		return nil
+	case question.FieldRequired:
+		m.ResetRequired()
+		return nil
+	case question.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case question.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.questionnaire != nil {
+		edges = append(edges, question.EdgeQuestionnaire)
+	}
+	if m.section != nil {
+		edges = append(edges, question.EdgeSection)
+	}
+	if m.answers != nil {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	if m.rules != nil {
+		edges = append(edges, question.EdgeRules)
+	}
+	if m.dependents != nil {
+		edges = append(edges, question.EdgeDependents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeQuestionnaire:
+		if id := m.questionnaire; id != nil {
+			return []ent.Value{*id}
+		}
+	case question.EdgeSection:
+		if id := m.section; id != nil {
+			return []ent.Value{*id}
+		}
+	case question.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.rules))
+		for id := range m.rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeDependents:
+		ids := make([]ent.Value, 0, len(m.dependents))
+		for id := range m.dependents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedanswers != nil {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	if m.removedrules != nil {
+		edges = append(edges, question.EdgeRules)
+	}
+	if m.removeddependents != nil {
+		edges = append(edges, question.EdgeDependents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.removedrules))
+		for id := range m.removedrules {
+			ids = append(ids, id)
+		}
+		return ids
+	case question.EdgeDependents:
+		ids := make([]ent.Value, 0, len(m.removeddependents))
+		for id := range m.removeddependents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedquestionnaire {
+		edges = append(edges, question.EdgeQuestionnaire)
+	}
+	if m.clearedsection {
+		edges = append(edges, question.EdgeSection)
+	}
+	if m.clearedanswers {
+		edges = append(edges, question.EdgeAnswers)
+	}
+	if m.clearedrules {
+		edges = append(edges, question.EdgeRules)
+	}
+	if m.cleareddependents {
+		edges = append(edges, question.EdgeDependents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case question.EdgeQuestionnaire:
+		return m.clearedquestionnaire
+	case question.EdgeSection:
+		return m.clearedsection
+	case question.EdgeAnswers:
+		return m.clearedanswers
+	case question.EdgeRules:
+		return m.clearedrules
+	case question.EdgeDependents:
+		return m.cleareddependents
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case question.EdgeQuestionnaire:
+		m.ClearQuestionnaire()
+		return nil
+	case question.EdgeSection:
+		m.ClearSection()
+		return nil
+	}
+	return fmt.Errorf("unknown Question unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case question.EdgeQuestionnaire:
+		m.ResetQuestionnaire()
+		return nil
+	case question.EdgeSection:
+		m.ResetSection()
+		return nil
+	case question.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	case question.EdgeRules:
+		m.ResetRules()
+		return nil
+	case question.EdgeDependents:
+		m.ResetDependents()
+		return nil
+	}
+	return fmt.Errorf("unknown Question edge %s", name)
+}
+
+// QuestionnaireMutation represents an operation that mutates the Questionnaire nodes in the graph.
+type QuestionnaireMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	title             *string
+	description       *string
+	is_published      *bool
+	lock_after_submit *bool
+	created_at        *int64
+	addcreated_at     *int64
+	closed_at         *int64
+	addclosed_at      *int64
+	version           *int
+	addversion        *int
+	clearedFields     map[string]struct{}
+	owner             *uuid.UUID
+	clearedowner      bool
+	members           map[uuid.UUID]struct{}
+	removedmembers    map[uuid.UUID]struct{}
+	clearedmembers    bool
+	questions         map[uuid.UUID]struct{}
+	removedquestions  map[uuid.UUID]struct{}
+	clearedquestions  bool
+	sections          map[uuid.UUID]struct{}
+	removedsections   map[uuid.UUID]struct{}
+	clearedsections   bool
+	webhooks          map[uuid.UUID]struct{}
+	removedwebhooks   map[uuid.UUID]struct{}
+	clearedwebhooks   bool
+	done              bool
+	oldValue          func(context.Context) (*Questionnaire, error)
+	predicates        []predicate.Questionnaire
+}
+
+var _ ent.Mutation = (*QuestionnaireMutation)(nil)
+
+// questionnaireOption allows management of the mutation configuration using functional options.
+type questionnaireOption func(*QuestionnaireMutation)
+
+// newQuestionnaireMutation creates new mutation for the Questionnaire entity.
+func newQuestionnaireMutation(c config, op Op, opts ...questionnaireOption) *QuestionnaireMutation {
+	m := &QuestionnaireMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestionnaire,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionnaireID sets the ID field of the mutation.
+func withQuestionnaireID(id uuid.UUID) questionnaireOption {
+	return func(m *QuestionnaireMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Questionnaire
+		)
+		m.oldValue = func(ctx context.Context) (*Questionnaire, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Questionnaire.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestionnaire sets the old Questionnaire of the mutation.
+func withQuestionnaire(node *Questionnaire) questionnaireOption {
+	return func(m *QuestionnaireMutation) {
+		m.oldValue = func(context.Context) (*Questionnaire, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionnaireMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionnaireMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Questionnaire entities.
+func (m *QuestionnaireMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionnaireMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionnaireMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Questionnaire.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *QuestionnaireMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *QuestionnaireMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Questionnaire entity.
+// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *QuestionnaireMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *QuestionnaireMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *QuestionnaireMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Questionnaire entity.
+// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *QuestionnaireMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[questionnaire.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *QuestionnaireMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[questionnaire.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *QuestionnaireMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, questionnaire.FieldDescription)
+}
+
+// SetIsPublished sets the "is_published" field.
+func (m *QuestionnaireMutation) SetIsPublished(b bool) {
+	m.is_published = &b
+}
+
+// IsPublished returns the value of the "is_published" field in the mutation.
+func (m *QuestionnaireMutation) IsPublished() (r bool, exists bool) {
+	v := m.is_published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublished returns the old "is_published" field's value of the Questionnaire entity.
+// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireMutation) OldIsPublished(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublished: %w", err)
+	}
+	return oldValue.IsPublished, nil
+}
+
+// ResetIsPublished resets all changes to the "is_published" field.
+func (m *QuestionnaireMutation) ResetIsPublished() {
+	m.is_published = nil
+}
+
+// SetLockAfterSubmit sets the "lock_after_submit" field.
+func (m *QuestionnaireMutation) SetLockAfterSubmit(b bool) {
+	m.lock_after_submit = &b
+}
+
+// LockAfterSubmit returns the value of the "lock_after_submit" field in the mutation.
+func (m *QuestionnaireMutation) LockAfterSubmit() (r bool, exists bool) {
+	v := m.lock_after_submit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockAfterSubmit returns the old "lock_after_submit" field's value of the Questionnaire entity.
+// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireMutation) OldLockAfterSubmit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockAfterSubmit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockAfterSubmit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockAfterSubmit: %w", err)
+	}
+	return oldValue.LockAfterSubmit, nil
+}
+
+// ResetLockAfterSubmit resets all changes to the "lock_after_submit" field.
+func (m *QuestionnaireMutation) ResetLockAfterSubmit() {
+	m.lock_after_submit = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestionnaireMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestionnaireMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Questionnaire entity.
+// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *QuestionnaireMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *QuestionnaireMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestionnaireMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *QuestionnaireMutation) SetClosedAt(i int64) {
+	m.closed_at = &i
+	m.addclosed_at = nil
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *QuestionnaireMutation) ClosedAt() (r int64, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the Questionnaire entity.
+// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireMutation) OldClosedAt(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// AddClosedAt adds i to the "closed_at" field.
+func (m *QuestionnaireMutation) AddClosedAt(i int64) {
+	if m.addclosed_at != nil {
+		*m.addclosed_at += i
+	} else {
+		m.addclosed_at = &i
+	}
+}
+
+// AddedClosedAt returns the value that was added to the "closed_at" field in this mutation.
+func (m *QuestionnaireMutation) AddedClosedAt() (r int64, exists bool) {
+	v := m.addclosed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *QuestionnaireMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.addclosed_at = nil
+	m.clearedFields[questionnaire.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *QuestionnaireMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[questionnaire.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *QuestionnaireMutation) ResetClosedAt() {
+	m.closed_at = nil
+	m.addclosed_at = nil
+	delete(m.clearedFields, questionnaire.FieldClosedAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *QuestionnaireMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *QuestionnaireMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Questionnaire entity.
+// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *QuestionnaireMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *QuestionnaireMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *QuestionnaireMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *QuestionnaireMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *QuestionnaireMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *QuestionnaireMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *QuestionnaireMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *QuestionnaireMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddMemberIDs adds the "members" edge to the Member entity by ids.
+func (m *QuestionnaireMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the Member entity.
+func (m *QuestionnaireMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the Member entity was cleared.
+func (m *QuestionnaireMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the Member entity by IDs.
+func (m *QuestionnaireMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the Member entity.
+func (m *QuestionnaireMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *QuestionnaireMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *QuestionnaireMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
+func (m *QuestionnaireMutation) AddQuestionIDs(ids ...uuid.UUID) {
+	if m.questions == nil {
+		m.questions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestions clears the "questions" edge to the Question entity.
+func (m *QuestionnaireMutation) ClearQuestions() {
+	m.clearedquestions = true
+}
+
+// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
+func (m *QuestionnaireMutation) QuestionsCleared() bool {
+	return m.clearedquestions
+}
+
+// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
+func (m *QuestionnaireMutation) RemoveQuestionIDs(ids ...uuid.UUID) {
+	if m.removedquestions == nil {
+		m.removedquestions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.questions, ids[i])
+		m.removedquestions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
+func (m *QuestionnaireMutation) RemovedQuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedquestions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionsIDs returns the "questions" edge IDs in the mutation.
+func (m *QuestionnaireMutation) QuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestions resets all changes to the "questions" edge.
+func (m *QuestionnaireMutation) ResetQuestions() {
+	m.questions = nil
+	m.clearedquestions = false
+	m.removedquestions = nil
+}
+
+// AddSectionIDs adds the "sections" edge to the Section entity by ids.
+func (m *QuestionnaireMutation) AddSectionIDs(ids ...uuid.UUID) {
+	if m.sections == nil {
+		m.sections = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sections[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSections clears the "sections" edge to the Section entity.
+func (m *QuestionnaireMutation) ClearSections() {
+	m.clearedsections = true
+}
+
+// SectionsCleared reports if the "sections" edge to the Section entity was cleared.
+func (m *QuestionnaireMutation) SectionsCleared() bool {
+	return m.clearedsections
+}
+
+// RemoveSectionIDs removes the "sections" edge to the Section entity by IDs.
+func (m *QuestionnaireMutation) RemoveSectionIDs(ids ...uuid.UUID) {
+	if m.removedsections == nil {
+		m.removedsections = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sections, ids[i])
+		m.removedsections[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSections returns the removed IDs of the "sections" edge to the Section entity.
+func (m *QuestionnaireMutation) RemovedSectionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SectionsIDs returns the "sections" edge IDs in the mutation.
+func (m *QuestionnaireMutation) SectionsIDs() (ids []uuid.UUID) {
+	for id := range m.sections {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSections resets all changes to the "sections" edge.
+func (m *QuestionnaireMutation) ResetSections() {
+	m.sections = nil
+	m.clearedsections = false
+	m.removedsections = nil
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by ids.
+func (m *QuestionnaireMutation) AddWebhookIDs(ids ...uuid.UUID) {
+	if m.webhooks == nil {
+		m.webhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webhooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhooks clears the "webhooks" edge to the Webhook entity.
+func (m *QuestionnaireMutation) ClearWebhooks() {
+	m.clearedwebhooks = true
+}
+
+// WebhooksCleared reports if the "webhooks" edge to the Webhook entity was cleared.
+func (m *QuestionnaireMutation) WebhooksCleared() bool {
+	return m.clearedwebhooks
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to the Webhook entity by IDs.
+func (m *QuestionnaireMutation) RemoveWebhookIDs(ids ...uuid.UUID) {
+	if m.removedwebhooks == nil {
+		m.removedwebhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.webhooks, ids[i])
+		m.removedwebhooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhooks returns the removed IDs of the "webhooks" edge to the Webhook entity.
+func (m *QuestionnaireMutation) RemovedWebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhooksIDs returns the "webhooks" edge IDs in the mutation.
+func (m *QuestionnaireMutation) WebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.webhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhooks resets all changes to the "webhooks" edge.
+func (m *QuestionnaireMutation) ResetWebhooks() {
+	m.webhooks = nil
+	m.clearedwebhooks = false
+	m.removedwebhooks = nil
+}
+
+// Where appends a list predicates to the QuestionnaireMutation builder.
+func (m *QuestionnaireMutation) Where(ps ...predicate.Questionnaire) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionnaireMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionnaireMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Questionnaire, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionnaireMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionnaireMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Questionnaire).
+func (m *QuestionnaireMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionnaireMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.title != nil {
+		fields = append(fields, questionnaire.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, questionnaire.FieldDescription)
+	}
+	if m.is_published != nil {
+		fields = append(fields, questionnaire.FieldIsPublished)
+	}
+	if m.lock_after_submit != nil {
+		fields = append(fields, questionnaire.FieldLockAfterSubmit)
+	}
+	if m.created_at != nil {
+		fields = append(fields, questionnaire.FieldCreatedAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, questionnaire.FieldClosedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, questionnaire.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionnaireMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case questionnaire.FieldTitle:
+		return m.Title()
+	case questionnaire.FieldDescription:
+		return m.Description()
+	case questionnaire.FieldIsPublished:
+		return m.IsPublished()
+	case questionnaire.FieldLockAfterSubmit:
+		return m.LockAfterSubmit()
+	case questionnaire.FieldCreatedAt:
+		return m.CreatedAt()
+	case questionnaire.FieldClosedAt:
+		return m.ClosedAt()
+	case questionnaire.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionnaireMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case questionnaire.FieldTitle:
+		return m.OldTitle(ctx)
+	case questionnaire.FieldDescription:
+		return m.OldDescription(ctx)
+	case questionnaire.FieldIsPublished:
+		return m.OldIsPublished(ctx)
+	case questionnaire.FieldLockAfterSubmit:
+		return m.OldLockAfterSubmit(ctx)
+	case questionnaire.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case questionnaire.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case questionnaire.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown Questionnaire field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionnaireMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case questionnaire.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case questionnaire.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case questionnaire.FieldIsPublished:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublished(v)
+		return nil
+	case questionnaire.FieldLockAfterSubmit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockAfterSubmit(v)
+		return nil
+	case questionnaire.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case questionnaire.FieldClosedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	case questionnaire.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Questionnaire field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionnaireMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, questionnaire.FieldCreatedAt)
+	}
+	if m.addclosed_at != nil {
+		fields = append(fields, questionnaire.FieldClosedAt)
+	}
+	if m.addversion != nil {
+		fields = append(fields, questionnaire.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionnaireMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case questionnaire.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case questionnaire.FieldClosedAt:
+		return m.AddedClosedAt()
+	case questionnaire.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionnaireMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case questionnaire.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case questionnaire.FieldClosedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClosedAt(v)
+		return nil
+	case questionnaire.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Questionnaire numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionnaireMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(questionnaire.FieldDescription) {
+		fields = append(fields, questionnaire.FieldDescription)
+	}
+	if m.FieldCleared(questionnaire.FieldClosedAt) {
+		fields = append(fields, questionnaire.FieldClosedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionnaireMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionnaireMutation) ClearField(name string) error {
+	switch name {
+	case questionnaire.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case questionnaire.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Questionnaire nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionnaireMutation) ResetField(name string) error {
+	switch name {
+	case questionnaire.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case questionnaire.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case questionnaire.FieldIsPublished:
+		m.ResetIsPublished()
+		return nil
+	case questionnaire.FieldLockAfterSubmit:
+		m.ResetLockAfterSubmit()
+		return nil
+	case questionnaire.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case questionnaire.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case questionnaire.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Questionnaire field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionnaireMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.owner != nil {
+		edges = append(edges, questionnaire.EdgeOwner)
+	}
+	if m.members != nil {
+		edges = append(edges, questionnaire.EdgeMembers)
+	}
+	if m.questions != nil {
+		edges = append(edges, questionnaire.EdgeQuestions)
+	}
+	if m.sections != nil {
+		edges = append(edges, questionnaire.EdgeSections)
+	}
+	if m.webhooks != nil {
+		edges = append(edges, questionnaire.EdgeWebhooks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionnaireMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case questionnaire.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case questionnaire.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnaire.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.questions))
+		for id := range m.questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnaire.EdgeSections:
+		ids := make([]ent.Value, 0, len(m.sections))
+		for id := range m.sections {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnaire.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.webhooks))
+		for id := range m.webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionnaireMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedmembers != nil {
+		edges = append(edges, questionnaire.EdgeMembers)
+	}
+	if m.removedquestions != nil {
+		edges = append(edges, questionnaire.EdgeQuestions)
+	}
+	if m.removedsections != nil {
+		edges = append(edges, questionnaire.EdgeSections)
+	}
+	if m.removedwebhooks != nil {
+		edges = append(edges, questionnaire.EdgeWebhooks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionnaireMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case questionnaire.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnaire.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquestions))
+		for id := range m.removedquestions {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnaire.EdgeSections:
+		ids := make([]ent.Value, 0, len(m.removedsections))
+		for id := range m.removedsections {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnaire.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedwebhooks))
+		for id := range m.removedwebhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionnaireMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedowner {
+		edges = append(edges, questionnaire.EdgeOwner)
+	}
+	if m.clearedmembers {
+		edges = append(edges, questionnaire.EdgeMembers)
+	}
+	if m.clearedquestions {
+		edges = append(edges, questionnaire.EdgeQuestions)
+	}
+	if m.clearedsections {
+		edges = append(edges, questionnaire.EdgeSections)
+	}
+	if m.clearedwebhooks {
+		edges = append(edges, questionnaire.EdgeWebhooks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionnaireMutation) EdgeCleared(name string) bool {
+	switch name {
+	case questionnaire.EdgeOwner:
+		return m.clearedowner
+	case questionnaire.EdgeMembers:
+		return m.clearedmembers
+	case questionnaire.EdgeQuestions:
+		return m.clearedquestions
+	case questionnaire.EdgeSections:
+		return m.clearedsections
+	case questionnaire.EdgeWebhooks:
+		return m.clearedwebhooks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionnaireMutation) ClearEdge(name string) error {
+	switch name {
+	case questionnaire.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Questionnaire unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionnaireMutation) ResetEdge(name string) error {
+	switch name {
+	case questionnaire.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case questionnaire.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case questionnaire.EdgeQuestions:
+		m.ResetQuestions()
+		return nil
+	case questionnaire.EdgeSections:
+		m.ResetSections()
+		return nil
+	case questionnaire.EdgeWebhooks:
+		m.ResetWebhooks()
+		return nil
+	}
+	return fmt.Errorf("unknown Questionnaire edge %s", name)
+}
+
+// RuleMutation represents an operation that mutates the Rule nodes in the graph.
+type RuleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	answer_values       *[]string
+	appendanswer_values []string
+	created_at          *int64
+	addcreated_at       *int64
+	clearedFields       map[string]struct{}
+	question            *uuid.UUID
+	clearedquestion     bool
+	source              *uuid.UUID
+	clearedsource       bool
+	done                bool
+	oldValue            func(context.Context) (*Rule, error)
+	predicates          []predicate.Rule
+}
+
+var _ ent.Mutation = (*RuleMutation)(nil)
+
+// ruleOption allows management of the mutation configuration using functional options.
+type ruleOption func(*RuleMutation)
+
+// newRuleMutation creates new mutation for the Rule entity.
+func newRuleMutation(c config, op Op, opts ...ruleOption) *RuleMutation {
+	m := &RuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRuleID sets the ID field of the mutation.
+func withRuleID(id uuid.UUID) ruleOption {
+	return func(m *RuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rule
+		)
+		m.oldValue = func(ctx context.Context) (*Rule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRule sets the old Rule of the mutation.
+func withRule(node *Rule) ruleOption {
+	return func(m *RuleMutation) {
+		m.oldValue = func(context.Context) (*Rule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Rule entities.
+func (m *RuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAnswerValues sets the "answer_values" field.
+func (m *RuleMutation) SetAnswerValues(s []string) {
+	m.answer_values = &s
+	m.appendanswer_values = nil
+}
+
+// AnswerValues returns the value of the "answer_values" field in the mutation.
+func (m *RuleMutation) AnswerValues() (r []string, exists bool) {
+	v := m.answer_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerValues returns the old "answer_values" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldAnswerValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerValues: %w", err)
+	}
+	return oldValue.AnswerValues, nil
+}
+
+// AppendAnswerValues adds s to the "answer_values" field.
+func (m *RuleMutation) AppendAnswerValues(s []string) {
+	m.appendanswer_values = append(m.appendanswer_values, s...)
+}
+
+// AppendedAnswerValues returns the list of values that were appended to the "answer_values" field in this mutation.
+func (m *RuleMutation) AppendedAnswerValues() ([]string, bool) {
+	if len(m.appendanswer_values) == 0 {
+		return nil, false
+	}
+	return m.appendanswer_values, true
+}
+
+// ResetAnswerValues resets all changes to the "answer_values" field.
+func (m *RuleMutation) ResetAnswerValues() {
+	m.answer_values = nil
+	m.appendanswer_values = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RuleMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RuleMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *RuleMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *RuleMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetQuestionID sets the "question" edge to the Question entity by id.
+func (m *RuleMutation) SetQuestionID(id uuid.UUID) {
+	m.question = &id
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *RuleMutation) ClearQuestion() {
+	m.clearedquestion = true
+}
+
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *RuleMutation) QuestionCleared() bool {
+	return m.clearedquestion
+}
+
+// QuestionID returns the "question" edge ID in the mutation.
+func (m *RuleMutation) QuestionID() (id uuid.UUID, exists bool) {
+	if m.question != nil {
+		return *m.question, true
+	}
+	return
+}
+
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *RuleMutation) QuestionIDs() (ids []uuid.UUID) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuestion resets all changes to the "question" edge.
+func (m *RuleMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
+}
+
+// SetSourceID sets the "source" edge to the Question entity by id.
+func (m *RuleMutation) SetSourceID(id uuid.UUID) {
+	m.source = &id
+}
+
+// ClearSource clears the "source" edge to the Question entity.
+func (m *RuleMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the Question entity was cleared.
+func (m *RuleMutation) SourceCleared() bool {
+	return m.clearedsource
+}
+
+// SourceID returns the "source" edge ID in the mutation.
+func (m *RuleMutation) SourceID() (id uuid.UUID, exists bool) {
+	if m.source != nil {
+		return *m.source, true
+	}
+	return
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *RuleMutation) SourceIDs() (ids []uuid.UUID) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *RuleMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// Where appends a list predicates to the RuleMutation builder.
+func (m *RuleMutation) Where(ps ...predicate.Rule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rule).
+func (m *RuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RuleMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.answer_values != nil {
+		fields = append(fields, rule.FieldAnswerValues)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rule.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rule.FieldAnswerValues:
+		return m.AnswerValues()
+	case rule.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rule.FieldAnswerValues:
+		return m.OldAnswerValues(ctx)
+	case rule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rule.FieldAnswerValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerValues(v)
+		return nil
+	case rule.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, rule.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rule.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rule.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RuleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Rule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RuleMutation) ResetField(name string) error {
+	switch name {
+	case rule.FieldAnswerValues:
+		m.ResetAnswerValues()
+		return nil
+	case rule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.question != nil {
+		edges = append(edges, rule.EdgeQuestion)
+	}
+	if m.source != nil {
+		edges = append(edges, rule.EdgeSource)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rule.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
+		}
+	case rule.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedquestion {
+		edges = append(edges, rule.EdgeQuestion)
+	}
+	if m.clearedsource {
+		edges = append(edges, rule.EdgeSource)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rule.EdgeQuestion:
+		return m.clearedquestion
+	case rule.EdgeSource:
+		return m.clearedsource
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RuleMutation) ClearEdge(name string) error {
+	switch name {
+	case rule.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
+	case rule.EdgeSource:
+		m.ClearSource()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RuleMutation) ResetEdge(name string) error {
+	switch name {
+	case rule.EdgeQuestion:
+		m.ResetQuestion()
+		return nil
+	case rule.EdgeSource:
+		m.ResetSource()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule edge %s", name)
+}
+
+// SectionMutation represents an operation that mutates the Section nodes in the graph.
+type SectionMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	title                *string
+	description          *string
+	position             *int
+	addposition          *int
+	created_at           *int64
+	addcreated_at        *int64
+	clearedFields        map[string]struct{}
+	questionnaire        *uuid.UUID
+	clearedquestionnaire bool
+	questions            map[uuid.UUID]struct{}
+	removedquestions     map[uuid.UUID]struct{}
+	clearedquestions     bool
+	done                 bool
+	oldValue             func(context.Context) (*Section, error)
+	predicates           []predicate.Section
+}
+
+var _ ent.Mutation = (*SectionMutation)(nil)
+
+// sectionOption allows management of the mutation configuration using functional options.
+type sectionOption func(*SectionMutation)
+
+// newSectionMutation creates new mutation for the Section entity.
+func newSectionMutation(c config, op Op, opts ...sectionOption) *SectionMutation {
+	m := &SectionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSection,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSectionID sets the ID field of the mutation.
+func withSectionID(id uuid.UUID) sectionOption {
+	return func(m *SectionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Section
+		)
+		m.oldValue = func(ctx context.Context) (*Section, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Section.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSection sets the old Section of the mutation.
+func withSection(node *Section) sectionOption {
+	return func(m *SectionMutation) {
+		m.oldValue = func(context.Context) (*Section, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SectionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SectionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Section entities.
+func (m *SectionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SectionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Section.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *SectionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SectionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SectionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SectionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *SectionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SectionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SectionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SectionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[section.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SectionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[section.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SectionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, section.FieldDescription)
+}
+
+// SetPosition sets the "position" field.
+func (m *SectionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *SectionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SectionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *SectionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *SectionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *SectionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SectionMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SectionMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Section entity.
+// If the Section object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SectionMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *SectionMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *SectionMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SectionMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
 }
 
 // SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by id.
-func (m *MemberMutation) SetQuestionnaireID(id uuid.UUID) {
+func (m *SectionMutation) SetQuestionnaireID(id uuid.UUID) {
 	m.questionnaire = &id
 }
 
 // ClearQuestionnaire clears the "questionnaire" edge to the Questionnaire entity.
-func (m *MemberMutation) ClearQuestionnaire() {
+func (m *SectionMutation) ClearQuestionnaire() {
 	m.clearedquestionnaire = true
 }
 
 // QuestionnaireCleared reports if the "questionnaire" edge to the Questionnaire entity was cleared.
-func (m *MemberMutation) QuestionnaireCleared() bool {
+func (m *SectionMutation) QuestionnaireCleared() bool {
 	return m.clearedquestionnaire
 }
 
 // QuestionnaireID returns the "questionnaire" edge ID in the mutation.
-func (m *MemberMutation) QuestionnaireID() (id uuid.UUID, exists bool) {
+func (m *SectionMutation) QuestionnaireID() (id uuid.UUID, exists bool) {
 	if m.questionnaire != nil {
 		return *m.questionnaire, true
 	}
@@ -1027,7 +5557,7 @@ func (m *MemberMutation) QuestionnaireID() (id uuid.UUID, exists bool) {
 // QuestionnaireIDs returns the "questionnaire" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // QuestionnaireID instead. It exists only for internal usage by the builders.
-func (m *MemberMutation) QuestionnaireIDs() (ids []uuid.UUID) {
+func (m *SectionMutation) QuestionnaireIDs() (ids []uuid.UUID) {
 	if id := m.questionnaire; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1035,74 +5565,74 @@ func (m *MemberMutation) QuestionnaireIDs() (ids []uuid.UUID) {
 }
 
 // ResetQuestionnaire resets all changes to the "questionnaire" edge.
-func (m *MemberMutation) ResetQuestionnaire() {
+func (m *SectionMutation) ResetQuestionnaire() {
 	m.questionnaire = nil
 	m.clearedquestionnaire = false
 }
 
-// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
-func (m *MemberMutation) AddAnswerIDs(ids ...uuid.UUID) {
-	if m.answers == nil {
-		m.answers = make(map[uuid.UUID]struct{})
+// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
+func (m *SectionMutation) AddQuestionIDs(ids ...uuid.UUID) {
+	if m.questions == nil {
+		m.questions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.answers[ids[i]] = struct{}{}
+		m.questions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearAnswers clears the "answers" edge to the Answer entity.
-func (m *MemberMutation) ClearAnswers() {
-	m.clearedanswers = true
+// ClearQuestions clears the "questions" edge to the Question entity.
+func (m *SectionMutation) ClearQuestions() {
+	m.clearedquestions = true
 }
 
-// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
-func (m *MemberMutation) AnswersCleared() bool {
-	return m.clearedanswers
+// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
+func (m *SectionMutation) QuestionsCleared() bool {
+	return m.clearedquestions
 }
 
-// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
-func (m *MemberMutation) RemoveAnswerIDs(ids ...uuid.UUID) {
-	if m.removedanswers == nil {
-		m.removedanswers = make(map[uuid.UUID]struct{})
+// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
+func (m *SectionMutation) RemoveQuestionIDs(ids ...uuid.UUID) {
+	if m.removedquestions == nil {
+		m.removedquestions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.answers, ids[i])
-		m.removedanswers[ids[i]] = struct{}{}
+		delete(m.questions, ids[i])
+		m.removedquestions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
-func (m *MemberMutation) RemovedAnswersIDs() (ids []uuid.UUID) {
-	for id := range m.removedanswers {
+// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
+func (m *SectionMutation) RemovedQuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedquestions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// AnswersIDs returns the "answers" edge IDs in the mutation.
-func (m *MemberMutation) AnswersIDs() (ids []uuid.UUID) {
-	for id := range m.answers {
+// QuestionsIDs returns the "questions" edge IDs in the mutation.
+func (m *SectionMutation) QuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.questions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAnswers resets all changes to the "answers" edge.
-func (m *MemberMutation) ResetAnswers() {
-	m.answers = nil
-	m.clearedanswers = false
-	m.removedanswers = nil
+// ResetQuestions resets all changes to the "questions" edge.
+func (m *SectionMutation) ResetQuestions() {
+	m.questions = nil
+	m.clearedquestions = false
+	m.removedquestions = nil
 }
 
-// Where appends a list predicates to the MemberMutation builder.
-func (m *MemberMutation) Where(ps ...predicate.Member) {
+// Where appends a list predicates to the SectionMutation builder.
+func (m *SectionMutation) Where(ps ...predicate.Section) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MemberMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SectionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MemberMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Member, len(ps))
+func (m *SectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Section, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1110,36 +5640,36 @@ func (m *MemberMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MemberMutation) Op() Op {
+func (m *SectionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MemberMutation) SetOp(op Op) {
+func (m *SectionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Member).
-func (m *MemberMutation) Type() string {
+// Type returns the node type of this mutation (Section).
+func (m *SectionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MemberMutation) Fields() []string {
+func (m *SectionMutation) Fields() []string {
 	fields := make([]string, 0, 4)
-	if m.display_name != nil {
-		fields = append(fields, member.FieldDisplayName)
+	if m.title != nil {
+		fields = append(fields, section.FieldTitle)
 	}
-	if m.created_at != nil {
-		fields = append(fields, member.FieldCreatedAt)
+	if m.description != nil {
+		fields = append(fields, section.FieldDescription)
 	}
-	if m.unique_identifier != nil {
-		fields = append(fields, member.FieldUniqueIdentifier)
+	if m.position != nil {
+		fields = append(fields, section.FieldPosition)
 	}
-	if m.pass_code != nil {
-		fields = append(fields, member.FieldPassCode)
+	if m.created_at != nil {
+		fields = append(fields, section.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1147,16 +5677,16 @@ func (m *MemberMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MemberMutation) Field(name string) (ent.Value, bool) {
+func (m *SectionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case member.FieldDisplayName:
-		return m.DisplayName()
-	case member.FieldCreatedAt:
+	case section.FieldTitle:
+		return m.Title()
+	case section.FieldDescription:
+		return m.Description()
+	case section.FieldPosition:
+		return m.Position()
+	case section.FieldCreatedAt:
 		return m.CreatedAt()
-	case member.FieldUniqueIdentifier:
-		return m.UniqueIdentifier()
-	case member.FieldPassCode:
-		return m.PassCode()
 	}
 	return nil, false
 }
@@ -1164,63 +5694,66 @@ func (m *MemberMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case member.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case member.FieldCreatedAt:
+	case section.FieldTitle:
+		return m.OldTitle(ctx)
+	case section.FieldDescription:
+		return m.OldDescription(ctx)
+	case section.FieldPosition:
+		return m.OldPosition(ctx)
+	case section.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case member.FieldUniqueIdentifier:
-		return m.OldUniqueIdentifier(ctx)
-	case member.FieldPassCode:
-		return m.OldPassCode(ctx)
 	}
-	return nil, fmt.Errorf("unknown Member field %s", name)
+	return nil, fmt.Errorf("unknown Section field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberMutation) SetField(name string, value ent.Value) error {
+func (m *SectionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case member.FieldDisplayName:
+	case section.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetTitle(v)
 		return nil
-	case member.FieldCreatedAt:
-		v, ok := value.(int64)
+	case section.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDescription(v)
 		return nil
-	case member.FieldUniqueIdentifier:
-		v, ok := value.(string)
+	case section.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUniqueIdentifier(v)
+		m.SetPosition(v)
 		return nil
-	case member.FieldPassCode:
-		v, ok := value.([]byte)
+	case section.FieldCreatedAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassCode(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Member field %s", name)
+	return fmt.Errorf("unknown Section field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MemberMutation) AddedFields() []string {
+func (m *SectionMutation) AddedFields() []string {
 	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, section.FieldPosition)
+	}
 	if m.addcreated_at != nil {
-		fields = append(fields, member.FieldCreatedAt)
+		fields = append(fields, section.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1228,9 +5761,11 @@ func (m *MemberMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MemberMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SectionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case member.FieldCreatedAt:
+	case section.FieldPosition:
+		return m.AddedPosition()
+	case section.FieldCreatedAt:
 		return m.AddedCreatedAt()
 	}
 	return nil, false
@@ -1239,9 +5774,16 @@ func (m *MemberMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MemberMutation) AddField(name string, value ent.Value) error {
+func (m *SectionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case member.FieldCreatedAt:
+	case section.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case section.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1249,78 +5791,80 @@ func (m *MemberMutation) AddField(name string, value ent.Value) error {
 		m.AddCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Member numeric field %s", name)
+	return fmt.Errorf("unknown Section numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MemberMutation) ClearedFields() []string {
-	return nil
+func (m *SectionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(section.FieldDescription) {
+		fields = append(fields, section.FieldDescription)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MemberMutation) FieldCleared(name string) bool {
+func (m *SectionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MemberMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Member nullable field %s", name)
+func (m *SectionMutation) ClearField(name string) error {
+	switch name {
+	case section.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Section nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MemberMutation) ResetField(name string) error {
+func (m *SectionMutation) ResetField(name string) error {
 	switch name {
-	case member.FieldDisplayName:
-		m.ResetDisplayName()
+	case section.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case member.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case section.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case member.FieldUniqueIdentifier:
-		m.ResetUniqueIdentifier()
+	case section.FieldPosition:
+		m.ResetPosition()
 		return nil
-	case member.FieldPassCode:
-		m.ResetPassCode()
+	case section.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Member field %s", name)
+	return fmt.Errorf("unknown Section field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MemberMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, member.EdgeUser)
-	}
+func (m *SectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.questionnaire != nil {
-		edges = append(edges, member.EdgeQuestionnaire)
+		edges = append(edges, section.EdgeQuestionnaire)
 	}
-	if m.answers != nil {
-		edges = append(edges, member.EdgeAnswers)
+	if m.questions != nil {
+		edges = append(edges, section.EdgeQuestions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MemberMutation) AddedIDs(name string) []ent.Value {
+func (m *SectionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case member.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case member.EdgeQuestionnaire:
+	case section.EdgeQuestionnaire:
 		if id := m.questionnaire; id != nil {
 			return []ent.Value{*id}
 		}
-	case member.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.answers))
-		for id := range m.answers {
+	case section.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.questions))
+		for id := range m.questions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1329,21 +5873,21 @@ func (m *MemberMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MemberMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedanswers != nil {
-		edges = append(edges, member.EdgeAnswers)
+func (m *SectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedquestions != nil {
+		edges = append(edges, section.EdgeQuestions)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MemberMutation) RemovedIDs(name string) []ent.Value {
+func (m *SectionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case member.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.removedanswers))
-		for id := range m.removedanswers {
+	case section.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquestions))
+		for id := range m.removedquestions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1352,97 +5896,92 @@ func (m *MemberMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MemberMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, member.EdgeUser)
-	}
+func (m *SectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedquestionnaire {
-		edges = append(edges, member.EdgeQuestionnaire)
+		edges = append(edges, section.EdgeQuestionnaire)
 	}
-	if m.clearedanswers {
-		edges = append(edges, member.EdgeAnswers)
+	if m.clearedquestions {
+		edges = append(edges, section.EdgeQuestions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MemberMutation) EdgeCleared(name string) bool {
+func (m *SectionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case member.EdgeUser:
-		return m.cleareduser
-	case member.EdgeQuestionnaire:
+	case section.EdgeQuestionnaire:
 		return m.clearedquestionnaire
-	case member.EdgeAnswers:
-		return m.clearedanswers
+	case section.EdgeQuestions:
+		return m.clearedquestions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MemberMutation) ClearEdge(name string) error {
+func (m *SectionMutation) ClearEdge(name string) error {
 	switch name {
-	case member.EdgeUser:
-		m.ClearUser()
-		return nil
-	case member.EdgeQuestionnaire:
+	case section.EdgeQuestionnaire:
 		m.ClearQuestionnaire()
 		return nil
 	}
-	return fmt.Errorf("unknown Member unique edge %s", name)
+	return fmt.Errorf("unknown Section unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MemberMutation) ResetEdge(name string) error {
+func (m *SectionMutation) ResetEdge(name string) error {
 	switch name {
-	case member.EdgeUser:
-		m.ResetUser()
-		return nil
-	case member.EdgeQuestionnaire:
+	case section.EdgeQuestionnaire:
 		m.ResetQuestionnaire()
 		return nil
-	case member.EdgeAnswers:
-		m.ResetAnswers()
+	case section.EdgeQuestions:
+		m.ResetQuestions()
 		return nil
 	}
-	return fmt.Errorf("unknown Member edge %s", name)
+	return fmt.Errorf("unknown Section edge %s", name)
 }
 
-// QuestionMutation represents an operation that mutates the Question nodes in the graph.
-type QuestionMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	theme                *string
-	created_at           *int64
-	addcreated_at        *int64
-	text                 *string
-	clearedFields        map[string]struct{}
-	questionnaire        *uuid.UUID
-	clearedquestionnaire bool
-	answers              map[uuid.UUID]struct{}
-	removedanswers       map[uuid.UUID]struct{}
-	clearedanswers       bool
-	done                 bool
-	oldValue             func(context.Context) (*Question, error)
-	predicates           []predicate.Question
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	name                  *string
+	username              *string
+	display_name          *string
+	created_at            *int64
+	addcreated_at         *int64
+	password              *[]byte
+	clearedFields         map[string]struct{}
+	questionnaires        map[uuid.UUID]struct{}
+	removedquestionnaires map[uuid.UUID]struct{}
+	clearedquestionnaires bool
+	memberships           map[uuid.UUID]struct{}
+	removedmemberships    map[uuid.UUID]struct{}
+	clearedmemberships    bool
+	access_tokens         map[uuid.UUID]struct{}
+	removedaccess_tokens  map[uuid.UUID]struct{}
+	clearedaccess_tokens  bool
+	done                  bool
+	oldValue              func(context.Context) (*User, error)
+	predicates            []predicate.User
 }
 
-var _ ent.Mutation = (*QuestionMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// questionOption allows management of the mutation configuration using functional options.
-type questionOption func(*QuestionMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newQuestionMutation creates new mutation for the Question entity.
-func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
-	m := &QuestionMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuestion,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1451,20 +5990,20 @@ func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutat
 	return m
 }
 
-// withQuestionID sets the ID field of the mutation.
-func withQuestionID(id uuid.UUID) questionOption {
-	return func(m *QuestionMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Question
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Question, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Question.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1473,10 +6012,10 @@ func withQuestionID(id uuid.UUID) questionOption {
 	}
 }
 
-// withQuestion sets the old Question of the mutation.
-func withQuestion(node *Question) questionOption {
-	return func(m *QuestionMutation) {
-		m.oldValue = func(context.Context) (*Question, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1485,7 +6024,7 @@ func withQuestion(node *Question) questionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestionMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1493,7 +6032,7 @@ func (m QuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestionMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1503,14 +6042,14 @@ func (m QuestionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Question entities.
-func (m *QuestionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1521,7 +6060,7 @@ func (m *QuestionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1530,56 +6069,141 @@ func (m *QuestionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTheme sets the "theme" field.
-func (m *QuestionMutation) SetTheme(s string) {
-	m.theme = &s
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Theme returns the value of the "theme" field in the mutation.
-func (m *QuestionMutation) Theme() (r string, exists bool) {
-	v := m.theme
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTheme returns the old "theme" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *UserMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *UserMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldTheme(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTheme is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTheme requires an ID field in the mutation")
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTheme: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
 	}
-	return oldValue.Theme, nil
+	return oldValue.DisplayName, nil
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *UserMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[user.FieldDisplayName] = struct{}{}
+}
+
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *UserMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[user.FieldDisplayName]
+	return ok
 }
 
-// ResetTheme resets all changes to the "theme" field.
-func (m *QuestionMutation) ResetTheme() {
-	m.theme = nil
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *UserMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, user.FieldDisplayName)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *QuestionMutation) SetCreatedAt(i int64) {
+func (m *UserMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuestionMutation) CreatedAt() (r int64, exists bool) {
+func (m *UserMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1587,10 +6211,10 @@ func (m *QuestionMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1605,7 +6229,7 @@ func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v int64, err error
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *QuestionMutation) AddCreatedAt(i int64) {
+func (m *UserMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -1614,7 +6238,7 @@ func (m *QuestionMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *QuestionMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *UserMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -1623,149 +6247,218 @@ func (m *QuestionMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuestionMutation) ResetCreatedAt() {
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// SetText sets the "text" field.
-func (m *QuestionMutation) SetText(s string) {
-	m.text = &s
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(b []byte) {
+	m.password = &b
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *QuestionMutation) Text() (r string, exists bool) {
-	v := m.text
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r []byte, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the Question entity.
-// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPassword(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.Password, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *QuestionMutation) ResetText() {
-	m.text = nil
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// AddQuestionnaireIDs adds the "questionnaires" edge to the Questionnaire entity by ids.
+func (m *UserMutation) AddQuestionnaireIDs(ids ...uuid.UUID) {
+	if m.questionnaires == nil {
+		m.questionnaires = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.questionnaires[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestionnaires clears the "questionnaires" edge to the Questionnaire entity.
+func (m *UserMutation) ClearQuestionnaires() {
+	m.clearedquestionnaires = true
+}
+
+// QuestionnairesCleared reports if the "questionnaires" edge to the Questionnaire entity was cleared.
+func (m *UserMutation) QuestionnairesCleared() bool {
+	return m.clearedquestionnaires
+}
+
+// RemoveQuestionnaireIDs removes the "questionnaires" edge to the Questionnaire entity by IDs.
+func (m *UserMutation) RemoveQuestionnaireIDs(ids ...uuid.UUID) {
+	if m.removedquestionnaires == nil {
+		m.removedquestionnaires = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.questionnaires, ids[i])
+		m.removedquestionnaires[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestionnaires returns the removed IDs of the "questionnaires" edge to the Questionnaire entity.
+func (m *UserMutation) RemovedQuestionnairesIDs() (ids []uuid.UUID) {
+	for id := range m.removedquestionnaires {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionnairesIDs returns the "questionnaires" edge IDs in the mutation.
+func (m *UserMutation) QuestionnairesIDs() (ids []uuid.UUID) {
+	for id := range m.questionnaires {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestionnaires resets all changes to the "questionnaires" edge.
+func (m *UserMutation) ResetQuestionnaires() {
+	m.questionnaires = nil
+	m.clearedquestionnaires = false
+	m.removedquestionnaires = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the Member entity by ids.
+func (m *UserMutation) AddMembershipIDs(ids ...uuid.UUID) {
+	if m.memberships == nil {
+		m.memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
 }
 
-// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by id.
-func (m *QuestionMutation) SetQuestionnaireID(id uuid.UUID) {
-	m.questionnaire = &id
+// ClearMemberships clears the "memberships" edge to the Member entity.
+func (m *UserMutation) ClearMemberships() {
+	m.clearedmemberships = true
 }
 
-// ClearQuestionnaire clears the "questionnaire" edge to the Questionnaire entity.
-func (m *QuestionMutation) ClearQuestionnaire() {
-	m.clearedquestionnaire = true
+// MembershipsCleared reports if the "memberships" edge to the Member entity was cleared.
+func (m *UserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
 }
 
-// QuestionnaireCleared reports if the "questionnaire" edge to the Questionnaire entity was cleared.
-func (m *QuestionMutation) QuestionnaireCleared() bool {
-	return m.clearedquestionnaire
+// RemoveMembershipIDs removes the "memberships" edge to the Member entity by IDs.
+func (m *UserMutation) RemoveMembershipIDs(ids ...uuid.UUID) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
 }
 
-// QuestionnaireID returns the "questionnaire" edge ID in the mutation.
-func (m *QuestionMutation) QuestionnaireID() (id uuid.UUID, exists bool) {
-	if m.questionnaire != nil {
-		return *m.questionnaire, true
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the Member entity.
+func (m *UserMutation) RemovedMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// QuestionnaireIDs returns the "questionnaire" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// QuestionnaireID instead. It exists only for internal usage by the builders.
-func (m *QuestionMutation) QuestionnaireIDs() (ids []uuid.UUID) {
-	if id := m.questionnaire; id != nil {
-		ids = append(ids, *id)
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *UserMutation) MembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.memberships {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetQuestionnaire resets all changes to the "questionnaire" edge.
-func (m *QuestionMutation) ResetQuestionnaire() {
-	m.questionnaire = nil
-	m.clearedquestionnaire = false
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *UserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
 }
 
-// AddAnswerIDs adds the "answers" edge to the Answer entity by ids.
-func (m *QuestionMutation) AddAnswerIDs(ids ...uuid.UUID) {
-	if m.answers == nil {
-		m.answers = make(map[uuid.UUID]struct{})
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
+func (m *UserMutation) AddAccessTokenIDs(ids ...uuid.UUID) {
+	if m.access_tokens == nil {
+		m.access_tokens = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.answers[ids[i]] = struct{}{}
+		m.access_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// ClearAnswers clears the "answers" edge to the Answer entity.
-func (m *QuestionMutation) ClearAnswers() {
-	m.clearedanswers = true
+// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) ClearAccessTokens() {
+	m.clearedaccess_tokens = true
 }
 
-// AnswersCleared reports if the "answers" edge to the Answer entity was cleared.
-func (m *QuestionMutation) AnswersCleared() bool {
-	return m.clearedanswers
+// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
+func (m *UserMutation) AccessTokensCleared() bool {
+	return m.clearedaccess_tokens
 }
 
-// RemoveAnswerIDs removes the "answers" edge to the Answer entity by IDs.
-func (m *QuestionMutation) RemoveAnswerIDs(ids ...uuid.UUID) {
-	if m.removedanswers == nil {
-		m.removedanswers = make(map[uuid.UUID]struct{})
+// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
+func (m *UserMutation) RemoveAccessTokenIDs(ids ...uuid.UUID) {
+	if m.removedaccess_tokens == nil {
+		m.removedaccess_tokens = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.answers, ids[i])
-		m.removedanswers[ids[i]] = struct{}{}
+		delete(m.access_tokens, ids[i])
+		m.removedaccess_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedAnswers returns the removed IDs of the "answers" edge to the Answer entity.
-func (m *QuestionMutation) RemovedAnswersIDs() (ids []uuid.UUID) {
-	for id := range m.removedanswers {
+// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) RemovedAccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedaccess_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// AnswersIDs returns the "answers" edge IDs in the mutation.
-func (m *QuestionMutation) AnswersIDs() (ids []uuid.UUID) {
-	for id := range m.answers {
+// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccessTokensIDs() (ids []uuid.UUID) {
+	for id := range m.access_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAnswers resets all changes to the "answers" edge.
-func (m *QuestionMutation) ResetAnswers() {
-	m.answers = nil
-	m.clearedanswers = false
-	m.removedanswers = nil
+// ResetAccessTokens resets all changes to the "access_tokens" edge.
+func (m *UserMutation) ResetAccessTokens() {
+	m.access_tokens = nil
+	m.clearedaccess_tokens = false
+	m.removedaccess_tokens = nil
 }
 
-// Where appends a list predicates to the QuestionMutation builder.
-func (m *QuestionMutation) Where(ps ...predicate.Question) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Question, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1773,33 +6466,39 @@ func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestionMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestionMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Question).
-func (m *QuestionMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestionMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.theme != nil {
-		fields = append(fields, question.FieldTheme)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.display_name != nil {
+		fields = append(fields, user.FieldDisplayName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, question.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
-	if m.text != nil {
-		fields = append(fields, question.FieldText)
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
 	}
 	return fields
 }
@@ -1807,14 +6506,18 @@ func (m *QuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case question.FieldTheme:
-		return m.Theme()
-	case question.FieldCreatedAt:
+	case user.FieldName:
+		return m.Name()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldDisplayName:
+		return m.DisplayName()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case question.FieldText:
-		return m.Text()
+	case user.FieldPassword:
+		return m.Password()
 	}
 	return nil, false
 }
@@ -1822,54 +6525,72 @@ func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case question.FieldTheme:
-		return m.OldTheme(ctx)
-	case question.FieldCreatedAt:
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case question.FieldText:
-		return m.OldText(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
 	}
-	return nil, fmt.Errorf("unknown Question field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case question.FieldTheme:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTheme(v)
+		m.SetName(v)
 		return nil
-	case question.FieldCreatedAt:
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case user.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case question.FieldText:
-		v, ok := value.(string)
+	case user.FieldPassword:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetPassword(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestionMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
 	if m.addcreated_at != nil {
-		fields = append(fields, question.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1877,9 +6598,9 @@ func (m *QuestionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case question.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		return m.AddedCreatedAt()
 	}
 	return nil, false
@@ -1888,9 +6609,9 @@ func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case question.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1898,68 +6619,94 @@ func (m *QuestionMutation) AddField(name string, value ent.Value) error {
 		m.AddCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Question numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestionMutation) ClearedFields() []string {
-	return nil
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldDisplayName) {
+		fields = append(fields, user.FieldDisplayName)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestionMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Question nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldDisplayName:
+		m.ClearDisplayName()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestionMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case question.FieldTheme:
-		m.ResetTheme()
+	case user.FieldName:
+		m.ResetName()
 		return nil
-	case question.FieldCreatedAt:
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case question.FieldText:
-		m.ResetText()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
 	}
-	return fmt.Errorf("unknown Question field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.questionnaire != nil {
-		edges = append(edges, question.EdgeQuestionnaire)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.questionnaires != nil {
+		edges = append(edges, user.EdgeQuestionnaires)
 	}
-	if m.answers != nil {
-		edges = append(edges, question.EdgeAnswers)
+	if m.memberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.access_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case question.EdgeQuestionnaire:
-		if id := m.questionnaire; id != nil {
-			return []ent.Value{*id}
+	case user.EdgeQuestionnaires:
+		ids := make([]ent.Value, 0, len(m.questionnaires))
+		for id := range m.questionnaires {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
 		}
-	case question.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.answers))
-		for id := range m.answers {
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.access_tokens))
+		for id := range m.access_tokens {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1968,21 +6715,39 @@ func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedanswers != nil {
-		edges = append(edges, question.EdgeAnswers)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedquestionnaires != nil {
+		edges = append(edges, user.EdgeQuestionnaires)
+	}
+	if m.removedmemberships != nil {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.removedaccess_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case question.EdgeAnswers:
-		ids := make([]ent.Value, 0, len(m.removedanswers))
-		for id := range m.removedanswers {
+	case user.EdgeQuestionnaires:
+		ids := make([]ent.Value, 0, len(m.removedquestionnaires))
+		for id := range m.removedquestionnaires {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
+		for id := range m.removedaccess_tokens {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1991,90 +6756,93 @@ func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedquestionnaire {
-		edges = append(edges, question.EdgeQuestionnaire)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedquestionnaires {
+		edges = append(edges, user.EdgeQuestionnaires)
 	}
-	if m.clearedanswers {
-		edges = append(edges, question.EdgeAnswers)
+	if m.clearedmemberships {
+		edges = append(edges, user.EdgeMemberships)
+	}
+	if m.clearedaccess_tokens {
+		edges = append(edges, user.EdgeAccessTokens)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestionMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case question.EdgeQuestionnaire:
-		return m.clearedquestionnaire
-	case question.EdgeAnswers:
-		return m.clearedanswers
+	case user.EdgeQuestionnaires:
+		return m.clearedquestionnaires
+	case user.EdgeMemberships:
+		return m.clearedmemberships
+	case user.EdgeAccessTokens:
+		return m.clearedaccess_tokens
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestionMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case question.EdgeQuestionnaire:
-		m.ClearQuestionnaire()
-		return nil
 	}
-	return fmt.Errorf("unknown Question unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestionMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case question.EdgeQuestionnaire:
-		m.ResetQuestionnaire()
+	case user.EdgeQuestionnaires:
+		m.ResetQuestionnaires()
 		return nil
-	case question.EdgeAnswers:
-		m.ResetAnswers()
+	case user.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case user.EdgeAccessTokens:
+		m.ResetAccessTokens()
 		return nil
 	}
-	return fmt.Errorf("unknown Question edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// QuestionnaireMutation represents an operation that mutates the Questionnaire nodes in the graph.
-type QuestionnaireMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	title            *string
-	description      *string
-	is_published     *bool
-	created_at       *int64
-	addcreated_at    *int64
-	clearedFields    map[string]struct{}
-	owner            *uuid.UUID
-	clearedowner     bool
-	members          map[uuid.UUID]struct{}
-	removedmembers   map[uuid.UUID]struct{}
-	clearedmembers   bool
-	questions        map[uuid.UUID]struct{}
-	removedquestions map[uuid.UUID]struct{}
-	clearedquestions bool
-	done             bool
-	oldValue         func(context.Context) (*Questionnaire, error)
-	predicates       []predicate.Questionnaire
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	url                  *string
+	secret               *string
+	events               *[]string
+	appendevents         []string
+	created_at           *int64
+	addcreated_at        *int64
+	clearedFields        map[string]struct{}
+	questionnaire        *uuid.UUID
+	clearedquestionnaire bool
+	deliveries           map[uuid.UUID]struct{}
+	removeddeliveries    map[uuid.UUID]struct{}
+	cleareddeliveries    bool
+	done                 bool
+	oldValue             func(context.Context) (*Webhook, error)
+	predicates           []predicate.Webhook
 }
 
-var _ ent.Mutation = (*QuestionnaireMutation)(nil)
+var _ ent.Mutation = (*WebhookMutation)(nil)
 
-// questionnaireOption allows management of the mutation configuration using functional options.
-type questionnaireOption func(*QuestionnaireMutation)
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
 
-// newQuestionnaireMutation creates new mutation for the Questionnaire entity.
-func newQuestionnaireMutation(c config, op Op, opts ...questionnaireOption) *QuestionnaireMutation {
-	m := &QuestionnaireMutation{
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuestionnaire,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2083,20 +6851,20 @@ func newQuestionnaireMutation(c config, op Op, opts ...questionnaireOption) *Que
 	return m
 }
 
-// withQuestionnaireID sets the ID field of the mutation.
-func withQuestionnaireID(id uuid.UUID) questionnaireOption {
-	return func(m *QuestionnaireMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uuid.UUID) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Questionnaire
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Questionnaire, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Questionnaire.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2105,10 +6873,10 @@ func withQuestionnaireID(id uuid.UUID) questionnaireOption {
 	}
 }
 
-// withQuestionnaire sets the old Questionnaire of the mutation.
-func withQuestionnaire(node *Questionnaire) questionnaireOption {
-	return func(m *QuestionnaireMutation) {
-		m.oldValue = func(context.Context) (*Questionnaire, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2117,7 +6885,7 @@ func withQuestionnaire(node *Questionnaire) questionnaireOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuestionnaireMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2125,7 +6893,7 @@ func (m QuestionnaireMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuestionnaireMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2135,14 +6903,14 @@ func (m QuestionnaireMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Questionnaire entities.
-func (m *QuestionnaireMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuestionnaireMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2153,7 +6921,7 @@ func (m *QuestionnaireMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuestionnaireMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2162,141 +6930,143 @@ func (m *QuestionnaireMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Questionnaire.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *QuestionnaireMutation) SetTitle(s string) {
-	m.title = &s
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *QuestionnaireMutation) Title() (r string, exists bool) {
-	v := m.title
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Questionnaire entity.
-// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionnaireMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.URL, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *QuestionnaireMutation) ResetTitle() {
-	m.title = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *QuestionnaireMutation) SetDescription(s string) {
-	m.description = &s
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *QuestionnaireMutation) Description() (r string, exists bool) {
-	v := m.description
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Questionnaire entity.
-// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionnaireMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *QuestionnaireMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[questionnaire.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *QuestionnaireMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[questionnaire.FieldDescription]
-	return ok
+	return oldValue.Secret, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *QuestionnaireMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, questionnaire.FieldDescription)
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetIsPublished sets the "is_published" field.
-func (m *QuestionnaireMutation) SetIsPublished(b bool) {
-	m.is_published = &b
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
 }
 
-// IsPublished returns the value of the "is_published" field in the mutation.
-func (m *QuestionnaireMutation) IsPublished() (r bool, exists bool) {
-	v := m.is_published
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublished returns the old "is_published" field's value of the Questionnaire entity.
-// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionnaireMutation) OldIsPublished(ctx context.Context) (v bool, err error) {
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublished is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublished requires an ID field in the mutation")
+		return v, errors.New("OldEvents requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublished: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
 	}
-	return oldValue.IsPublished, nil
+	return oldValue.Events, nil
 }
 
-// ResetIsPublished resets all changes to the "is_published" field.
-func (m *QuestionnaireMutation) ResetIsPublished() {
-	m.is_published = nil
+// AppendEvents adds s to the "events" field.
+func (m *WebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *QuestionnaireMutation) SetCreatedAt(i int64) {
+func (m *WebhookMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuestionnaireMutation) CreatedAt() (r int64, exists bool) {
+func (m *WebhookMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2304,10 +7074,10 @@ func (m *QuestionnaireMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Questionnaire entity.
-// If the Questionnaire object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuestionnaireMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2322,7 +7092,7 @@ func (m *QuestionnaireMutation) OldCreatedAt(ctx context.Context) (v int64, err
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *QuestionnaireMutation) AddCreatedAt(i int64) {
+func (m *WebhookMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -2331,7 +7101,7 @@ func (m *QuestionnaireMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *QuestionnaireMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *WebhookMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -2340,167 +7110,113 @@ func (m *QuestionnaireMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuestionnaireMutation) ResetCreatedAt() {
+func (m *WebhookMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *QuestionnaireMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *QuestionnaireMutation) ClearOwner() {
-	m.clearedowner = true
-}
-
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *QuestionnaireMutation) OwnerCleared() bool {
-	return m.clearedowner
-}
-
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *QuestionnaireMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
-	}
-	return
-}
-
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *QuestionnaireMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *QuestionnaireMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// AddMemberIDs adds the "members" edge to the Member entity by ids.
-func (m *QuestionnaireMutation) AddMemberIDs(ids ...uuid.UUID) {
-	if m.members == nil {
-		m.members = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.members[ids[i]] = struct{}{}
-	}
-}
-
-// ClearMembers clears the "members" edge to the Member entity.
-func (m *QuestionnaireMutation) ClearMembers() {
-	m.clearedmembers = true
-}
-
-// MembersCleared reports if the "members" edge to the Member entity was cleared.
-func (m *QuestionnaireMutation) MembersCleared() bool {
-	return m.clearedmembers
-}
-
-// RemoveMemberIDs removes the "members" edge to the Member entity by IDs.
-func (m *QuestionnaireMutation) RemoveMemberIDs(ids ...uuid.UUID) {
-	if m.removedmembers == nil {
-		m.removedmembers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.members, ids[i])
-		m.removedmembers[ids[i]] = struct{}{}
-	}
+// SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by id.
+func (m *WebhookMutation) SetQuestionnaireID(id uuid.UUID) {
+	m.questionnaire = &id
 }
 
-// RemovedMembers returns the removed IDs of the "members" edge to the Member entity.
-func (m *QuestionnaireMutation) RemovedMembersIDs() (ids []uuid.UUID) {
-	for id := range m.removedmembers {
-		ids = append(ids, id)
+// ClearQuestionnaire clears the "questionnaire" edge to the Questionnaire entity.
+func (m *WebhookMutation) ClearQuestionnaire() {
+	m.clearedquestionnaire = true
+}
+
+// QuestionnaireCleared reports if the "questionnaire" edge to the Questionnaire entity was cleared.
+func (m *WebhookMutation) QuestionnaireCleared() bool {
+	return m.clearedquestionnaire
+}
+
+// QuestionnaireID returns the "questionnaire" edge ID in the mutation.
+func (m *WebhookMutation) QuestionnaireID() (id uuid.UUID, exists bool) {
+	if m.questionnaire != nil {
+		return *m.questionnaire, true
 	}
 	return
 }
 
-// MembersIDs returns the "members" edge IDs in the mutation.
-func (m *QuestionnaireMutation) MembersIDs() (ids []uuid.UUID) {
-	for id := range m.members {
-		ids = append(ids, id)
+// QuestionnaireIDs returns the "questionnaire" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionnaireID instead. It exists only for internal usage by the builders.
+func (m *WebhookMutation) QuestionnaireIDs() (ids []uuid.UUID) {
+	if id := m.questionnaire; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMembers resets all changes to the "members" edge.
-func (m *QuestionnaireMutation) ResetMembers() {
-	m.members = nil
-	m.clearedmembers = false
-	m.removedmembers = nil
+// ResetQuestionnaire resets all changes to the "questionnaire" edge.
+func (m *WebhookMutation) ResetQuestionnaire() {
+	m.questionnaire = nil
+	m.clearedquestionnaire = false
 }
 
-// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
-func (m *QuestionnaireMutation) AddQuestionIDs(ids ...uuid.UUID) {
-	if m.questions == nil {
-		m.questions = make(map[uuid.UUID]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.questions[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearQuestions clears the "questions" edge to the Question entity.
-func (m *QuestionnaireMutation) ClearQuestions() {
-	m.clearedquestions = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
-func (m *QuestionnaireMutation) QuestionsCleared() bool {
-	return m.clearedquestions
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
-func (m *QuestionnaireMutation) RemoveQuestionIDs(ids ...uuid.UUID) {
-	if m.removedquestions == nil {
-		m.removedquestions = make(map[uuid.UUID]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.questions, ids[i])
-		m.removedquestions[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
-func (m *QuestionnaireMutation) RemovedQuestionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedquestions {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// QuestionsIDs returns the "questions" edge IDs in the mutation.
-func (m *QuestionnaireMutation) QuestionsIDs() (ids []uuid.UUID) {
-	for id := range m.questions {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetQuestions resets all changes to the "questions" edge.
-func (m *QuestionnaireMutation) ResetQuestions() {
-	m.questions = nil
-	m.clearedquestions = false
-	m.removedquestions = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the QuestionnaireMutation builder.
-func (m *QuestionnaireMutation) Where(ps ...predicate.Questionnaire) {
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuestionnaireMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuestionnaireMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Questionnaire, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2508,36 +7224,36 @@ func (m *QuestionnaireMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuestionnaireMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuestionnaireMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Questionnaire).
-func (m *QuestionnaireMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuestionnaireMutation) Fields() []string {
+func (m *WebhookMutation) Fields() []string {
 	fields := make([]string, 0, 4)
-	if m.title != nil {
-		fields = append(fields, questionnaire.FieldTitle)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.description != nil {
-		fields = append(fields, questionnaire.FieldDescription)
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
 	}
-	if m.is_published != nil {
-		fields = append(fields, questionnaire.FieldIsPublished)
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
 	}
 	if m.created_at != nil {
-		fields = append(fields, questionnaire.FieldCreatedAt)
+		fields = append(fields, webhook.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2545,15 +7261,15 @@ func (m *QuestionnaireMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuestionnaireMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case questionnaire.FieldTitle:
-		return m.Title()
-	case questionnaire.FieldDescription:
-		return m.Description()
-	case questionnaire.FieldIsPublished:
-		return m.IsPublished()
-	case questionnaire.FieldCreatedAt:
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2562,47 +7278,47 @@ func (m *QuestionnaireMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuestionnaireMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case questionnaire.FieldTitle:
-		return m.OldTitle(ctx)
-	case questionnaire.FieldDescription:
-		return m.OldDescription(ctx)
-	case questionnaire.FieldIsPublished:
-		return m.OldIsPublished(ctx)
-	case questionnaire.FieldCreatedAt:
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Questionnaire field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionnaireMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case questionnaire.FieldTitle:
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetURL(v)
 		return nil
-	case questionnaire.FieldDescription:
+	case webhook.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetSecret(v)
 		return nil
-	case questionnaire.FieldIsPublished:
-		v, ok := value.(bool)
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPublished(v)
+		m.SetEvents(v)
 		return nil
-	case questionnaire.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2610,15 +7326,15 @@ func (m *QuestionnaireMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Questionnaire field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuestionnaireMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	var fields []string
 	if m.addcreated_at != nil {
-		fields = append(fields, questionnaire.FieldCreatedAt)
+		fields = append(fields, webhook.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2626,9 +7342,9 @@ func (m *QuestionnaireMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuestionnaireMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case questionnaire.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		return m.AddedCreatedAt()
 	}
 	return nil, false
@@ -2637,9 +7353,9 @@ func (m *QuestionnaireMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuestionnaireMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case questionnaire.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2647,89 +7363,71 @@ func (m *QuestionnaireMutation) AddField(name string, value ent.Value) error {
 		m.AddCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Questionnaire numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuestionnaireMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(questionnaire.FieldDescription) {
-		fields = append(fields, questionnaire.FieldDescription)
-	}
-	return fields
+func (m *WebhookMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuestionnaireMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuestionnaireMutation) ClearField(name string) error {
-	switch name {
-	case questionnaire.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Questionnaire nullable field %s", name)
+func (m *WebhookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuestionnaireMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case questionnaire.FieldTitle:
-		m.ResetTitle()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case questionnaire.FieldDescription:
-		m.ResetDescription()
+	case webhook.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case questionnaire.FieldIsPublished:
-		m.ResetIsPublished()
+	case webhook.FieldEvents:
+		m.ResetEvents()
 		return nil
-	case questionnaire.FieldCreatedAt:
+	case webhook.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Questionnaire field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuestionnaireMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, questionnaire.EdgeOwner)
-	}
-	if m.members != nil {
-		edges = append(edges, questionnaire.EdgeMembers)
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.questionnaire != nil {
+		edges = append(edges, webhook.EdgeQuestionnaire)
 	}
-	if m.questions != nil {
-		edges = append(edges, questionnaire.EdgeQuestions)
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuestionnaireMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case questionnaire.EdgeOwner:
-		if id := m.owner; id != nil {
+	case webhook.EdgeQuestionnaire:
+		if id := m.questionnaire; id != nil {
 			return []ent.Value{*id}
 		}
-	case questionnaire.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.members))
-		for id := range m.members {
-			ids = append(ids, id)
-		}
-		return ids
-	case questionnaire.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.questions))
-		for id := range m.questions {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2738,30 +7436,21 @@ func (m *QuestionnaireMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuestionnaireMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedmembers != nil {
-		edges = append(edges, questionnaire.EdgeMembers)
-	}
-	if m.removedquestions != nil {
-		edges = append(edges, questionnaire.EdgeQuestions)
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuestionnaireMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case questionnaire.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.removedmembers))
-		for id := range m.removedmembers {
-			ids = append(ids, id)
-		}
-		return ids
-	case questionnaire.EdgeQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquestions))
-		for id := range m.removedquestions {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2770,97 +7459,93 @@ func (m *QuestionnaireMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuestionnaireMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, questionnaire.EdgeOwner)
-	}
-	if m.clearedmembers {
-		edges = append(edges, questionnaire.EdgeMembers)
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedquestionnaire {
+		edges = append(edges, webhook.EdgeQuestionnaire)
 	}
-	if m.clearedquestions {
-		edges = append(edges, questionnaire.EdgeQuestions)
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuestionnaireMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case questionnaire.EdgeOwner:
-		return m.clearedowner
-	case questionnaire.EdgeMembers:
-		return m.clearedmembers
-	case questionnaire.EdgeQuestions:
-		return m.clearedquestions
+	case webhook.EdgeQuestionnaire:
+		return m.clearedquestionnaire
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuestionnaireMutation) ClearEdge(name string) error {
+func (m *WebhookMutation) ClearEdge(name string) error {
 	switch name {
-	case questionnaire.EdgeOwner:
-		m.ClearOwner()
+	case webhook.EdgeQuestionnaire:
+		m.ClearQuestionnaire()
 		return nil
 	}
-	return fmt.Errorf("unknown Questionnaire unique edge %s", name)
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuestionnaireMutation) ResetEdge(name string) error {
+func (m *WebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case questionnaire.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case questionnaire.EdgeMembers:
-		m.ResetMembers()
+	case webhook.EdgeQuestionnaire:
+		m.ResetQuestionnaire()
 		return nil
-	case questionnaire.EdgeQuestions:
-		m.ResetQuestions()
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Questionnaire edge %s", name)
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	name                  *string
-	username              *string
-	display_name          *string
-	created_at            *int64
-	addcreated_at         *int64
-	password              *[]byte
-	clearedFields         map[string]struct{}
-	questionnaires        map[uuid.UUID]struct{}
-	removedquestionnaires map[uuid.UUID]struct{}
-	clearedquestionnaires bool
-	memberships           map[uuid.UUID]struct{}
-	removedmemberships    map[uuid.UUID]struct{}
-	clearedmemberships    bool
-	done                  bool
-	oldValue              func(context.Context) (*User, error)
-	predicates            []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	event              *string
+	payload            *[]byte
+	status             *webhookdelivery.Status
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *int64
+	addnext_attempt_at *int64
+	last_attempt_at    *int64
+	addlast_attempt_at *int64
+	response_status    *int
+	addresponse_status *int
+	error              *string
+	created_at         *int64
+	addcreated_at      *int64
+	clearedFields      map[string]struct{}
+	webhook            *uuid.UUID
+	clearedwebhook     bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2869,32 +7554,32 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
-	}
-}
-
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2903,7 +7588,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2911,7 +7596,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2921,14 +7606,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2939,7 +7624,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2948,342 +7633,525 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEvent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Event, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(b []byte) {
+	m.payload = &b
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.Payload, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *UserMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *UserMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.Status, nil
 }
 
-// ClearDisplayName clears the value of the "display_name" field.
-func (m *UserMutation) ClearDisplayName() {
-	m.display_name = nil
-	m.clearedFields[user.FieldDisplayName] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
-func (m *UserMutation) DisplayNameCleared() bool {
-	_, ok := m.clearedFields[user.FieldDisplayName]
-	return ok
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *UserMutation) ResetDisplayName() {
-	m.display_name = nil
-	delete(m.clearedFields, user.FieldDisplayName)
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(i int64) {
-	m.created_at = &i
-	m.addcreated_at = nil
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r int64, exists bool) {
-	v := m.created_at
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(i int64) {
+	m.next_attempt_at = &i
+	m.addnext_attempt_at = nil
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r int64, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// AddCreatedAt adds i to the "created_at" field.
-func (m *UserMutation) AddCreatedAt(i int64) {
-	if m.addcreated_at != nil {
-		*m.addcreated_at += i
+// AddNextAttemptAt adds i to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) AddNextAttemptAt(i int64) {
+	if m.addnext_attempt_at != nil {
+		*m.addnext_attempt_at += i
 	} else {
-		m.addcreated_at = &i
+		m.addnext_attempt_at = &i
 	}
 }
 
-// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *UserMutation) AddedCreatedAt() (r int64, exists bool) {
-	v := m.addcreated_at
+// AddedNextAttemptAt returns the value that was added to the "next_attempt_at" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedNextAttemptAt() (r int64, exists bool) {
+	v := m.addnext_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
-	m.addcreated_at = nil
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.addnext_attempt_at = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(b []byte) {
-	m.password = &b
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetLastAttemptAt(i int64) {
+	m.last_attempt_at = &i
+	m.addlast_attempt_at = nil
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r []byte, exists bool) {
-	v := m.password
+// LastAttemptAt returns the value of the "last_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAt() (r int64, exists bool) {
+	v := m.last_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastAttemptAt returns the old "last_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v []byte, err error) {
+func (m *WebhookDeliveryMutation) OldLastAttemptAt(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldLastAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastAttemptAt: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.LastAttemptAt, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// AddLastAttemptAt adds i to the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) AddLastAttemptAt(i int64) {
+	if m.addlast_attempt_at != nil {
+		*m.addlast_attempt_at += i
+	} else {
+		m.addlast_attempt_at = &i
+	}
 }
 
-// AddQuestionnaireIDs adds the "questionnaires" edge to the Questionnaire entity by ids.
-func (m *UserMutation) AddQuestionnaireIDs(ids ...uuid.UUID) {
-	if m.questionnaires == nil {
-		m.questionnaires = make(map[uuid.UUID]struct{})
+// AddedLastAttemptAt returns the value that was added to the "last_attempt_at" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedLastAttemptAt() (r int64, exists bool) {
+	v := m.addlast_attempt_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.questionnaires[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearLastAttemptAt() {
+	m.last_attempt_at = nil
+	m.addlast_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldLastAttemptAt] = struct{}{}
+}
+
+// LastAttemptAtCleared returns if the "last_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastAttemptAt]
+	return ok
+}
+
+// ResetLastAttemptAt resets all changes to the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetLastAttemptAt() {
+	m.last_attempt_at = nil
+	m.addlast_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastAttemptAt)
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearQuestionnaires clears the "questionnaires" edge to the Questionnaire entity.
-func (m *UserMutation) ClearQuestionnaires() {
-	m.clearedquestionnaires = true
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
 }
 
-// QuestionnairesCleared reports if the "questionnaires" edge to the Questionnaire entity was cleared.
-func (m *UserMutation) QuestionnairesCleared() bool {
-	return m.clearedquestionnaires
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
 }
 
-// RemoveQuestionnaireIDs removes the "questionnaires" edge to the Questionnaire entity by IDs.
-func (m *UserMutation) RemoveQuestionnaireIDs(ids ...uuid.UUID) {
-	if m.removedquestionnaires == nil {
-		m.removedquestionnaires = make(map[uuid.UUID]struct{})
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.questionnaires, ids[i])
-		m.removedquestionnaires[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
+
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedQuestionnaires returns the removed IDs of the "questionnaires" edge to the Questionnaire entity.
-func (m *UserMutation) RemovedQuestionnairesIDs() (ids []uuid.UUID) {
-	for id := range m.removedquestionnaires {
-		ids = append(ids, id)
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// QuestionnairesIDs returns the "questionnaires" edge IDs in the mutation.
-func (m *UserMutation) QuestionnairesIDs() (ids []uuid.UUID) {
-	for id := range m.questionnaires {
-		ids = append(ids, id)
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetQuestionnaires resets all changes to the "questionnaires" edge.
-func (m *UserMutation) ResetQuestionnaires() {
-	m.questionnaires = nil
-	m.clearedquestionnaires = false
-	m.removedquestionnaires = nil
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *WebhookDeliveryMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
 }
 
-// AddMembershipIDs adds the "memberships" edge to the Member entity by ids.
-func (m *UserMutation) AddMembershipIDs(ids ...uuid.UUID) {
-	if m.memberships == nil {
-		m.memberships = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.memberships[ids[i]] = struct{}{}
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearMemberships clears the "memberships" edge to the Member entity.
-func (m *UserMutation) ClearMemberships() {
-	m.clearedmemberships = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
 }
 
-// MembershipsCleared reports if the "memberships" edge to the Member entity was cleared.
-func (m *UserMutation) MembershipsCleared() bool {
-	return m.clearedmemberships
+// SetWebhookID sets the "webhook" edge to the Webhook entity by id.
+func (m *WebhookDeliveryMutation) SetWebhookID(id uuid.UUID) {
+	m.webhook = &id
 }
 
-// RemoveMembershipIDs removes the "memberships" edge to the Member entity by IDs.
-func (m *UserMutation) RemoveMembershipIDs(ids ...uuid.UUID) {
-	if m.removedmemberships == nil {
-		m.removedmemberships = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.memberships, ids[i])
-		m.removedmemberships[ids[i]] = struct{}{}
-	}
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
 }
 
-// RemovedMemberships returns the removed IDs of the "memberships" edge to the Member entity.
-func (m *UserMutation) RemovedMembershipsIDs() (ids []uuid.UUID) {
-	for id := range m.removedmemberships {
-		ids = append(ids, id)
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookID returns the "webhook" edge ID in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (id uuid.UUID, exists bool) {
+	if m.webhook != nil {
+		return *m.webhook, true
 	}
 	return
 }
 
-// MembershipsIDs returns the "memberships" edge IDs in the mutation.
-func (m *UserMutation) MembershipsIDs() (ids []uuid.UUID) {
-	for id := range m.memberships {
-		ids = append(ids, id)
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMemberships resets all changes to the "memberships" edge.
-func (m *UserMutation) ResetMemberships() {
-	m.memberships = nil
-	m.clearedmemberships = false
-	m.removedmemberships = nil
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3291,39 +8159,51 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
 	}
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.display_name != nil {
-		fields = append(fields, user.FieldDisplayName)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.last_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3331,18 +8211,26 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldName:
-		return m.Name()
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldDisplayName:
-		return m.DisplayName()
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.LastAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldPassword:
-		return m.Password()
 	}
 	return nil, false
 }
@@ -3350,72 +8238,120 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.OldLastAttemptAt(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldName:
+	case webhookdelivery.FieldEvent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEvent(v)
 		return nil
-	case user.FieldUsername:
-		v, ok := value.(string)
+	case webhookdelivery.FieldPayload:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetPayload(v)
 		return nil
-	case user.FieldDisplayName:
-		v, ok := value.(string)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetStatus(v)
 		return nil
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case user.FieldPassword:
-		v, ok := value.([]byte)
+	case webhookdelivery.FieldLastAttemptAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetLastAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addnext_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.addlast_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
 	if m.addcreated_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3423,9 +8359,17 @@ func (m *UserMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.AddedNextAttemptAt()
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.AddedLastAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	case webhookdelivery.FieldCreatedAt:
 		return m.AddedCreatedAt()
 	}
 	return nil, false
@@ -3434,9 +8378,37 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3444,166 +8416,154 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		m.AddCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldDisplayName) {
-		fields = append(fields, user.FieldDisplayName)
+	if m.FieldCleared(webhookdelivery.FieldLastAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldDisplayName:
-		m.ClearDisplayName()
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ClearLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case user.FieldUsername:
-		m.ResetUsername()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case user.FieldDisplayName:
-		m.ResetDisplayName()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ResetLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.questionnaires != nil {
-		edges = append(edges, user.EdgeQuestionnaires)
-	}
-	if m.memberships != nil {
-		edges = append(edges, user.EdgeMemberships)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeQuestionnaires:
-		ids := make([]ent.Value, 0, len(m.questionnaires))
-		for id := range m.questionnaires {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.memberships))
-		for id := range m.memberships {
-			ids = append(ids, id)
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedquestionnaires != nil {
-		edges = append(edges, user.EdgeQuestionnaires)
-	}
-	if m.removedmemberships != nil {
-		edges = append(edges, user.EdgeMemberships)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeQuestionnaires:
-		ids := make([]ent.Value, 0, len(m.removedquestionnaires))
-		for id := range m.removedquestionnaires {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.removedmemberships))
-		for id := range m.removedmemberships {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedquestionnaires {
-		edges = append(edges, user.EdgeQuestionnaires)
-	}
-	if m.clearedmemberships {
-		edges = append(edges, user.EdgeMemberships)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeQuestionnaires:
-		return m.clearedquestionnaires
-	case user.EdgeMemberships:
-		return m.clearedmemberships
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeQuestionnaires:
-		m.ResetQuestionnaires()
-		return nil
-	case user.EdgeMemberships:
-		m.ResetMemberships()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/section"
 	"strings"
 
 	"entgo.io/ent"
@@ -18,16 +19,21 @@ type Question struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// Theme holds the value of the "theme" field.
-	Theme string `json:"theme,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
 	// Text holds the value of the "text" field.
 	Text string `json:"text,omitempty"`
+	// Required holds the value of the "required" field.
+	Required bool `json:"required,omitempty"`
+	// Zero based order of the question within its questionnaire
+	Position int `json:"position,omitempty"`
+	// Incremented on every edit, backs the ETag of the question
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QuestionQuery when eager-loading is set.
 	Edges                   QuestionEdges `json:"edges"`
 	questionnaire_questions *uuid.UUID
+	section_questions       *uuid.UUID
 	selectValues            sql.SelectValues
 }
 
@@ -35,11 +41,23 @@ type Question struct {
 type QuestionEdges struct {
 	// Questionnaire holds the value of the questionnaire edge.
 	Questionnaire *Questionnaire `json:"questionnaire,omitempty"`
+	// Section holds the value of the section edge.
+	Section *Section `json:"section,omitempty"`
 	// Answers holds the value of the answers edge.
 	Answers []*Answer `json:"answers,omitempty"`
+	// Rules holds the value of the rules edge.
+	Rules []*Rule `json:"rules,omitempty"`
+	// Dependents holds the value of the dependents edge.
+	Dependents []*Rule `json:"dependents,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
+	// totalCount holds the count of the edges above.
+	totalCount [5]map[string]int
+
+	namedAnswers    map[string][]*Answer
+	namedRules      map[string][]*Rule
+	namedDependents map[string][]*Rule
 }
 
 // QuestionnaireOrErr returns the Questionnaire value or an error if the edge
@@ -53,28 +71,61 @@ func (e QuestionEdges) QuestionnaireOrErr() (*Questionnaire, error) {
 	return nil, &NotLoadedError{edge: "questionnaire"}
 }
 
+// SectionOrErr returns the Section value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionEdges) SectionOrErr() (*Section, error) {
+	if e.Section != nil {
+		return e.Section, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: section.Label}
+	}
+	return nil, &NotLoadedError{edge: "section"}
+}
+
 // AnswersOrErr returns the Answers value or an error if the edge
 // was not loaded in eager-loading.
 func (e QuestionEdges) AnswersOrErr() ([]*Answer, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Answers, nil
 	}
 	return nil, &NotLoadedError{edge: "answers"}
 }
 
+// RulesOrErr returns the Rules value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionEdges) RulesOrErr() ([]*Rule, error) {
+	if e.loadedTypes[3] {
+		return e.Rules, nil
+	}
+	return nil, &NotLoadedError{edge: "rules"}
+}
+
+// DependentsOrErr returns the Dependents value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionEdges) DependentsOrErr() ([]*Rule, error) {
+	if e.loadedTypes[4] {
+		return e.Dependents, nil
+	}
+	return nil, &NotLoadedError{edge: "dependents"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Question) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case question.FieldCreatedAt:
+		case question.FieldRequired:
+			values[i] = new(sql.NullBool)
+		case question.FieldCreatedAt, question.FieldPosition, question.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case question.FieldTheme, question.FieldText:
+		case question.FieldText:
 			values[i] = new(sql.NullString)
 		case question.FieldID:
 			values[i] = new(uuid.UUID)
 		case question.ForeignKeys[0]: // questionnaire_questions
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case question.ForeignKeys[1]: // section_questions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -96,12 +147,6 @@ func (_m *Question) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
-		case question.FieldTheme:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field theme", values[i])
-			} else if value.Valid {
-				_m.Theme = value.String
-			}
 		case question.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -114,6 +159,24 @@ func (_m *Question) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Text = value.String
 			}
+		case question.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				_m.Required = value.Bool
+			}
+		case question.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case question.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case question.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field questionnaire_questions", values[i])
@@ -121,6 +184,13 @@ func (_m *Question) assignValues(columns []string, values []any) error {
 				_m.questionnaire_questions = new(uuid.UUID)
 				*_m.questionnaire_questions = *value.S.(*uuid.UUID)
 			}
+		case question.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field section_questions", values[i])
+			} else if value.Valid {
+				_m.section_questions = new(uuid.UUID)
+				*_m.section_questions = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -139,11 +209,26 @@ func (_m *Question) QueryQuestionnaire() *QuestionnaireQuery {
 	return NewQuestionClient(_m.config).QueryQuestionnaire(_m)
 }
 
+// QuerySection queries the "section" edge of the Question entity.
+func (_m *Question) QuerySection() *SectionQuery {
+	return NewQuestionClient(_m.config).QuerySection(_m)
+}
+
 // QueryAnswers queries the "answers" edge of the Question entity.
 func (_m *Question) QueryAnswers() *AnswerQuery {
 	return NewQuestionClient(_m.config).QueryAnswers(_m)
 }
 
+// QueryRules queries the "rules" edge of the Question entity.
+func (_m *Question) QueryRules() *RuleQuery {
+	return NewQuestionClient(_m.config).QueryRules(_m)
+}
+
+// QueryDependents queries the "dependents" edge of the Question entity.
+func (_m *Question) QueryDependents() *RuleQuery {
+	return NewQuestionClient(_m.config).QueryDependents(_m)
+}
+
 // Update returns a builder for updating this Question.
 // Note that you need to call Question.Unwrap() before calling this method if this Question
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -167,17 +252,95 @@ func (_m *Question) String() string {
 	var builder strings.Builder
 	builder.WriteString("Question(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
-	builder.WriteString("theme=")
-	builder.WriteString(_m.Theme)
-	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
 	builder.WriteString(", ")
 	builder.WriteString("text=")
 	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("required=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Required))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedAnswers returns the Answers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Question) NamedAnswers(name string) ([]*Answer, error) {
+	if _m.Edges.namedAnswers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedAnswers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Question) appendNamedAnswers(name string, edges ...*Answer) {
+	if _m.Edges.namedAnswers == nil {
+		_m.Edges.namedAnswers = make(map[string][]*Answer)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedAnswers[name] = []*Answer{}
+	} else {
+		_m.Edges.namedAnswers[name] = append(_m.Edges.namedAnswers[name], edges...)
+	}
+}
+
+// NamedRules returns the Rules named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Question) NamedRules(name string) ([]*Rule, error) {
+	if _m.Edges.namedRules == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedRules[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Question) appendNamedRules(name string, edges ...*Rule) {
+	if _m.Edges.namedRules == nil {
+		_m.Edges.namedRules = make(map[string][]*Rule)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedRules[name] = []*Rule{}
+	} else {
+		_m.Edges.namedRules[name] = append(_m.Edges.namedRules[name], edges...)
+	}
+}
+
+// NamedDependents returns the Dependents named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Question) NamedDependents(name string) ([]*Rule, error) {
+	if _m.Edges.namedDependents == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedDependents[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Question) appendNamedDependents(name string, edges ...*Rule) {
+	if _m.Edges.namedDependents == nil {
+		_m.Edges.namedDependents = make(map[string][]*Rule)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedDependents[name] = []*Rule{}
+	} else {
+		_m.Edges.namedDependents[name] = append(_m.Edges.namedDependents[name], edges...)
+	}
+}
+
 // Questions is a parsable slice of Question.
 type Questions []*Question
@@ -13,16 +13,26 @@ const (
 	Label = "question"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
-	// FieldTheme holds the string denoting the theme field in the database.
-	FieldTheme = "theme"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldText holds the string denoting the text field in the database.
 	FieldText = "text"
+	// FieldRequired holds the string denoting the required field in the database.
+	FieldRequired = "required"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeQuestionnaire holds the string denoting the questionnaire edge name in mutations.
 	EdgeQuestionnaire = "questionnaire"
+	// EdgeSection holds the string denoting the section edge name in mutations.
+	EdgeSection = "section"
 	// EdgeAnswers holds the string denoting the answers edge name in mutations.
 	EdgeAnswers = "answers"
+	// EdgeRules holds the string denoting the rules edge name in mutations.
+	EdgeRules = "rules"
+	// EdgeDependents holds the string denoting the dependents edge name in mutations.
+	EdgeDependents = "dependents"
 	// Table holds the table name of the question in the database.
 	Table = "questions"
 	// QuestionnaireTable is the table that holds the questionnaire relation/edge.
@@ -32,6 +42,13 @@ const (
 	QuestionnaireInverseTable = "questionnaires"
 	// QuestionnaireColumn is the table column denoting the questionnaire relation/edge.
 	QuestionnaireColumn = "questionnaire_questions"
+	// SectionTable is the table that holds the section relation/edge.
+	SectionTable = "questions"
+	// SectionInverseTable is the table name for the Section entity.
+	// It exists in this package in order to avoid circular dependency with the "section" package.
+	SectionInverseTable = "sections"
+	// SectionColumn is the table column denoting the section relation/edge.
+	SectionColumn = "section_questions"
 	// AnswersTable is the table that holds the answers relation/edge.
 	AnswersTable = "answers"
 	// AnswersInverseTable is the table name for the Answer entity.
@@ -39,20 +56,37 @@ const (
 	AnswersInverseTable = "answers"
 	// AnswersColumn is the table column denoting the answers relation/edge.
 	AnswersColumn = "question_answers"
+	// RulesTable is the table that holds the rules relation/edge.
+	RulesTable = "rules"
+	// RulesInverseTable is the table name for the Rule entity.
+	// It exists in this package in order to avoid circular dependency with the "rule" package.
+	RulesInverseTable = "rules"
+	// RulesColumn is the table column denoting the rules relation/edge.
+	RulesColumn = "question_rules"
+	// DependentsTable is the table that holds the dependents relation/edge.
+	DependentsTable = "rules"
+	// DependentsInverseTable is the table name for the Rule entity.
+	// It exists in this package in order to avoid circular dependency with the "rule" package.
+	DependentsInverseTable = "rules"
+	// DependentsColumn is the table column denoting the dependents relation/edge.
+	DependentsColumn = "question_dependents"
 )
 
 // Columns holds all SQL columns for question fields.
 var Columns = []string{
 	FieldID,
-	FieldTheme,
 	FieldCreatedAt,
 	FieldText,
+	FieldRequired,
+	FieldPosition,
+	FieldVersion,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "questions"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"questionnaire_questions",
+	"section_questions",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -71,10 +105,18 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// ThemeValidator is a validator for the "theme" field. It is called by the builders before save.
-	ThemeValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() int64
+	// DefaultRequired holds the default value on creation for the "required" field.
+	DefaultRequired bool
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -87,11 +129,6 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
-// ByTheme orders the results by the theme field.
-func ByTheme(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldTheme, opts...).ToFunc()
-}
-
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -102,6 +139,21 @@ func ByText(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldText, opts...).ToFunc()
 }
 
+// ByRequired orders the results by the required field.
+func ByRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequired, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByQuestionnaireField orders the results by questionnaire field.
 func ByQuestionnaireField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -109,6 +161,13 @@ func ByQuestionnaireField(field string, opts ...sql.OrderTermOption) OrderOption
 	}
 }
 
+// BySectionField orders the results by section field.
+func BySectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByAnswersCount orders the results by answers count.
 func ByAnswersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -122,6 +181,34 @@ func ByAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRulesCount orders the results by rules count.
+func ByRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRulesStep(), opts...)
+	}
+}
+
+// ByRules orders the results by rules terms.
+func ByRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDependentsCount orders the results by dependents count.
+func ByDependentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDependentsStep(), opts...)
+	}
+}
+
+// ByDependents orders the results by dependents terms.
+func ByDependents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDependentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newQuestionnaireStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -129,6 +216,13 @@ func newQuestionnaireStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, QuestionnaireTable, QuestionnaireColumn),
 	)
 }
+func newSectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SectionTable, SectionColumn),
+	)
+}
 func newAnswersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -136,3 +230,17 @@ func newAnswersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
 	)
 }
+func newRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RulesTable, RulesColumn),
+	)
+}
+func newDependentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DependentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DependentsTable, DependentsColumn),
+	)
+}
@@ -55,11 +55,6 @@ func IDLTE(id uuid.UUID) predicate.Question {
 	return predicate.Question(sql.FieldLTE(FieldID, id))
 }
 
-// Theme applies equality check predicate on the "theme" field. It's identical to ThemeEQ.
-func Theme(v string) predicate.Question {
-	return predicate.Question(sql.FieldEQ(FieldTheme, v))
-}
-
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldCreatedAt, v))
@@ -70,69 +65,19 @@ func Text(v string) predicate.Question {
 	return predicate.Question(sql.FieldEQ(FieldText, v))
 }
 
-// ThemeEQ applies the EQ predicate on the "theme" field.
-func ThemeEQ(v string) predicate.Question {
-	return predicate.Question(sql.FieldEQ(FieldTheme, v))
-}
-
-// ThemeNEQ applies the NEQ predicate on the "theme" field.
-func ThemeNEQ(v string) predicate.Question {
-	return predicate.Question(sql.FieldNEQ(FieldTheme, v))
-}
-
-// ThemeIn applies the In predicate on the "theme" field.
-func ThemeIn(vs ...string) predicate.Question {
-	return predicate.Question(sql.FieldIn(FieldTheme, vs...))
-}
-
-// ThemeNotIn applies the NotIn predicate on the "theme" field.
-func ThemeNotIn(vs ...string) predicate.Question {
-	return predicate.Question(sql.FieldNotIn(FieldTheme, vs...))
-}
-
-// ThemeGT applies the GT predicate on the "theme" field.
-func ThemeGT(v string) predicate.Question {
-	return predicate.Question(sql.FieldGT(FieldTheme, v))
-}
-
-// ThemeGTE applies the GTE predicate on the "theme" field.
-func ThemeGTE(v string) predicate.Question {
-	return predicate.Question(sql.FieldGTE(FieldTheme, v))
-}
-
-// ThemeLT applies the LT predicate on the "theme" field.
-func ThemeLT(v string) predicate.Question {
-	return predicate.Question(sql.FieldLT(FieldTheme, v))
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldRequired, v))
 }
 
-// ThemeLTE applies the LTE predicate on the "theme" field.
-func ThemeLTE(v string) predicate.Question {
-	return predicate.Question(sql.FieldLTE(FieldTheme, v))
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldPosition, v))
 }
 
-// ThemeContains applies the Contains predicate on the "theme" field.
-func ThemeContains(v string) predicate.Question {
-	return predicate.Question(sql.FieldContains(FieldTheme, v))
-}
-
-// ThemeHasPrefix applies the HasPrefix predicate on the "theme" field.
-func ThemeHasPrefix(v string) predicate.Question {
-	return predicate.Question(sql.FieldHasPrefix(FieldTheme, v))
-}
-
-// ThemeHasSuffix applies the HasSuffix predicate on the "theme" field.
-func ThemeHasSuffix(v string) predicate.Question {
-	return predicate.Question(sql.FieldHasSuffix(FieldTheme, v))
-}
-
-// ThemeEqualFold applies the EqualFold predicate on the "theme" field.
-func ThemeEqualFold(v string) predicate.Question {
-	return predicate.Question(sql.FieldEqualFold(FieldTheme, v))
-}
-
-// ThemeContainsFold applies the ContainsFold predicate on the "theme" field.
-func ThemeContainsFold(v string) predicate.Question {
-	return predicate.Question(sql.FieldContainsFold(FieldTheme, v))
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldVersion, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -240,6 +185,96 @@ func TextContainsFold(v string) predicate.Question {
 	return predicate.Question(sql.FieldContainsFold(FieldText, v))
 }
 
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldRequired, v))
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldRequired, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldPosition, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasQuestionnaire applies the HasEdge predicate on the "questionnaire" edge.
 func HasQuestionnaire() predicate.Question {
 	return predicate.Question(func(s *sql.Selector) {
@@ -263,6 +298,29 @@ func HasQuestionnaireWith(preds ...predicate.Questionnaire) predicate.Question {
 	})
 }
 
+// HasSection applies the HasEdge predicate on the "section" edge.
+func HasSection() predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SectionTable, SectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionWith applies the HasEdge predicate on the "section" edge with a given conditions (other predicates).
+func HasSectionWith(preds ...predicate.Section) predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := newSectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAnswers applies the HasEdge predicate on the "answers" edge.
 func HasAnswers() predicate.Question {
 	return predicate.Question(func(s *sql.Selector) {
@@ -286,6 +344,52 @@ func HasAnswersWith(preds ...predicate.Answer) predicate.Question {
 	})
 }
 
+// HasRules applies the HasEdge predicate on the "rules" edge.
+func HasRules() predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RulesTable, RulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRulesWith applies the HasEdge predicate on the "rules" edge with a given conditions (other predicates).
+func HasRulesWith(preds ...predicate.Rule) predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := newRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDependents applies the HasEdge predicate on the "dependents" edge.
+func HasDependents() predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DependentsTable, DependentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDependentsWith applies the HasEdge predicate on the "dependents" edge with a given conditions (other predicates).
+func HasDependentsWith(preds ...predicate.Rule) predicate.Question {
+	return predicate.Question(func(s *sql.Selector) {
+		step := newDependentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Question) predicate.Question {
 	return predicate.Question(sql.AndPredicates(predicates...))
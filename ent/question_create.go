@@ -9,7 +9,11 @@ import (
 	"radgifa/ent/answer"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -20,12 +24,7 @@ type QuestionCreate struct {
 	config
 	mutation *QuestionMutation
 	hooks    []Hook
-}
-
-// SetTheme sets the "theme" field.
-func (_c *QuestionCreate) SetTheme(v string) *QuestionCreate {
-	_c.mutation.SetTheme(v)
-	return _c
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -48,6 +47,48 @@ func (_c *QuestionCreate) SetText(v string) *QuestionCreate {
 	return _c
 }
 
+// SetRequired sets the "required" field.
+func (_c *QuestionCreate) SetRequired(v bool) *QuestionCreate {
+	_c.mutation.SetRequired(v)
+	return _c
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableRequired(v *bool) *QuestionCreate {
+	if v != nil {
+		_c.SetRequired(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *QuestionCreate) SetPosition(v int) *QuestionCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillablePosition(v *int) *QuestionCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *QuestionCreate) SetVersion(v int) *QuestionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableVersion(v *int) *QuestionCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *QuestionCreate) SetID(v uuid.UUID) *QuestionCreate {
 	_c.mutation.SetID(v)
@@ -73,6 +114,25 @@ func (_c *QuestionCreate) SetQuestionnaire(v *Questionnaire) *QuestionCreate {
 	return _c.SetQuestionnaireID(v.ID)
 }
 
+// SetSectionID sets the "section" edge to the Section entity by ID.
+func (_c *QuestionCreate) SetSectionID(id uuid.UUID) *QuestionCreate {
+	_c.mutation.SetSectionID(id)
+	return _c
+}
+
+// SetNillableSectionID sets the "section" edge to the Section entity by ID if the given value is not nil.
+func (_c *QuestionCreate) SetNillableSectionID(id *uuid.UUID) *QuestionCreate {
+	if id != nil {
+		_c = _c.SetSectionID(*id)
+	}
+	return _c
+}
+
+// SetSection sets the "section" edge to the Section entity.
+func (_c *QuestionCreate) SetSection(v *Section) *QuestionCreate {
+	return _c.SetSectionID(v.ID)
+}
+
 // AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
 func (_c *QuestionCreate) AddAnswerIDs(ids ...uuid.UUID) *QuestionCreate {
 	_c.mutation.AddAnswerIDs(ids...)
@@ -88,6 +148,36 @@ func (_c *QuestionCreate) AddAnswers(v ...*Answer) *QuestionCreate {
 	return _c.AddAnswerIDs(ids...)
 }
 
+// AddRuleIDs adds the "rules" edge to the Rule entity by IDs.
+func (_c *QuestionCreate) AddRuleIDs(ids ...uuid.UUID) *QuestionCreate {
+	_c.mutation.AddRuleIDs(ids...)
+	return _c
+}
+
+// AddRules adds the "rules" edges to the Rule entity.
+func (_c *QuestionCreate) AddRules(v ...*Rule) *QuestionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRuleIDs(ids...)
+}
+
+// AddDependentIDs adds the "dependents" edge to the Rule entity by IDs.
+func (_c *QuestionCreate) AddDependentIDs(ids ...uuid.UUID) *QuestionCreate {
+	_c.mutation.AddDependentIDs(ids...)
+	return _c
+}
+
+// AddDependents adds the "dependents" edges to the Rule entity.
+func (_c *QuestionCreate) AddDependents(v ...*Rule) *QuestionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDependentIDs(ids...)
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (_c *QuestionCreate) Mutation() *QuestionMutation {
 	return _c.mutation
@@ -127,6 +217,18 @@ func (_c *QuestionCreate) defaults() {
 		v := question.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.Required(); !ok {
+		v := question.DefaultRequired
+		_c.mutation.SetRequired(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := question.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := question.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := question.DefaultID()
 		_c.mutation.SetID(v)
@@ -135,20 +237,31 @@ func (_c *QuestionCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *QuestionCreate) check() error {
-	if _, ok := _c.mutation.Theme(); !ok {
-		return &ValidationError{Name: "theme", err: errors.New(`ent: missing required field "Question.theme"`)}
-	}
-	if v, ok := _c.mutation.Theme(); ok {
-		if err := question.ThemeValidator(v); err != nil {
-			return &ValidationError{Name: "theme", err: fmt.Errorf(`ent: validator failed for field "Question.theme": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Question.created_at"`)}
 	}
 	if _, ok := _c.mutation.Text(); !ok {
 		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Question.text"`)}
 	}
+	if _, ok := _c.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "Question.required"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Question.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := question.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Question.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Question.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := question.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Question.version": %w`, err)}
+		}
+	}
 	if len(_c.mutation.QuestionnaireIDs()) == 0 {
 		return &ValidationError{Name: "questionnaire", err: errors.New(`ent: missing required edge "Question.questionnaire"`)}
 	}
@@ -183,14 +296,11 @@ func (_c *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_node = &Question{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(question.Table, sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
-	if value, ok := _c.mutation.Theme(); ok {
-		_spec.SetField(question.FieldTheme, field.TypeString, value)
-		_node.Theme = value
-	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(question.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
@@ -199,6 +309,18 @@ func (_c *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_spec.SetField(question.FieldText, field.TypeString, value)
 		_node.Text = value
 	}
+	if value, ok := _c.mutation.Required(); ok {
+		_spec.SetField(question.FieldRequired, field.TypeBool, value)
+		_node.Required = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(question.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(question.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if nodes := _c.mutation.QuestionnaireIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -216,6 +338,23 @@ func (_c *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		_node.questionnaire_questions = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.SectionTable,
+			Columns: []string{question.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.section_questions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.AnswersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -232,14 +371,315 @@ func (_c *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.RulesTable,
+			Columns: []string{question.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.DependentsTable,
+			Columns: []string{question.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionCreate) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertOne {
+	_c.conflict = opts
+	return &QuestionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionCreate) OnConflictColumns(columns ...string) *QuestionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// QuestionUpsertOne is the builder for "upsert"-ing
+	//  one Question node.
+	QuestionUpsertOne struct {
+		create *QuestionCreate
+	}
+
+	// QuestionUpsert is the "OnConflict" setter.
+	QuestionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetText sets the "text" field.
+func (u *QuestionUpsert) SetText(v string) *QuestionUpsert {
+	u.Set(question.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateText() *QuestionUpsert {
+	u.SetExcluded(question.FieldText)
+	return u
+}
+
+// SetRequired sets the "required" field.
+func (u *QuestionUpsert) SetRequired(v bool) *QuestionUpsert {
+	u.Set(question.FieldRequired, v)
+	return u
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateRequired() *QuestionUpsert {
+	u.SetExcluded(question.FieldRequired)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *QuestionUpsert) SetPosition(v int) *QuestionUpsert {
+	u.Set(question.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdatePosition() *QuestionUpsert {
+	u.SetExcluded(question.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *QuestionUpsert) AddPosition(v int) *QuestionUpsert {
+	u.Add(question.FieldPosition, v)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionUpsert) SetVersion(v int) *QuestionUpsert {
+	u.Set(question.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionUpsert) UpdateVersion() *QuestionUpsert {
+	u.SetExcluded(question.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionUpsert) AddVersion(v int) *QuestionUpsert {
+	u.Add(question.FieldVersion, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(question.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertOne) UpdateNewValues() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(question.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(question.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionUpsertOne) Ignore() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertOne) DoNothing() *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreate.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertOne) Update(set func(*QuestionUpsert)) *QuestionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *QuestionUpsertOne) SetText(v string) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateText() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *QuestionUpsertOne) SetRequired(v bool) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateRequired() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *QuestionUpsertOne) SetPosition(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *QuestionUpsertOne) AddPosition(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdatePosition() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionUpsertOne) SetVersion(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionUpsertOne) AddVersion(v int) *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionUpsertOne) UpdateVersion() *QuestionUpsertOne {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QuestionUpsertOne.ID is not supported by MySQL driver. Use QuestionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // QuestionCreateBulk is the builder for creating many Question entities in bulk.
 type QuestionCreateBulk struct {
 	config
 	err      error
 	builders []*QuestionCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Question entities in the database.
@@ -269,6 +709,7 @@ func (_c *QuestionCreateBulk) Save(ctx context.Context) ([]*Question, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -318,3 +759,190 @@ func (_c *QuestionCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Question.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionUpsertBulk {
+	_c.conflict = opts
+	return &QuestionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionCreateBulk) OnConflictColumns(columns ...string) *QuestionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionUpsertBulk{
+		create: _c,
+	}
+}
+
+// QuestionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Question nodes.
+type QuestionUpsertBulk struct {
+	create *QuestionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(question.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) UpdateNewValues() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(question.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(question.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Question.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionUpsertBulk) Ignore() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionUpsertBulk) DoNothing() *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionUpsertBulk) Update(set func(*QuestionUpsert)) *QuestionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *QuestionUpsertBulk) SetText(v string) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateText() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *QuestionUpsertBulk) SetRequired(v bool) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateRequired() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *QuestionUpsertBulk) SetPosition(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *QuestionUpsertBulk) AddPosition(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdatePosition() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionUpsertBulk) SetVersion(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionUpsertBulk) AddVersion(v int) *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionUpsertBulk) UpdateVersion() *QuestionUpsertBulk {
+	return u.Update(func(s *QuestionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
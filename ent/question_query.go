@@ -11,8 +11,11 @@ import (
 	"radgifa/ent/predicate"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -22,13 +25,21 @@ import (
 // QuestionQuery is the builder for querying Question entities.
 type QuestionQuery struct {
 	config
-	ctx               *QueryContext
-	order             []question.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Question
-	withQuestionnaire *QuestionnaireQuery
-	withAnswers       *AnswerQuery
-	withFKs           bool
+	ctx                 *QueryContext
+	order               []question.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Question
+	withQuestionnaire   *QuestionnaireQuery
+	withSection         *SectionQuery
+	withAnswers         *AnswerQuery
+	withRules           *RuleQuery
+	withDependents      *RuleQuery
+	withFKs             bool
+	loadTotal           []func(context.Context, []*Question) error
+	modifiers           []func(*sql.Selector)
+	withNamedAnswers    map[string]*AnswerQuery
+	withNamedRules      map[string]*RuleQuery
+	withNamedDependents map[string]*RuleQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +98,28 @@ func (_q *QuestionQuery) QueryQuestionnaire() *QuestionnaireQuery {
 	return query
 }
 
+// QuerySection chains the current query on the "section" edge.
+func (_q *QuestionQuery) QuerySection() *SectionQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, selector),
+			sqlgraph.To(section.Table, section.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.SectionTable, question.SectionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAnswers chains the current query on the "answers" edge.
 func (_q *QuestionQuery) QueryAnswers() *AnswerQuery {
 	query := (&AnswerClient{config: _q.config}).Query()
@@ -109,6 +142,50 @@ func (_q *QuestionQuery) QueryAnswers() *AnswerQuery {
 	return query
 }
 
+// QueryRules chains the current query on the "rules" edge.
+func (_q *QuestionQuery) QueryRules() *RuleQuery {
+	query := (&RuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, selector),
+			sqlgraph.To(rule.Table, rule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.RulesTable, question.RulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDependents chains the current query on the "dependents" edge.
+func (_q *QuestionQuery) QueryDependents() *RuleQuery {
+	query := (&RuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, selector),
+			sqlgraph.To(rule.Table, rule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.DependentsTable, question.DependentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Question entity from the query.
 // Returns a *NotFoundError when no Question was found.
 func (_q *QuestionQuery) First(ctx context.Context) (*Question, error) {
@@ -302,7 +379,10 @@ func (_q *QuestionQuery) Clone() *QuestionQuery {
 		inters:            append([]Interceptor{}, _q.inters...),
 		predicates:        append([]predicate.Question{}, _q.predicates...),
 		withQuestionnaire: _q.withQuestionnaire.Clone(),
+		withSection:       _q.withSection.Clone(),
 		withAnswers:       _q.withAnswers.Clone(),
+		withRules:         _q.withRules.Clone(),
+		withDependents:    _q.withDependents.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -320,6 +400,17 @@ func (_q *QuestionQuery) WithQuestionnaire(opts ...func(*QuestionnaireQuery)) *Q
 	return _q
 }
 
+// WithSection tells the query-builder to eager-load the nodes that are connected to
+// the "section" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionQuery) WithSection(opts ...func(*SectionQuery)) *QuestionQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSection = query
+	return _q
+}
+
 // WithAnswers tells the query-builder to eager-load the nodes that are connected to
 // the "answers" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *QuestionQuery) WithAnswers(opts ...func(*AnswerQuery)) *QuestionQuery {
@@ -331,18 +422,40 @@ func (_q *QuestionQuery) WithAnswers(opts ...func(*AnswerQuery)) *QuestionQuery
 	return _q
 }
 
+// WithRules tells the query-builder to eager-load the nodes that are connected to
+// the "rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionQuery) WithRules(opts ...func(*RuleQuery)) *QuestionQuery {
+	query := (&RuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRules = query
+	return _q
+}
+
+// WithDependents tells the query-builder to eager-load the nodes that are connected to
+// the "dependents" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionQuery) WithDependents(opts ...func(*RuleQuery)) *QuestionQuery {
+	query := (&RuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDependents = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Theme string `json:"theme,omitempty"`
+//		CreatedAt int64 `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Question.Query().
-//		GroupBy(question.FieldTheme).
+//		GroupBy(question.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *QuestionQuery) GroupBy(field string, fields ...string) *QuestionGroupBy {
@@ -360,11 +473,11 @@ func (_q *QuestionQuery) GroupBy(field string, fields ...string) *QuestionGroupB
 // Example:
 //
 //	var v []struct {
-//		Theme string `json:"theme,omitempty"`
+//		CreatedAt int64 `json:"created_at,omitempty"`
 //	}
 //
 //	client.Question.Query().
-//		Select(question.FieldTheme).
+//		Select(question.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *QuestionQuery) Select(fields ...string) *QuestionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -410,12 +523,15 @@ func (_q *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 		nodes       = []*Question{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			_q.withQuestionnaire != nil,
+			_q.withSection != nil,
 			_q.withAnswers != nil,
+			_q.withRules != nil,
+			_q.withDependents != nil,
 		}
 	)
-	if _q.withQuestionnaire != nil {
+	if _q.withQuestionnaire != nil || _q.withSection != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -430,6 +546,9 @@ func (_q *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -445,6 +564,12 @@ func (_q *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 			return nil, err
 		}
 	}
+	if query := _q.withSection; query != nil {
+		if err := _q.loadSection(ctx, query, nodes, nil,
+			func(n *Question, e *Section) { n.Edges.Section = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withAnswers; query != nil {
 		if err := _q.loadAnswers(ctx, query, nodes,
 			func(n *Question) { n.Edges.Answers = []*Answer{} },
@@ -452,6 +577,46 @@ func (_q *QuestionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Que
 			return nil, err
 		}
 	}
+	if query := _q.withRules; query != nil {
+		if err := _q.loadRules(ctx, query, nodes,
+			func(n *Question) { n.Edges.Rules = []*Rule{} },
+			func(n *Question, e *Rule) { n.Edges.Rules = append(n.Edges.Rules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDependents; query != nil {
+		if err := _q.loadDependents(ctx, query, nodes,
+			func(n *Question) { n.Edges.Dependents = []*Rule{} },
+			func(n *Question, e *Rule) { n.Edges.Dependents = append(n.Edges.Dependents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedAnswers {
+		if err := _q.loadAnswers(ctx, query, nodes,
+			func(n *Question) { n.appendNamedAnswers(name) },
+			func(n *Question, e *Answer) { n.appendNamedAnswers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedRules {
+		if err := _q.loadRules(ctx, query, nodes,
+			func(n *Question) { n.appendNamedRules(name) },
+			func(n *Question, e *Rule) { n.appendNamedRules(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedDependents {
+		if err := _q.loadDependents(ctx, query, nodes,
+			func(n *Question) { n.appendNamedDependents(name) },
+			func(n *Question, e *Rule) { n.appendNamedDependents(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -487,6 +652,38 @@ func (_q *QuestionQuery) loadQuestionnaire(ctx context.Context, query *Questionn
 	}
 	return nil
 }
+func (_q *QuestionQuery) loadSection(ctx context.Context, query *SectionQuery, nodes []*Question, init func(*Question), assign func(*Question, *Section)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Question)
+	for i := range nodes {
+		if nodes[i].section_questions == nil {
+			continue
+		}
+		fk := *nodes[i].section_questions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(section.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "section_questions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *QuestionQuery) loadAnswers(ctx context.Context, query *AnswerQuery, nodes []*Question, init func(*Question), assign func(*Question, *Answer)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Question)
@@ -518,9 +715,74 @@ func (_q *QuestionQuery) loadAnswers(ctx context.Context, query *AnswerQuery, no
 	}
 	return nil
 }
+func (_q *QuestionQuery) loadRules(ctx context.Context, query *RuleQuery, nodes []*Question, init func(*Question), assign func(*Question, *Rule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Question)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Rule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(question.RulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.question_rules
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "question_rules" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "question_rules" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *QuestionQuery) loadDependents(ctx context.Context, query *RuleQuery, nodes []*Question, init func(*Question), assign func(*Question, *Rule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Question)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Rule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(question.DependentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.question_dependents
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "question_dependents" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "question_dependents" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *QuestionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -583,6 +845,9 @@ func (_q *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -600,6 +865,74 @@ func (_q *QuestionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *QuestionQuery) ForUpdate(opts ...sql.LockOption) *QuestionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *QuestionQuery) ForShare(opts ...sql.LockOption) *QuestionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// WithNamedAnswers tells the query-builder to eager-load the nodes that are connected to the "answers"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionQuery) WithNamedAnswers(name string, opts ...func(*AnswerQuery)) *QuestionQuery {
+	query := (&AnswerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedAnswers == nil {
+		_q.withNamedAnswers = make(map[string]*AnswerQuery)
+	}
+	_q.withNamedAnswers[name] = query
+	return _q
+}
+
+// WithNamedRules tells the query-builder to eager-load the nodes that are connected to the "rules"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionQuery) WithNamedRules(name string, opts ...func(*RuleQuery)) *QuestionQuery {
+	query := (&RuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedRules == nil {
+		_q.withNamedRules = make(map[string]*RuleQuery)
+	}
+	_q.withNamedRules[name] = query
+	return _q
+}
+
+// WithNamedDependents tells the query-builder to eager-load the nodes that are connected to the "dependents"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionQuery) WithNamedDependents(name string, opts ...func(*RuleQuery)) *QuestionQuery {
+	query := (&RuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedDependents == nil {
+		_q.withNamedDependents = make(map[string]*RuleQuery)
+	}
+	_q.withNamedDependents[name] = query
+	return _q
+}
+
 // QuestionGroupBy is the group-by builder for Question entities.
 type QuestionGroupBy struct {
 	selector
@@ -10,6 +10,8 @@ import (
 	"radgifa/ent/predicate"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -30,34 +32,76 @@ func (_u *QuestionUpdate) Where(ps ...predicate.Question) *QuestionUpdate {
 	return _u
 }
 
-// SetTheme sets the "theme" field.
-func (_u *QuestionUpdate) SetTheme(v string) *QuestionUpdate {
-	_u.mutation.SetTheme(v)
+// SetText sets the "text" field.
+func (_u *QuestionUpdate) SetText(v string) *QuestionUpdate {
+	_u.mutation.SetText(v)
 	return _u
 }
 
-// SetNillableTheme sets the "theme" field if the given value is not nil.
-func (_u *QuestionUpdate) SetNillableTheme(v *string) *QuestionUpdate {
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *QuestionUpdate) SetNillableText(v *string) *QuestionUpdate {
 	if v != nil {
-		_u.SetTheme(*v)
+		_u.SetText(*v)
 	}
 	return _u
 }
 
-// SetText sets the "text" field.
-func (_u *QuestionUpdate) SetText(v string) *QuestionUpdate {
-	_u.mutation.SetText(v)
+// SetRequired sets the "required" field.
+func (_u *QuestionUpdate) SetRequired(v bool) *QuestionUpdate {
+	_u.mutation.SetRequired(v)
 	return _u
 }
 
-// SetNillableText sets the "text" field if the given value is not nil.
-func (_u *QuestionUpdate) SetNillableText(v *string) *QuestionUpdate {
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *QuestionUpdate) SetNillableRequired(v *bool) *QuestionUpdate {
 	if v != nil {
-		_u.SetText(*v)
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *QuestionUpdate) SetPosition(v int) *QuestionUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *QuestionUpdate) SetNillablePosition(v *int) *QuestionUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *QuestionUpdate) AddPosition(v int) *QuestionUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *QuestionUpdate) SetVersion(v int) *QuestionUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *QuestionUpdate) SetNillableVersion(v *int) *QuestionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
 	}
 	return _u
 }
 
+// AddVersion adds value to the "version" field.
+func (_u *QuestionUpdate) AddVersion(v int) *QuestionUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by ID.
 func (_u *QuestionUpdate) SetQuestionnaireID(id uuid.UUID) *QuestionUpdate {
 	_u.mutation.SetQuestionnaireID(id)
@@ -69,6 +113,25 @@ func (_u *QuestionUpdate) SetQuestionnaire(v *Questionnaire) *QuestionUpdate {
 	return _u.SetQuestionnaireID(v.ID)
 }
 
+// SetSectionID sets the "section" edge to the Section entity by ID.
+func (_u *QuestionUpdate) SetSectionID(id uuid.UUID) *QuestionUpdate {
+	_u.mutation.SetSectionID(id)
+	return _u
+}
+
+// SetNillableSectionID sets the "section" edge to the Section entity by ID if the given value is not nil.
+func (_u *QuestionUpdate) SetNillableSectionID(id *uuid.UUID) *QuestionUpdate {
+	if id != nil {
+		_u = _u.SetSectionID(*id)
+	}
+	return _u
+}
+
+// SetSection sets the "section" edge to the Section entity.
+func (_u *QuestionUpdate) SetSection(v *Section) *QuestionUpdate {
+	return _u.SetSectionID(v.ID)
+}
+
 // AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
 func (_u *QuestionUpdate) AddAnswerIDs(ids ...uuid.UUID) *QuestionUpdate {
 	_u.mutation.AddAnswerIDs(ids...)
@@ -84,6 +147,36 @@ func (_u *QuestionUpdate) AddAnswers(v ...*Answer) *QuestionUpdate {
 	return _u.AddAnswerIDs(ids...)
 }
 
+// AddRuleIDs adds the "rules" edge to the Rule entity by IDs.
+func (_u *QuestionUpdate) AddRuleIDs(ids ...uuid.UUID) *QuestionUpdate {
+	_u.mutation.AddRuleIDs(ids...)
+	return _u
+}
+
+// AddRules adds the "rules" edges to the Rule entity.
+func (_u *QuestionUpdate) AddRules(v ...*Rule) *QuestionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleIDs(ids...)
+}
+
+// AddDependentIDs adds the "dependents" edge to the Rule entity by IDs.
+func (_u *QuestionUpdate) AddDependentIDs(ids ...uuid.UUID) *QuestionUpdate {
+	_u.mutation.AddDependentIDs(ids...)
+	return _u
+}
+
+// AddDependents adds the "dependents" edges to the Rule entity.
+func (_u *QuestionUpdate) AddDependents(v ...*Rule) *QuestionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDependentIDs(ids...)
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (_u *QuestionUpdate) Mutation() *QuestionMutation {
 	return _u.mutation
@@ -95,6 +188,12 @@ func (_u *QuestionUpdate) ClearQuestionnaire() *QuestionUpdate {
 	return _u
 }
 
+// ClearSection clears the "section" edge to the Section entity.
+func (_u *QuestionUpdate) ClearSection() *QuestionUpdate {
+	_u.mutation.ClearSection()
+	return _u
+}
+
 // ClearAnswers clears all "answers" edges to the Answer entity.
 func (_u *QuestionUpdate) ClearAnswers() *QuestionUpdate {
 	_u.mutation.ClearAnswers()
@@ -116,6 +215,48 @@ func (_u *QuestionUpdate) RemoveAnswers(v ...*Answer) *QuestionUpdate {
 	return _u.RemoveAnswerIDs(ids...)
 }
 
+// ClearRules clears all "rules" edges to the Rule entity.
+func (_u *QuestionUpdate) ClearRules() *QuestionUpdate {
+	_u.mutation.ClearRules()
+	return _u
+}
+
+// RemoveRuleIDs removes the "rules" edge to Rule entities by IDs.
+func (_u *QuestionUpdate) RemoveRuleIDs(ids ...uuid.UUID) *QuestionUpdate {
+	_u.mutation.RemoveRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRules removes "rules" edges to Rule entities.
+func (_u *QuestionUpdate) RemoveRules(v ...*Rule) *QuestionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleIDs(ids...)
+}
+
+// ClearDependents clears all "dependents" edges to the Rule entity.
+func (_u *QuestionUpdate) ClearDependents() *QuestionUpdate {
+	_u.mutation.ClearDependents()
+	return _u
+}
+
+// RemoveDependentIDs removes the "dependents" edge to Rule entities by IDs.
+func (_u *QuestionUpdate) RemoveDependentIDs(ids ...uuid.UUID) *QuestionUpdate {
+	_u.mutation.RemoveDependentIDs(ids...)
+	return _u
+}
+
+// RemoveDependents removes "dependents" edges to Rule entities.
+func (_u *QuestionUpdate) RemoveDependents(v ...*Rule) *QuestionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDependentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *QuestionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -145,9 +286,14 @@ func (_u *QuestionUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *QuestionUpdate) check() error {
-	if v, ok := _u.mutation.Theme(); ok {
-		if err := question.ThemeValidator(v); err != nil {
-			return &ValidationError{Name: "theme", err: fmt.Errorf(`ent: validator failed for field "Question.theme": %w`, err)}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := question.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Question.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := question.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Question.version": %w`, err)}
 		}
 	}
 	if _u.mutation.QuestionnaireCleared() && len(_u.mutation.QuestionnaireIDs()) > 0 {
@@ -168,12 +314,24 @@ func (_u *QuestionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.Theme(); ok {
-		_spec.SetField(question.FieldTheme, field.TypeString, value)
-	}
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(question.FieldText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(question.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(question.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(question.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(question.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(question.FieldVersion, field.TypeInt, value)
+	}
 	if _u.mutation.QuestionnaireCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -203,6 +361,35 @@ func (_u *QuestionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.SectionTable,
+			Columns: []string{question.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.SectionTable,
+			Columns: []string{question.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.AnswersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -248,6 +435,96 @@ func (_u *QuestionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.RulesTable,
+			Columns: []string{question.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRulesIDs(); len(nodes) > 0 && !_u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.RulesTable,
+			Columns: []string{question.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.RulesTable,
+			Columns: []string{question.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.DependentsTable,
+			Columns: []string{question.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDependentsIDs(); len(nodes) > 0 && !_u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.DependentsTable,
+			Columns: []string{question.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.DependentsTable,
+			Columns: []string{question.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{question.Label}
@@ -268,34 +545,76 @@ type QuestionUpdateOne struct {
 	mutation *QuestionMutation
 }
 
-// SetTheme sets the "theme" field.
-func (_u *QuestionUpdateOne) SetTheme(v string) *QuestionUpdateOne {
-	_u.mutation.SetTheme(v)
+// SetText sets the "text" field.
+func (_u *QuestionUpdateOne) SetText(v string) *QuestionUpdateOne {
+	_u.mutation.SetText(v)
 	return _u
 }
 
-// SetNillableTheme sets the "theme" field if the given value is not nil.
-func (_u *QuestionUpdateOne) SetNillableTheme(v *string) *QuestionUpdateOne {
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *QuestionUpdateOne) SetNillableText(v *string) *QuestionUpdateOne {
 	if v != nil {
-		_u.SetTheme(*v)
+		_u.SetText(*v)
 	}
 	return _u
 }
 
-// SetText sets the "text" field.
-func (_u *QuestionUpdateOne) SetText(v string) *QuestionUpdateOne {
-	_u.mutation.SetText(v)
+// SetRequired sets the "required" field.
+func (_u *QuestionUpdateOne) SetRequired(v bool) *QuestionUpdateOne {
+	_u.mutation.SetRequired(v)
 	return _u
 }
 
-// SetNillableText sets the "text" field if the given value is not nil.
-func (_u *QuestionUpdateOne) SetNillableText(v *string) *QuestionUpdateOne {
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *QuestionUpdateOne) SetNillableRequired(v *bool) *QuestionUpdateOne {
 	if v != nil {
-		_u.SetText(*v)
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *QuestionUpdateOne) SetPosition(v int) *QuestionUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *QuestionUpdateOne) SetNillablePosition(v *int) *QuestionUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *QuestionUpdateOne) AddPosition(v int) *QuestionUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *QuestionUpdateOne) SetVersion(v int) *QuestionUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *QuestionUpdateOne) SetNillableVersion(v *int) *QuestionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
 	}
 	return _u
 }
 
+// AddVersion adds value to the "version" field.
+func (_u *QuestionUpdateOne) AddVersion(v int) *QuestionUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetQuestionnaireID sets the "questionnaire" edge to the Questionnaire entity by ID.
 func (_u *QuestionUpdateOne) SetQuestionnaireID(id uuid.UUID) *QuestionUpdateOne {
 	_u.mutation.SetQuestionnaireID(id)
@@ -307,6 +626,25 @@ func (_u *QuestionUpdateOne) SetQuestionnaire(v *Questionnaire) *QuestionUpdateO
 	return _u.SetQuestionnaireID(v.ID)
 }
 
+// SetSectionID sets the "section" edge to the Section entity by ID.
+func (_u *QuestionUpdateOne) SetSectionID(id uuid.UUID) *QuestionUpdateOne {
+	_u.mutation.SetSectionID(id)
+	return _u
+}
+
+// SetNillableSectionID sets the "section" edge to the Section entity by ID if the given value is not nil.
+func (_u *QuestionUpdateOne) SetNillableSectionID(id *uuid.UUID) *QuestionUpdateOne {
+	if id != nil {
+		_u = _u.SetSectionID(*id)
+	}
+	return _u
+}
+
+// SetSection sets the "section" edge to the Section entity.
+func (_u *QuestionUpdateOne) SetSection(v *Section) *QuestionUpdateOne {
+	return _u.SetSectionID(v.ID)
+}
+
 // AddAnswerIDs adds the "answers" edge to the Answer entity by IDs.
 func (_u *QuestionUpdateOne) AddAnswerIDs(ids ...uuid.UUID) *QuestionUpdateOne {
 	_u.mutation.AddAnswerIDs(ids...)
@@ -322,6 +660,36 @@ func (_u *QuestionUpdateOne) AddAnswers(v ...*Answer) *QuestionUpdateOne {
 	return _u.AddAnswerIDs(ids...)
 }
 
+// AddRuleIDs adds the "rules" edge to the Rule entity by IDs.
+func (_u *QuestionUpdateOne) AddRuleIDs(ids ...uuid.UUID) *QuestionUpdateOne {
+	_u.mutation.AddRuleIDs(ids...)
+	return _u
+}
+
+// AddRules adds the "rules" edges to the Rule entity.
+func (_u *QuestionUpdateOne) AddRules(v ...*Rule) *QuestionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRuleIDs(ids...)
+}
+
+// AddDependentIDs adds the "dependents" edge to the Rule entity by IDs.
+func (_u *QuestionUpdateOne) AddDependentIDs(ids ...uuid.UUID) *QuestionUpdateOne {
+	_u.mutation.AddDependentIDs(ids...)
+	return _u
+}
+
+// AddDependents adds the "dependents" edges to the Rule entity.
+func (_u *QuestionUpdateOne) AddDependents(v ...*Rule) *QuestionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDependentIDs(ids...)
+}
+
 // Mutation returns the QuestionMutation object of the builder.
 func (_u *QuestionUpdateOne) Mutation() *QuestionMutation {
 	return _u.mutation
@@ -333,6 +701,12 @@ func (_u *QuestionUpdateOne) ClearQuestionnaire() *QuestionUpdateOne {
 	return _u
 }
 
+// ClearSection clears the "section" edge to the Section entity.
+func (_u *QuestionUpdateOne) ClearSection() *QuestionUpdateOne {
+	_u.mutation.ClearSection()
+	return _u
+}
+
 // ClearAnswers clears all "answers" edges to the Answer entity.
 func (_u *QuestionUpdateOne) ClearAnswers() *QuestionUpdateOne {
 	_u.mutation.ClearAnswers()
@@ -354,6 +728,48 @@ func (_u *QuestionUpdateOne) RemoveAnswers(v ...*Answer) *QuestionUpdateOne {
 	return _u.RemoveAnswerIDs(ids...)
 }
 
+// ClearRules clears all "rules" edges to the Rule entity.
+func (_u *QuestionUpdateOne) ClearRules() *QuestionUpdateOne {
+	_u.mutation.ClearRules()
+	return _u
+}
+
+// RemoveRuleIDs removes the "rules" edge to Rule entities by IDs.
+func (_u *QuestionUpdateOne) RemoveRuleIDs(ids ...uuid.UUID) *QuestionUpdateOne {
+	_u.mutation.RemoveRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRules removes "rules" edges to Rule entities.
+func (_u *QuestionUpdateOne) RemoveRules(v ...*Rule) *QuestionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRuleIDs(ids...)
+}
+
+// ClearDependents clears all "dependents" edges to the Rule entity.
+func (_u *QuestionUpdateOne) ClearDependents() *QuestionUpdateOne {
+	_u.mutation.ClearDependents()
+	return _u
+}
+
+// RemoveDependentIDs removes the "dependents" edge to Rule entities by IDs.
+func (_u *QuestionUpdateOne) RemoveDependentIDs(ids ...uuid.UUID) *QuestionUpdateOne {
+	_u.mutation.RemoveDependentIDs(ids...)
+	return _u
+}
+
+// RemoveDependents removes "dependents" edges to Rule entities.
+func (_u *QuestionUpdateOne) RemoveDependents(v ...*Rule) *QuestionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDependentIDs(ids...)
+}
+
 // Where appends a list predicates to the QuestionUpdate builder.
 func (_u *QuestionUpdateOne) Where(ps ...predicate.Question) *QuestionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -396,9 +812,14 @@ func (_u *QuestionUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *QuestionUpdateOne) check() error {
-	if v, ok := _u.mutation.Theme(); ok {
-		if err := question.ThemeValidator(v); err != nil {
-			return &ValidationError{Name: "theme", err: fmt.Errorf(`ent: validator failed for field "Question.theme": %w`, err)}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := question.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Question.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := question.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Question.version": %w`, err)}
 		}
 	}
 	if _u.mutation.QuestionnaireCleared() && len(_u.mutation.QuestionnaireIDs()) > 0 {
@@ -436,12 +857,24 @@ func (_u *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 			}
 		}
 	}
-	if value, ok := _u.mutation.Theme(); ok {
-		_spec.SetField(question.FieldTheme, field.TypeString, value)
-	}
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(question.FieldText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(question.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(question.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(question.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(question.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(question.FieldVersion, field.TypeInt, value)
+	}
 	if _u.mutation.QuestionnaireCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -471,6 +904,35 @@ func (_u *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.SectionTable,
+			Columns: []string{question.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.SectionTable,
+			Columns: []string{question.SectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.AnswersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -516,6 +978,96 @@ func (_u *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.RulesTable,
+			Columns: []string{question.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRulesIDs(); len(nodes) > 0 && !_u.mutation.RulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.RulesTable,
+			Columns: []string{question.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.RulesTable,
+			Columns: []string{question.RulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.DependentsTable,
+			Columns: []string{question.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDependentsIDs(); len(nodes) > 0 && !_u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.DependentsTable,
+			Columns: []string{question.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.DependentsTable,
+			Columns: []string{question.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Question{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -24,8 +24,14 @@ type Questionnaire struct {
 	Description string `json:"description,omitempty"`
 	// IsPublished holds the value of the "is_published" field.
 	IsPublished bool `json:"is_published,omitempty"`
+	// Members cannot change their answers once they submitted
+	LockAfterSubmit bool `json:"lock_after_submit,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt int64 `json:"created_at,omitempty"`
+	// When the owner closed the questionnaire, which then takes no more members or answers
+	ClosedAt *int64 `json:"closed_at,omitempty"`
+	// Incremented on every edit, backs the ETag of the questionnaire
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QuestionnaireQuery when eager-loading is set.
 	Edges               QuestionnaireEdges `json:"edges"`
@@ -41,9 +47,20 @@ type QuestionnaireEdges struct {
 	Members []*Member `json:"members,omitempty"`
 	// Questions holds the value of the questions edge.
 	Questions []*Question `json:"questions,omitempty"`
+	// Sections holds the value of the sections edge.
+	Sections []*Section `json:"sections,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
+	// totalCount holds the count of the edges above.
+	totalCount [4]map[string]int
+
+	namedMembers   map[string][]*Member
+	namedQuestions map[string][]*Question
+	namedSections  map[string][]*Section
+	namedWebhooks  map[string][]*Webhook
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -75,14 +92,32 @@ func (e QuestionnaireEdges) QuestionsOrErr() ([]*Question, error) {
 	return nil, &NotLoadedError{edge: "questions"}
 }
 
+// SectionsOrErr returns the Sections value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionnaireEdges) SectionsOrErr() ([]*Section, error) {
+	if e.loadedTypes[3] {
+		return e.Sections, nil
+	}
+	return nil, &NotLoadedError{edge: "sections"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionnaireEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[4] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Questionnaire) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case questionnaire.FieldIsPublished:
+		case questionnaire.FieldIsPublished, questionnaire.FieldLockAfterSubmit:
 			values[i] = new(sql.NullBool)
-		case questionnaire.FieldCreatedAt:
+		case questionnaire.FieldCreatedAt, questionnaire.FieldClosedAt, questionnaire.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case questionnaire.FieldTitle, questionnaire.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -129,12 +164,31 @@ func (_m *Questionnaire) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPublished = value.Bool
 			}
+		case questionnaire.FieldLockAfterSubmit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_after_submit", values[i])
+			} else if value.Valid {
+				_m.LockAfterSubmit = value.Bool
+			}
 		case questionnaire.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Int64
 			}
+		case questionnaire.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(int64)
+				*_m.ClosedAt = value.Int64
+			}
+		case questionnaire.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case questionnaire.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_questionnaires", values[i])
@@ -170,6 +224,16 @@ func (_m *Questionnaire) QueryQuestions() *QuestionQuery {
 	return NewQuestionnaireClient(_m.config).QueryQuestions(_m)
 }
 
+// QuerySections queries the "sections" edge of the Questionnaire entity.
+func (_m *Questionnaire) QuerySections() *SectionQuery {
+	return NewQuestionnaireClient(_m.config).QuerySections(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Questionnaire entity.
+func (_m *Questionnaire) QueryWebhooks() *WebhookQuery {
+	return NewQuestionnaireClient(_m.config).QueryWebhooks(_m)
+}
+
 // Update returns a builder for updating this Questionnaire.
 // Note that you need to call Questionnaire.Unwrap() before calling this method if this Questionnaire
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -202,11 +266,118 @@ func (_m *Questionnaire) String() string {
 	builder.WriteString("is_published=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublished))
 	builder.WriteString(", ")
+	builder.WriteString("lock_after_submit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LockAfterSubmit))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedMembers returns the Members named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Questionnaire) NamedMembers(name string) ([]*Member, error) {
+	if _m.Edges.namedMembers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedMembers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Questionnaire) appendNamedMembers(name string, edges ...*Member) {
+	if _m.Edges.namedMembers == nil {
+		_m.Edges.namedMembers = make(map[string][]*Member)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedMembers[name] = []*Member{}
+	} else {
+		_m.Edges.namedMembers[name] = append(_m.Edges.namedMembers[name], edges...)
+	}
+}
+
+// NamedQuestions returns the Questions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Questionnaire) NamedQuestions(name string) ([]*Question, error) {
+	if _m.Edges.namedQuestions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedQuestions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Questionnaire) appendNamedQuestions(name string, edges ...*Question) {
+	if _m.Edges.namedQuestions == nil {
+		_m.Edges.namedQuestions = make(map[string][]*Question)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedQuestions[name] = []*Question{}
+	} else {
+		_m.Edges.namedQuestions[name] = append(_m.Edges.namedQuestions[name], edges...)
+	}
+}
+
+// NamedSections returns the Sections named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Questionnaire) NamedSections(name string) ([]*Section, error) {
+	if _m.Edges.namedSections == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedSections[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Questionnaire) appendNamedSections(name string, edges ...*Section) {
+	if _m.Edges.namedSections == nil {
+		_m.Edges.namedSections = make(map[string][]*Section)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedSections[name] = []*Section{}
+	} else {
+		_m.Edges.namedSections[name] = append(_m.Edges.namedSections[name], edges...)
+	}
+}
+
+// NamedWebhooks returns the Webhooks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Questionnaire) NamedWebhooks(name string) ([]*Webhook, error) {
+	if _m.Edges.namedWebhooks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedWebhooks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Questionnaire) appendNamedWebhooks(name string, edges ...*Webhook) {
+	if _m.Edges.namedWebhooks == nil {
+		_m.Edges.namedWebhooks = make(map[string][]*Webhook)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedWebhooks[name] = []*Webhook{}
+	} else {
+		_m.Edges.namedWebhooks[name] = append(_m.Edges.namedWebhooks[name], edges...)
+	}
+}
+
 // Questionnaires is a parsable slice of Questionnaire.
 type Questionnaires []*Questionnaire
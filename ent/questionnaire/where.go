@@ -70,11 +70,26 @@ func IsPublished(v bool) predicate.Questionnaire {
 	return predicate.Questionnaire(sql.FieldEQ(FieldIsPublished, v))
 }
 
+// LockAfterSubmit applies equality check predicate on the "lock_after_submit" field. It's identical to LockAfterSubmitEQ.
+func LockAfterSubmit(v bool) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldEQ(FieldLockAfterSubmit, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v int64) predicate.Questionnaire {
 	return predicate.Questionnaire(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldEQ(FieldVersion, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Questionnaire {
 	return predicate.Questionnaire(sql.FieldEQ(FieldTitle, v))
@@ -225,6 +240,16 @@ func IsPublishedNEQ(v bool) predicate.Questionnaire {
 	return predicate.Questionnaire(sql.FieldNEQ(FieldIsPublished, v))
 }
 
+// LockAfterSubmitEQ applies the EQ predicate on the "lock_after_submit" field.
+func LockAfterSubmitEQ(v bool) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldEQ(FieldLockAfterSubmit, v))
+}
+
+// LockAfterSubmitNEQ applies the NEQ predicate on the "lock_after_submit" field.
+func LockAfterSubmitNEQ(v bool) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldNEQ(FieldLockAfterSubmit, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v int64) predicate.Questionnaire {
 	return predicate.Questionnaire(sql.FieldEQ(FieldCreatedAt, v))
@@ -265,6 +290,96 @@ func CreatedAtLTE(v int64) predicate.Questionnaire {
 	return predicate.Questionnaire(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v int64) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldNotNull(FieldClosedAt))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Questionnaire {
+	return predicate.Questionnaire(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Questionnaire {
 	return predicate.Questionnaire(func(s *sql.Selector) {
@@ -334,6 +449,52 @@ func HasQuestionsWith(preds ...predicate.Question) predicate.Questionnaire {
 	})
 }
 
+// HasSections applies the HasEdge predicate on the "sections" edge.
+func HasSections() predicate.Questionnaire {
+	return predicate.Questionnaire(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SectionsTable, SectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSectionsWith applies the HasEdge predicate on the "sections" edge with a given conditions (other predicates).
+func HasSectionsWith(preds ...predicate.Section) predicate.Questionnaire {
+	return predicate.Questionnaire(func(s *sql.Selector) {
+		step := newSectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Questionnaire {
+	return predicate.Questionnaire(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Questionnaire {
+	return predicate.Questionnaire(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Questionnaire) predicate.Questionnaire {
 	return predicate.Questionnaire(sql.AndPredicates(predicates...))
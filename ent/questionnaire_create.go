@@ -9,8 +9,12 @@ import (
 	"radgifa/ent/member"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/section"
 	"radgifa/ent/user"
+	"radgifa/ent/webhook"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +25,7 @@ type QuestionnaireCreate struct {
 	config
 	mutation *QuestionnaireMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetTitle sets the "title" field.
@@ -57,6 +62,20 @@ func (_c *QuestionnaireCreate) SetNillableIsPublished(v *bool) *QuestionnaireCre
 	return _c
 }
 
+// SetLockAfterSubmit sets the "lock_after_submit" field.
+func (_c *QuestionnaireCreate) SetLockAfterSubmit(v bool) *QuestionnaireCreate {
+	_c.mutation.SetLockAfterSubmit(v)
+	return _c
+}
+
+// SetNillableLockAfterSubmit sets the "lock_after_submit" field if the given value is not nil.
+func (_c *QuestionnaireCreate) SetNillableLockAfterSubmit(v *bool) *QuestionnaireCreate {
+	if v != nil {
+		_c.SetLockAfterSubmit(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *QuestionnaireCreate) SetCreatedAt(v int64) *QuestionnaireCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -71,6 +90,34 @@ func (_c *QuestionnaireCreate) SetNillableCreatedAt(v *int64) *QuestionnaireCrea
 	return _c
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (_c *QuestionnaireCreate) SetClosedAt(v int64) *QuestionnaireCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *QuestionnaireCreate) SetNillableClosedAt(v *int64) *QuestionnaireCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *QuestionnaireCreate) SetVersion(v int) *QuestionnaireCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *QuestionnaireCreate) SetNillableVersion(v *int) *QuestionnaireCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *QuestionnaireCreate) SetID(v uuid.UUID) *QuestionnaireCreate {
 	_c.mutation.SetID(v)
@@ -126,6 +173,36 @@ func (_c *QuestionnaireCreate) AddQuestions(v ...*Question) *QuestionnaireCreate
 	return _c.AddQuestionIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the Section entity by IDs.
+func (_c *QuestionnaireCreate) AddSectionIDs(ids ...uuid.UUID) *QuestionnaireCreate {
+	_c.mutation.AddSectionIDs(ids...)
+	return _c
+}
+
+// AddSections adds the "sections" edges to the Section entity.
+func (_c *QuestionnaireCreate) AddSections(v ...*Section) *QuestionnaireCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSectionIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *QuestionnaireCreate) AddWebhookIDs(ids ...uuid.UUID) *QuestionnaireCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *QuestionnaireCreate) AddWebhooks(v ...*Webhook) *QuestionnaireCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
 // Mutation returns the QuestionnaireMutation object of the builder.
 func (_c *QuestionnaireCreate) Mutation() *QuestionnaireMutation {
 	return _c.mutation
@@ -165,10 +242,18 @@ func (_c *QuestionnaireCreate) defaults() {
 		v := questionnaire.DefaultIsPublished
 		_c.mutation.SetIsPublished(v)
 	}
+	if _, ok := _c.mutation.LockAfterSubmit(); !ok {
+		v := questionnaire.DefaultLockAfterSubmit
+		_c.mutation.SetLockAfterSubmit(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := questionnaire.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := questionnaire.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := questionnaire.DefaultID()
 		_c.mutation.SetID(v)
@@ -183,9 +268,20 @@ func (_c *QuestionnaireCreate) check() error {
 	if _, ok := _c.mutation.IsPublished(); !ok {
 		return &ValidationError{Name: "is_published", err: errors.New(`ent: missing required field "Questionnaire.is_published"`)}
 	}
+	if _, ok := _c.mutation.LockAfterSubmit(); !ok {
+		return &ValidationError{Name: "lock_after_submit", err: errors.New(`ent: missing required field "Questionnaire.lock_after_submit"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Questionnaire.created_at"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Questionnaire.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := questionnaire.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.version": %w`, err)}
+		}
+	}
 	if len(_c.mutation.OwnerIDs()) == 0 {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Questionnaire.owner"`)}
 	}
@@ -220,6 +316,7 @@ func (_c *QuestionnaireCreate) createSpec() (*Questionnaire, *sqlgraph.CreateSpe
 		_node = &Questionnaire{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(questionnaire.Table, sqlgraph.NewFieldSpec(questionnaire.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -236,10 +333,22 @@ func (_c *QuestionnaireCreate) createSpec() (*Questionnaire, *sqlgraph.CreateSpe
 		_spec.SetField(questionnaire.FieldIsPublished, field.TypeBool, value)
 		_node.IsPublished = value
 	}
+	if value, ok := _c.mutation.LockAfterSubmit(); ok {
+		_spec.SetField(questionnaire.FieldLockAfterSubmit, field.TypeBool, value)
+		_node.LockAfterSubmit = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(questionnaire.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(questionnaire.FieldClosedAt, field.TypeInt64, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(questionnaire.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -289,14 +398,393 @@ func (_c *QuestionnaireCreate) createSpec() (*Questionnaire, *sqlgraph.CreateSpe
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.SectionsTable,
+			Columns: []string{questionnaire.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.WebhooksTable,
+			Columns: []string{questionnaire.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Questionnaire.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireCreate) OnConflict(opts ...sql.ConflictOption) *QuestionnaireUpsertOne {
+	_c.conflict = opts
+	return &QuestionnaireUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Questionnaire.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireCreate) OnConflictColumns(columns ...string) *QuestionnaireUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// QuestionnaireUpsertOne is the builder for "upsert"-ing
+	//  one Questionnaire node.
+	QuestionnaireUpsertOne struct {
+		create *QuestionnaireCreate
+	}
+
+	// QuestionnaireUpsert is the "OnConflict" setter.
+	QuestionnaireUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *QuestionnaireUpsert) SetTitle(v string) *QuestionnaireUpsert {
+	u.Set(questionnaire.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionnaireUpsert) UpdateTitle() *QuestionnaireUpsert {
+	u.SetExcluded(questionnaire.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionnaireUpsert) SetDescription(v string) *QuestionnaireUpsert {
+	u.Set(questionnaire.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionnaireUpsert) UpdateDescription() *QuestionnaireUpsert {
+	u.SetExcluded(questionnaire.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionnaireUpsert) ClearDescription() *QuestionnaireUpsert {
+	u.SetNull(questionnaire.FieldDescription)
+	return u
+}
+
+// SetIsPublished sets the "is_published" field.
+func (u *QuestionnaireUpsert) SetIsPublished(v bool) *QuestionnaireUpsert {
+	u.Set(questionnaire.FieldIsPublished, v)
+	return u
+}
+
+// UpdateIsPublished sets the "is_published" field to the value that was provided on create.
+func (u *QuestionnaireUpsert) UpdateIsPublished() *QuestionnaireUpsert {
+	u.SetExcluded(questionnaire.FieldIsPublished)
+	return u
+}
+
+// SetLockAfterSubmit sets the "lock_after_submit" field.
+func (u *QuestionnaireUpsert) SetLockAfterSubmit(v bool) *QuestionnaireUpsert {
+	u.Set(questionnaire.FieldLockAfterSubmit, v)
+	return u
+}
+
+// UpdateLockAfterSubmit sets the "lock_after_submit" field to the value that was provided on create.
+func (u *QuestionnaireUpsert) UpdateLockAfterSubmit() *QuestionnaireUpsert {
+	u.SetExcluded(questionnaire.FieldLockAfterSubmit)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *QuestionnaireUpsert) SetClosedAt(v int64) *QuestionnaireUpsert {
+	u.Set(questionnaire.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *QuestionnaireUpsert) UpdateClosedAt() *QuestionnaireUpsert {
+	u.SetExcluded(questionnaire.FieldClosedAt)
+	return u
+}
+
+// AddClosedAt adds v to the "closed_at" field.
+func (u *QuestionnaireUpsert) AddClosedAt(v int64) *QuestionnaireUpsert {
+	u.Add(questionnaire.FieldClosedAt, v)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *QuestionnaireUpsert) ClearClosedAt() *QuestionnaireUpsert {
+	u.SetNull(questionnaire.FieldClosedAt)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionnaireUpsert) SetVersion(v int) *QuestionnaireUpsert {
+	u.Set(questionnaire.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionnaireUpsert) UpdateVersion() *QuestionnaireUpsert {
+	u.SetExcluded(questionnaire.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionnaireUpsert) AddVersion(v int) *QuestionnaireUpsert {
+	u.Add(questionnaire.FieldVersion, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Questionnaire.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnaire.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireUpsertOne) UpdateNewValues() *QuestionnaireUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(questionnaire.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(questionnaire.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Questionnaire.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionnaireUpsertOne) Ignore() *QuestionnaireUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireUpsertOne) DoNothing() *QuestionnaireUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireCreate.OnConflict
+// documentation for more info.
+func (u *QuestionnaireUpsertOne) Update(set func(*QuestionnaireUpsert)) *QuestionnaireUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionnaireUpsertOne) SetTitle(v string) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionnaireUpsertOne) UpdateTitle() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionnaireUpsertOne) SetDescription(v string) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionnaireUpsertOne) UpdateDescription() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionnaireUpsertOne) ClearDescription() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIsPublished sets the "is_published" field.
+func (u *QuestionnaireUpsertOne) SetIsPublished(v bool) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetIsPublished(v)
+	})
+}
+
+// UpdateIsPublished sets the "is_published" field to the value that was provided on create.
+func (u *QuestionnaireUpsertOne) UpdateIsPublished() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateIsPublished()
+	})
+}
+
+// SetLockAfterSubmit sets the "lock_after_submit" field.
+func (u *QuestionnaireUpsertOne) SetLockAfterSubmit(v bool) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetLockAfterSubmit(v)
+	})
+}
+
+// UpdateLockAfterSubmit sets the "lock_after_submit" field to the value that was provided on create.
+func (u *QuestionnaireUpsertOne) UpdateLockAfterSubmit() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateLockAfterSubmit()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *QuestionnaireUpsertOne) SetClosedAt(v int64) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// AddClosedAt adds v to the "closed_at" field.
+func (u *QuestionnaireUpsertOne) AddClosedAt(v int64) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.AddClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *QuestionnaireUpsertOne) UpdateClosedAt() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *QuestionnaireUpsertOne) ClearClosedAt() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionnaireUpsertOne) SetVersion(v int) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionnaireUpsertOne) AddVersion(v int) *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionnaireUpsertOne) UpdateVersion() *QuestionnaireUpsertOne {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionnaireUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QuestionnaireUpsertOne.ID is not supported by MySQL driver. Use QuestionnaireUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionnaireUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // QuestionnaireCreateBulk is the builder for creating many Questionnaire entities in bulk.
 type QuestionnaireCreateBulk struct {
 	config
 	err      error
 	builders []*QuestionnaireCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Questionnaire entities in the database.
@@ -326,6 +814,7 @@ func (_c *QuestionnaireCreateBulk) Save(ctx context.Context) ([]*Questionnaire,
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -375,3 +864,232 @@ func (_c *QuestionnaireCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Questionnaire.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionnaireUpsertBulk {
+	_c.conflict = opts
+	return &QuestionnaireUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Questionnaire.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireCreateBulk) OnConflictColumns(columns ...string) *QuestionnaireUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireUpsertBulk{
+		create: _c,
+	}
+}
+
+// QuestionnaireUpsertBulk is the builder for "upsert"-ing
+// a bulk of Questionnaire nodes.
+type QuestionnaireUpsertBulk struct {
+	create *QuestionnaireCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Questionnaire.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnaire.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireUpsertBulk) UpdateNewValues() *QuestionnaireUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(questionnaire.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(questionnaire.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Questionnaire.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionnaireUpsertBulk) Ignore() *QuestionnaireUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireUpsertBulk) DoNothing() *QuestionnaireUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionnaireUpsertBulk) Update(set func(*QuestionnaireUpsert)) *QuestionnaireUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionnaireUpsertBulk) SetTitle(v string) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionnaireUpsertBulk) UpdateTitle() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionnaireUpsertBulk) SetDescription(v string) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionnaireUpsertBulk) UpdateDescription() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionnaireUpsertBulk) ClearDescription() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIsPublished sets the "is_published" field.
+func (u *QuestionnaireUpsertBulk) SetIsPublished(v bool) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetIsPublished(v)
+	})
+}
+
+// UpdateIsPublished sets the "is_published" field to the value that was provided on create.
+func (u *QuestionnaireUpsertBulk) UpdateIsPublished() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateIsPublished()
+	})
+}
+
+// SetLockAfterSubmit sets the "lock_after_submit" field.
+func (u *QuestionnaireUpsertBulk) SetLockAfterSubmit(v bool) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetLockAfterSubmit(v)
+	})
+}
+
+// UpdateLockAfterSubmit sets the "lock_after_submit" field to the value that was provided on create.
+func (u *QuestionnaireUpsertBulk) UpdateLockAfterSubmit() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateLockAfterSubmit()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *QuestionnaireUpsertBulk) SetClosedAt(v int64) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// AddClosedAt adds v to the "closed_at" field.
+func (u *QuestionnaireUpsertBulk) AddClosedAt(v int64) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.AddClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *QuestionnaireUpsertBulk) UpdateClosedAt() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *QuestionnaireUpsertBulk) ClearClosedAt() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionnaireUpsertBulk) SetVersion(v int) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionnaireUpsertBulk) AddVersion(v int) *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionnaireUpsertBulk) UpdateVersion() *QuestionnaireUpsertBulk {
+	return u.Update(func(s *QuestionnaireUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionnaireCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -11,9 +11,12 @@ import (
 	"radgifa/ent/predicate"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/section"
 	"radgifa/ent/user"
+	"radgifa/ent/webhook"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,14 +26,22 @@ import (
 // QuestionnaireQuery is the builder for querying Questionnaire entities.
 type QuestionnaireQuery struct {
 	config
-	ctx           *QueryContext
-	order         []questionnaire.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Questionnaire
-	withOwner     *UserQuery
-	withMembers   *MemberQuery
-	withQuestions *QuestionQuery
-	withFKs       bool
+	ctx                *QueryContext
+	order              []questionnaire.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Questionnaire
+	withOwner          *UserQuery
+	withMembers        *MemberQuery
+	withQuestions      *QuestionQuery
+	withSections       *SectionQuery
+	withWebhooks       *WebhookQuery
+	withFKs            bool
+	loadTotal          []func(context.Context, []*Questionnaire) error
+	modifiers          []func(*sql.Selector)
+	withNamedMembers   map[string]*MemberQuery
+	withNamedQuestions map[string]*QuestionQuery
+	withNamedSections  map[string]*SectionQuery
+	withNamedWebhooks  map[string]*WebhookQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -133,6 +144,50 @@ func (_q *QuestionnaireQuery) QueryQuestions() *QuestionQuery {
 	return query
 }
 
+// QuerySections chains the current query on the "sections" edge.
+func (_q *QuestionnaireQuery) QuerySections() *SectionQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaire.Table, questionnaire.FieldID, selector),
+			sqlgraph.To(section.Table, section.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionnaire.SectionsTable, questionnaire.SectionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (_q *QuestionnaireQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaire.Table, questionnaire.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionnaire.WebhooksTable, questionnaire.WebhooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Questionnaire entity from the query.
 // Returns a *NotFoundError when no Questionnaire was found.
 func (_q *QuestionnaireQuery) First(ctx context.Context) (*Questionnaire, error) {
@@ -328,6 +383,8 @@ func (_q *QuestionnaireQuery) Clone() *QuestionnaireQuery {
 		withOwner:     _q.withOwner.Clone(),
 		withMembers:   _q.withMembers.Clone(),
 		withQuestions: _q.withQuestions.Clone(),
+		withSections:  _q.withSections.Clone(),
+		withWebhooks:  _q.withWebhooks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -367,6 +424,28 @@ func (_q *QuestionnaireQuery) WithQuestions(opts ...func(*QuestionQuery)) *Quest
 	return _q
 }
 
+// WithSections tells the query-builder to eager-load the nodes that are connected to
+// the "sections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireQuery) WithSections(opts ...func(*SectionQuery)) *QuestionnaireQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSections = query
+	return _q
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireQuery) WithWebhooks(opts ...func(*WebhookQuery)) *QuestionnaireQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhooks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,10 +525,12 @@ func (_q *QuestionnaireQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*Questionnaire{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withOwner != nil,
 			_q.withMembers != nil,
 			_q.withQuestions != nil,
+			_q.withSections != nil,
+			_q.withWebhooks != nil,
 		}
 	)
 	if _q.withOwner != nil {
@@ -467,6 +548,9 @@ func (_q *QuestionnaireQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -496,6 +580,53 @@ func (_q *QuestionnaireQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withSections; query != nil {
+		if err := _q.loadSections(ctx, query, nodes,
+			func(n *Questionnaire) { n.Edges.Sections = []*Section{} },
+			func(n *Questionnaire, e *Section) { n.Edges.Sections = append(n.Edges.Sections, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhooks; query != nil {
+		if err := _q.loadWebhooks(ctx, query, nodes,
+			func(n *Questionnaire) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *Questionnaire, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedMembers {
+		if err := _q.loadMembers(ctx, query, nodes,
+			func(n *Questionnaire) { n.appendNamedMembers(name) },
+			func(n *Questionnaire, e *Member) { n.appendNamedMembers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedQuestions {
+		if err := _q.loadQuestions(ctx, query, nodes,
+			func(n *Questionnaire) { n.appendNamedQuestions(name) },
+			func(n *Questionnaire, e *Question) { n.appendNamedQuestions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedSections {
+		if err := _q.loadSections(ctx, query, nodes,
+			func(n *Questionnaire) { n.appendNamedSections(name) },
+			func(n *Questionnaire, e *Section) { n.appendNamedSections(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedWebhooks {
+		if err := _q.loadWebhooks(ctx, query, nodes,
+			func(n *Questionnaire) { n.appendNamedWebhooks(name) },
+			func(n *Questionnaire, e *Webhook) { n.appendNamedWebhooks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -593,9 +724,74 @@ func (_q *QuestionnaireQuery) loadQuestions(ctx context.Context, query *Question
 	}
 	return nil
 }
+func (_q *QuestionnaireQuery) loadSections(ctx context.Context, query *SectionQuery, nodes []*Questionnaire, init func(*Questionnaire), assign func(*Questionnaire, *Section)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Questionnaire)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Section(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questionnaire.SectionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.questionnaire_sections
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "questionnaire_sections" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "questionnaire_sections" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *QuestionnaireQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*Questionnaire, init func(*Questionnaire), assign func(*Questionnaire, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Questionnaire)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questionnaire.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.questionnaire_webhooks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "questionnaire_webhooks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "questionnaire_webhooks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *QuestionnaireQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -658,6 +854,9 @@ func (_q *QuestionnaireQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -675,6 +874,88 @@ func (_q *QuestionnaireQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *QuestionnaireQuery) ForUpdate(opts ...sql.LockOption) *QuestionnaireQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *QuestionnaireQuery) ForShare(opts ...sql.LockOption) *QuestionnaireQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// WithNamedMembers tells the query-builder to eager-load the nodes that are connected to the "members"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireQuery) WithNamedMembers(name string, opts ...func(*MemberQuery)) *QuestionnaireQuery {
+	query := (&MemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedMembers == nil {
+		_q.withNamedMembers = make(map[string]*MemberQuery)
+	}
+	_q.withNamedMembers[name] = query
+	return _q
+}
+
+// WithNamedQuestions tells the query-builder to eager-load the nodes that are connected to the "questions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireQuery) WithNamedQuestions(name string, opts ...func(*QuestionQuery)) *QuestionnaireQuery {
+	query := (&QuestionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedQuestions == nil {
+		_q.withNamedQuestions = make(map[string]*QuestionQuery)
+	}
+	_q.withNamedQuestions[name] = query
+	return _q
+}
+
+// WithNamedSections tells the query-builder to eager-load the nodes that are connected to the "sections"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireQuery) WithNamedSections(name string, opts ...func(*SectionQuery)) *QuestionnaireQuery {
+	query := (&SectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedSections == nil {
+		_q.withNamedSections = make(map[string]*SectionQuery)
+	}
+	_q.withNamedSections[name] = query
+	return _q
+}
+
+// WithNamedWebhooks tells the query-builder to eager-load the nodes that are connected to the "webhooks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireQuery) WithNamedWebhooks(name string, opts ...func(*WebhookQuery)) *QuestionnaireQuery {
+	query := (&WebhookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedWebhooks == nil {
+		_q.withNamedWebhooks = make(map[string]*WebhookQuery)
+	}
+	_q.withNamedWebhooks[name] = query
+	return _q
+}
+
 // QuestionnaireGroupBy is the group-by builder for Questionnaire entities.
 type QuestionnaireGroupBy struct {
 	selector
@@ -10,7 +10,9 @@ import (
 	"radgifa/ent/predicate"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/section"
 	"radgifa/ent/user"
+	"radgifa/ent/webhook"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -79,6 +81,68 @@ func (_u *QuestionnaireUpdate) SetNillableIsPublished(v *bool) *QuestionnaireUpd
 	return _u
 }
 
+// SetLockAfterSubmit sets the "lock_after_submit" field.
+func (_u *QuestionnaireUpdate) SetLockAfterSubmit(v bool) *QuestionnaireUpdate {
+	_u.mutation.SetLockAfterSubmit(v)
+	return _u
+}
+
+// SetNillableLockAfterSubmit sets the "lock_after_submit" field if the given value is not nil.
+func (_u *QuestionnaireUpdate) SetNillableLockAfterSubmit(v *bool) *QuestionnaireUpdate {
+	if v != nil {
+		_u.SetLockAfterSubmit(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *QuestionnaireUpdate) SetClosedAt(v int64) *QuestionnaireUpdate {
+	_u.mutation.ResetClosedAt()
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *QuestionnaireUpdate) SetNillableClosedAt(v *int64) *QuestionnaireUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// AddClosedAt adds value to the "closed_at" field.
+func (_u *QuestionnaireUpdate) AddClosedAt(v int64) *QuestionnaireUpdate {
+	_u.mutation.AddClosedAt(v)
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *QuestionnaireUpdate) ClearClosedAt() *QuestionnaireUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *QuestionnaireUpdate) SetVersion(v int) *QuestionnaireUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *QuestionnaireUpdate) SetNillableVersion(v *int) *QuestionnaireUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *QuestionnaireUpdate) AddVersion(v int) *QuestionnaireUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *QuestionnaireUpdate) SetOwnerID(id uuid.UUID) *QuestionnaireUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -120,6 +184,36 @@ func (_u *QuestionnaireUpdate) AddQuestions(v ...*Question) *QuestionnaireUpdate
 	return _u.AddQuestionIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the Section entity by IDs.
+func (_u *QuestionnaireUpdate) AddSectionIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the Section entity.
+func (_u *QuestionnaireUpdate) AddSections(v ...*Section) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *QuestionnaireUpdate) AddWebhookIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *QuestionnaireUpdate) AddWebhooks(v ...*Webhook) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
 // Mutation returns the QuestionnaireMutation object of the builder.
 func (_u *QuestionnaireUpdate) Mutation() *QuestionnaireMutation {
 	return _u.mutation
@@ -173,6 +267,48 @@ func (_u *QuestionnaireUpdate) RemoveQuestions(v ...*Question) *QuestionnaireUpd
 	return _u.RemoveQuestionIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the Section entity.
+func (_u *QuestionnaireUpdate) ClearSections() *QuestionnaireUpdate {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to Section entities by IDs.
+func (_u *QuestionnaireUpdate) RemoveSectionIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to Section entities.
+func (_u *QuestionnaireUpdate) RemoveSections(v ...*Section) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *QuestionnaireUpdate) ClearWebhooks() *QuestionnaireUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *QuestionnaireUpdate) RemoveWebhookIDs(ids ...uuid.UUID) *QuestionnaireUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *QuestionnaireUpdate) RemoveWebhooks(v ...*Webhook) *QuestionnaireUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *QuestionnaireUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -202,6 +338,11 @@ func (_u *QuestionnaireUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *QuestionnaireUpdate) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := questionnaire.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.version": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Questionnaire.owner"`)
 	}
@@ -232,6 +373,24 @@ func (_u *QuestionnaireUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.IsPublished(); ok {
 		_spec.SetField(questionnaire.FieldIsPublished, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.LockAfterSubmit(); ok {
+		_spec.SetField(questionnaire.FieldLockAfterSubmit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(questionnaire.FieldClosedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClosedAt(); ok {
+		_spec.AddField(questionnaire.FieldClosedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(questionnaire.FieldClosedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(questionnaire.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(questionnaire.FieldVersion, field.TypeInt, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -351,6 +510,96 @@ func (_u *QuestionnaireUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.SectionsTable,
+			Columns: []string{questionnaire.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.SectionsTable,
+			Columns: []string{questionnaire.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.SectionsTable,
+			Columns: []string{questionnaire.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.WebhooksTable,
+			Columns: []string{questionnaire.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.WebhooksTable,
+			Columns: []string{questionnaire.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.WebhooksTable,
+			Columns: []string{questionnaire.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{questionnaire.Label}
@@ -419,6 +668,68 @@ func (_u *QuestionnaireUpdateOne) SetNillableIsPublished(v *bool) *Questionnaire
 	return _u
 }
 
+// SetLockAfterSubmit sets the "lock_after_submit" field.
+func (_u *QuestionnaireUpdateOne) SetLockAfterSubmit(v bool) *QuestionnaireUpdateOne {
+	_u.mutation.SetLockAfterSubmit(v)
+	return _u
+}
+
+// SetNillableLockAfterSubmit sets the "lock_after_submit" field if the given value is not nil.
+func (_u *QuestionnaireUpdateOne) SetNillableLockAfterSubmit(v *bool) *QuestionnaireUpdateOne {
+	if v != nil {
+		_u.SetLockAfterSubmit(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *QuestionnaireUpdateOne) SetClosedAt(v int64) *QuestionnaireUpdateOne {
+	_u.mutation.ResetClosedAt()
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *QuestionnaireUpdateOne) SetNillableClosedAt(v *int64) *QuestionnaireUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// AddClosedAt adds value to the "closed_at" field.
+func (_u *QuestionnaireUpdateOne) AddClosedAt(v int64) *QuestionnaireUpdateOne {
+	_u.mutation.AddClosedAt(v)
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *QuestionnaireUpdateOne) ClearClosedAt() *QuestionnaireUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *QuestionnaireUpdateOne) SetVersion(v int) *QuestionnaireUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *QuestionnaireUpdateOne) SetNillableVersion(v *int) *QuestionnaireUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *QuestionnaireUpdateOne) AddVersion(v int) *QuestionnaireUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *QuestionnaireUpdateOne) SetOwnerID(id uuid.UUID) *QuestionnaireUpdateOne {
 	_u.mutation.SetOwnerID(id)
@@ -460,6 +771,36 @@ func (_u *QuestionnaireUpdateOne) AddQuestions(v ...*Question) *QuestionnaireUpd
 	return _u.AddQuestionIDs(ids...)
 }
 
+// AddSectionIDs adds the "sections" edge to the Section entity by IDs.
+func (_u *QuestionnaireUpdateOne) AddSectionIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.AddSectionIDs(ids...)
+	return _u
+}
+
+// AddSections adds the "sections" edges to the Section entity.
+func (_u *QuestionnaireUpdateOne) AddSections(v ...*Section) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSectionIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *QuestionnaireUpdateOne) AddWebhookIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *QuestionnaireUpdateOne) AddWebhooks(v ...*Webhook) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
 // Mutation returns the QuestionnaireMutation object of the builder.
 func (_u *QuestionnaireUpdateOne) Mutation() *QuestionnaireMutation {
 	return _u.mutation
@@ -513,6 +854,48 @@ func (_u *QuestionnaireUpdateOne) RemoveQuestions(v ...*Question) *Questionnaire
 	return _u.RemoveQuestionIDs(ids...)
 }
 
+// ClearSections clears all "sections" edges to the Section entity.
+func (_u *QuestionnaireUpdateOne) ClearSections() *QuestionnaireUpdateOne {
+	_u.mutation.ClearSections()
+	return _u
+}
+
+// RemoveSectionIDs removes the "sections" edge to Section entities by IDs.
+func (_u *QuestionnaireUpdateOne) RemoveSectionIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.RemoveSectionIDs(ids...)
+	return _u
+}
+
+// RemoveSections removes "sections" edges to Section entities.
+func (_u *QuestionnaireUpdateOne) RemoveSections(v ...*Section) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSectionIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *QuestionnaireUpdateOne) ClearWebhooks() *QuestionnaireUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *QuestionnaireUpdateOne) RemoveWebhookIDs(ids ...uuid.UUID) *QuestionnaireUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *QuestionnaireUpdateOne) RemoveWebhooks(v ...*Webhook) *QuestionnaireUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
 // Where appends a list predicates to the QuestionnaireUpdate builder.
 func (_u *QuestionnaireUpdateOne) Where(ps ...predicate.Questionnaire) *QuestionnaireUpdateOne {
 	_u.mutation.Where(ps...)
@@ -555,6 +938,11 @@ func (_u *QuestionnaireUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *QuestionnaireUpdateOne) check() error {
+	if v, ok := _u.mutation.Version(); ok {
+		if err := questionnaire.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Questionnaire.version": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Questionnaire.owner"`)
 	}
@@ -602,6 +990,24 @@ func (_u *QuestionnaireUpdateOne) sqlSave(ctx context.Context) (_node *Questionn
 	if value, ok := _u.mutation.IsPublished(); ok {
 		_spec.SetField(questionnaire.FieldIsPublished, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.LockAfterSubmit(); ok {
+		_spec.SetField(questionnaire.FieldLockAfterSubmit, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(questionnaire.FieldClosedAt, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClosedAt(); ok {
+		_spec.AddField(questionnaire.FieldClosedAt, field.TypeInt64, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(questionnaire.FieldClosedAt, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(questionnaire.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(questionnaire.FieldVersion, field.TypeInt, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -721,6 +1127,96 @@ func (_u *QuestionnaireUpdateOne) sqlSave(ctx context.Context) (_node *Questionn
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.SectionsTable,
+			Columns: []string{questionnaire.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSectionsIDs(); len(nodes) > 0 && !_u.mutation.SectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.SectionsTable,
+			Columns: []string{questionnaire.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.SectionsTable,
+			Columns: []string{questionnaire.SectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(section.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.WebhooksTable,
+			Columns: []string{questionnaire.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.WebhooksTable,
+			Columns: []string{questionnaire.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnaire.WebhooksTable,
+			Columns: []string{questionnaire.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Questionnaire{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
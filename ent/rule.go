@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"radgifa/ent/question"
+	"radgifa/ent/rule"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Rule is the model entity for the Rule schema.
+type Rule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Answers to the source question that show the question
+	AnswerValues []string `json:"answer_values,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RuleQuery when eager-loading is set.
+	Edges               RuleEdges `json:"edges"`
+	question_rules      *uuid.UUID
+	question_dependents *uuid.UUID
+	selectValues        sql.SelectValues
+}
+
+// RuleEdges holds the relations/edges for other nodes in the graph.
+type RuleEdges struct {
+	// Question holds the value of the question edge.
+	Question *Question `json:"question,omitempty"`
+	// Source holds the value of the source edge.
+	Source *Question `json:"source,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// QuestionOrErr returns the Question value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
+		return e.Question, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: question.Label}
+	}
+	return nil, &NotLoadedError{edge: "question"}
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleEdges) SourceOrErr() (*Question, error) {
+	if e.Source != nil {
+		return e.Source, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: question.Label}
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldAnswerValues:
+			values[i] = new([]byte)
+		case rule.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case rule.FieldID:
+			values[i] = new(uuid.UUID)
+		case rule.ForeignKeys[0]: // question_rules
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case rule.ForeignKeys[1]: // question_dependents
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rule fields.
+func (_m *Rule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case rule.FieldAnswerValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AnswerValues); err != nil {
+					return fmt.Errorf("unmarshal field answer_values: %w", err)
+				}
+			}
+		case rule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case rule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field question_rules", values[i])
+			} else if value.Valid {
+				_m.question_rules = new(uuid.UUID)
+				*_m.question_rules = *value.S.(*uuid.UUID)
+			}
+		case rule.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field question_dependents", values[i])
+			} else if value.Valid {
+				_m.question_dependents = new(uuid.UUID)
+				*_m.question_dependents = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Rule.
+// This includes values selected through modifiers, order, etc.
+func (_m *Rule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryQuestion queries the "question" edge of the Rule entity.
+func (_m *Rule) QueryQuestion() *QuestionQuery {
+	return NewRuleClient(_m.config).QueryQuestion(_m)
+}
+
+// QuerySource queries the "source" edge of the Rule entity.
+func (_m *Rule) QuerySource() *QuestionQuery {
+	return NewRuleClient(_m.config).QuerySource(_m)
+}
+
+// Update returns a builder for updating this Rule.
+// Note that you need to call Rule.Unwrap() before calling this method if this Rule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Rule) Update() *RuleUpdateOne {
+	return NewRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Rule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Rule) Unwrap() *Rule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Rule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("answer_values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnswerValues))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Rules is a parsable slice of Rule.
+type Rules []*Rule
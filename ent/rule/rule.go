@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the rule type in the database.
+	Label = "rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAnswerValues holds the string denoting the answer_values field in the database.
+	FieldAnswerValues = "answer_values"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeQuestion holds the string denoting the question edge name in mutations.
+	EdgeQuestion = "question"
+	// EdgeSource holds the string denoting the source edge name in mutations.
+	EdgeSource = "source"
+	// Table holds the table name of the rule in the database.
+	Table = "rules"
+	// QuestionTable is the table that holds the question relation/edge.
+	QuestionTable = "rules"
+	// QuestionInverseTable is the table name for the Question entity.
+	// It exists in this package in order to avoid circular dependency with the "question" package.
+	QuestionInverseTable = "questions"
+	// QuestionColumn is the table column denoting the question relation/edge.
+	QuestionColumn = "question_rules"
+	// SourceTable is the table that holds the source relation/edge.
+	SourceTable = "rules"
+	// SourceInverseTable is the table name for the Question entity.
+	// It exists in this package in order to avoid circular dependency with the "question" package.
+	SourceInverseTable = "questions"
+	// SourceColumn is the table column denoting the source relation/edge.
+	SourceColumn = "question_dependents"
+)
+
+// Columns holds all SQL columns for rule fields.
+var Columns = []string{
+	FieldID,
+	FieldAnswerValues,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "rules"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"question_rules",
+	"question_dependents",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Rule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByQuestionField orders the results by question field.
+func ByQuestionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySourceField orders the results by source field.
+func BySourceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newQuestionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
+	)
+}
+func newSourceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SourceTable, SourceColumn),
+	)
+}
@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"radgifa/ent/question"
+	"radgifa/ent/rule"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RuleCreate is the builder for creating a Rule entity.
+type RuleCreate struct {
+	config
+	mutation *RuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAnswerValues sets the "answer_values" field.
+func (_c *RuleCreate) SetAnswerValues(v []string) *RuleCreate {
+	_c.mutation.SetAnswerValues(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RuleCreate) SetCreatedAt(v int64) *RuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableCreatedAt(v *int64) *RuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RuleCreate) SetID(v uuid.UUID) *RuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableID(v *uuid.UUID) *RuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetQuestionID sets the "question" edge to the Question entity by ID.
+func (_c *RuleCreate) SetQuestionID(id uuid.UUID) *RuleCreate {
+	_c.mutation.SetQuestionID(id)
+	return _c
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (_c *RuleCreate) SetQuestion(v *Question) *RuleCreate {
+	return _c.SetQuestionID(v.ID)
+}
+
+// SetSourceID sets the "source" edge to the Question entity by ID.
+func (_c *RuleCreate) SetSourceID(id uuid.UUID) *RuleCreate {
+	_c.mutation.SetSourceID(id)
+	return _c
+}
+
+// SetSource sets the "source" edge to the Question entity.
+func (_c *RuleCreate) SetSource(v *Question) *RuleCreate {
+	return _c.SetSourceID(v.ID)
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_c *RuleCreate) Mutation() *RuleMutation {
+	return _c.mutation
+}
+
+// Save creates the Rule in the database.
+func (_c *RuleCreate) Save(ctx context.Context) (*Rule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RuleCreate) SaveX(ctx context.Context) *Rule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := rule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := rule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RuleCreate) check() error {
+	if _, ok := _c.mutation.AnswerValues(); !ok {
+		return &ValidationError{Name: "answer_values", err: errors.New(`ent: missing required field "Rule.answer_values"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Rule.created_at"`)}
+	}
+	if len(_c.mutation.QuestionIDs()) == 0 {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required edge "Rule.question"`)}
+	}
+	if len(_c.mutation.SourceIDs()) == 0 {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required edge "Rule.source"`)}
+	}
+	return nil
+}
+
+func (_c *RuleCreate) sqlSave(ctx context.Context) (*Rule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RuleCreate) createSpec() (*Rule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Rule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rule.Table, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.AnswerValues(); ok {
+		_spec.SetField(rule.FieldAnswerValues, field.TypeJSON, value)
+		_node.AnswerValues = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rule.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.QuestionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.QuestionTable,
+			Columns: []string{rule.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.question_rules = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.SourceTable,
+			Columns: []string{rule.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.question_dependents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Rule.Create().
+//		SetAnswerValues(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RuleUpsert) {
+//			SetAnswerValues(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RuleCreate) OnConflict(opts ...sql.ConflictOption) *RuleUpsertOne {
+	_c.conflict = opts
+	return &RuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RuleCreate) OnConflictColumns(columns ...string) *RuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RuleUpsertOne is the builder for "upsert"-ing
+	//  one Rule node.
+	RuleUpsertOne struct {
+		create *RuleCreate
+	}
+
+	// RuleUpsert is the "OnConflict" setter.
+	RuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAnswerValues sets the "answer_values" field.
+func (u *RuleUpsert) SetAnswerValues(v []string) *RuleUpsert {
+	u.Set(rule.FieldAnswerValues, v)
+	return u
+}
+
+// UpdateAnswerValues sets the "answer_values" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateAnswerValues() *RuleUpsert {
+	u.SetExcluded(rule.FieldAnswerValues)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RuleUpsertOne) UpdateNewValues() *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(rule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(rule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RuleUpsertOne) Ignore() *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RuleUpsertOne) DoNothing() *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RuleCreate.OnConflict
+// documentation for more info.
+func (u *RuleUpsertOne) Update(set func(*RuleUpsert)) *RuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAnswerValues sets the "answer_values" field.
+func (u *RuleUpsertOne) SetAnswerValues(v []string) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetAnswerValues(v)
+	})
+}
+
+// UpdateAnswerValues sets the "answer_values" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateAnswerValues() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateAnswerValues()
+	})
+}
+
+// Exec executes the query.
+func (u *RuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RuleUpsertOne.ID is not supported by MySQL driver. Use RuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RuleCreateBulk is the builder for creating many Rule entities in bulk.
+type RuleCreateBulk struct {
+	config
+	err      error
+	builders []*RuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Rule entities in the database.
+func (_c *RuleCreateBulk) Save(ctx context.Context) ([]*Rule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Rule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RuleCreateBulk) SaveX(ctx context.Context) []*Rule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Rule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RuleUpsert) {
+//			SetAnswerValues(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RuleUpsertBulk {
+	_c.conflict = opts
+	return &RuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RuleCreateBulk) OnConflictColumns(columns ...string) *RuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Rule nodes.
+type RuleUpsertBulk struct {
+	create *RuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RuleUpsertBulk) UpdateNewValues() *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(rule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(rule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Rule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RuleUpsertBulk) Ignore() *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RuleUpsertBulk) DoNothing() *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RuleUpsertBulk) Update(set func(*RuleUpsert)) *RuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAnswerValues sets the "answer_values" field.
+func (u *RuleUpsertBulk) SetAnswerValues(v []string) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetAnswerValues(v)
+	})
+}
+
+// UpdateAnswerValues sets the "answer_values" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateAnswerValues() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateAnswerValues()
+	})
+}
+
+// Exec executes the query.
+func (u *RuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
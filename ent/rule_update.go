@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"radgifa/ent/predicate"
+	"radgifa/ent/question"
+	"radgifa/ent/rule"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RuleUpdate is the builder for updating Rule entities.
+type RuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// Where appends a list predicates to the RuleUpdate builder.
+func (_u *RuleUpdate) Where(ps ...predicate.Rule) *RuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAnswerValues sets the "answer_values" field.
+func (_u *RuleUpdate) SetAnswerValues(v []string) *RuleUpdate {
+	_u.mutation.SetAnswerValues(v)
+	return _u
+}
+
+// AppendAnswerValues appends value to the "answer_values" field.
+func (_u *RuleUpdate) AppendAnswerValues(v []string) *RuleUpdate {
+	_u.mutation.AppendAnswerValues(v)
+	return _u
+}
+
+// SetQuestionID sets the "question" edge to the Question entity by ID.
+func (_u *RuleUpdate) SetQuestionID(id uuid.UUID) *RuleUpdate {
+	_u.mutation.SetQuestionID(id)
+	return _u
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (_u *RuleUpdate) SetQuestion(v *Question) *RuleUpdate {
+	return _u.SetQuestionID(v.ID)
+}
+
+// SetSourceID sets the "source" edge to the Question entity by ID.
+func (_u *RuleUpdate) SetSourceID(id uuid.UUID) *RuleUpdate {
+	_u.mutation.SetSourceID(id)
+	return _u
+}
+
+// SetSource sets the "source" edge to the Question entity.
+func (_u *RuleUpdate) SetSource(v *Question) *RuleUpdate {
+	return _u.SetSourceID(v.ID)
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_u *RuleUpdate) Mutation() *RuleMutation {
+	return _u.mutation
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (_u *RuleUpdate) ClearQuestion() *RuleUpdate {
+	_u.mutation.ClearQuestion()
+	return _u
+}
+
+// ClearSource clears the "source" edge to the Question entity.
+func (_u *RuleUpdate) ClearSource() *RuleUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleUpdate) check() error {
+	if _u.mutation.QuestionCleared() && len(_u.mutation.QuestionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rule.question"`)
+	}
+	if _u.mutation.SourceCleared() && len(_u.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rule.source"`)
+	}
+	return nil
+}
+
+func (_u *RuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rule.Table, rule.Columns, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AnswerValues(); ok {
+		_spec.SetField(rule.FieldAnswerValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAnswerValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rule.FieldAnswerValues, value)
+		})
+	}
+	if _u.mutation.QuestionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.QuestionTable,
+			Columns: []string{rule.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.QuestionTable,
+			Columns: []string{rule.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.SourceTable,
+			Columns: []string{rule.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.SourceTable,
+			Columns: []string{rule.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RuleUpdateOne is the builder for updating a single Rule entity.
+type RuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// SetAnswerValues sets the "answer_values" field.
+func (_u *RuleUpdateOne) SetAnswerValues(v []string) *RuleUpdateOne {
+	_u.mutation.SetAnswerValues(v)
+	return _u
+}
+
+// AppendAnswerValues appends value to the "answer_values" field.
+func (_u *RuleUpdateOne) AppendAnswerValues(v []string) *RuleUpdateOne {
+	_u.mutation.AppendAnswerValues(v)
+	return _u
+}
+
+// SetQuestionID sets the "question" edge to the Question entity by ID.
+func (_u *RuleUpdateOne) SetQuestionID(id uuid.UUID) *RuleUpdateOne {
+	_u.mutation.SetQuestionID(id)
+	return _u
+}
+
+// SetQuestion sets the "question" edge to the Question entity.
+func (_u *RuleUpdateOne) SetQuestion(v *Question) *RuleUpdateOne {
+	return _u.SetQuestionID(v.ID)
+}
+
+// SetSourceID sets the "source" edge to the Question entity by ID.
+func (_u *RuleUpdateOne) SetSourceID(id uuid.UUID) *RuleUpdateOne {
+	_u.mutation.SetSourceID(id)
+	return _u
+}
+
+// SetSource sets the "source" edge to the Question entity.
+func (_u *RuleUpdateOne) SetSource(v *Question) *RuleUpdateOne {
+	return _u.SetSourceID(v.ID)
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_u *RuleUpdateOne) Mutation() *RuleMutation {
+	return _u.mutation
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (_u *RuleUpdateOne) ClearQuestion() *RuleUpdateOne {
+	_u.mutation.ClearQuestion()
+	return _u
+}
+
+// ClearSource clears the "source" edge to the Question entity.
+func (_u *RuleUpdateOne) ClearSource() *RuleUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// Where appends a list predicates to the RuleUpdate builder.
+func (_u *RuleUpdateOne) Where(ps ...predicate.Rule) *RuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RuleUpdateOne) Select(field string, fields ...string) *RuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Rule entity.
+func (_u *RuleUpdateOne) Save(ctx context.Context) (*Rule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleUpdateOne) SaveX(ctx context.Context) *Rule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleUpdateOne) check() error {
+	if _u.mutation.QuestionCleared() && len(_u.mutation.QuestionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rule.question"`)
+	}
+	if _u.mutation.SourceCleared() && len(_u.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Rule.source"`)
+	}
+	return nil
+}
+
+func (_u *RuleUpdateOne) sqlSave(ctx context.Context) (_node *Rule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rule.Table, rule.Columns, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rule.FieldID)
+		for _, f := range fields {
+			if !rule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AnswerValues(); ok {
+		_spec.SetField(rule.FieldAnswerValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAnswerValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rule.FieldAnswerValues, value)
+		})
+	}
+	if _u.mutation.QuestionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.QuestionTable,
+			Columns: []string{rule.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.QuestionTable,
+			Columns: []string{rule.QuestionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.SourceTable,
+			Columns: []string{rule.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.SourceTable,
+			Columns: []string{rule.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Rule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
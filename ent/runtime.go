@@ -3,12 +3,17 @@
 package ent
 
 import (
+	"radgifa/ent/accesstoken"
 	"radgifa/ent/answer"
 	"radgifa/ent/member"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
 	"radgifa/ent/schema"
+	"radgifa/ent/section"
 	"radgifa/ent/user"
+	"radgifa/ent/webhook"
+	"radgifa/ent/webhookdelivery"
 
 	"github.com/google/uuid"
 )
@@ -17,6 +22,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenFields[5].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() int64)
+	// accesstokenDescID is the schema descriptor for id field.
+	accesstokenDescID := accesstokenFields[0].Descriptor()
+	// accesstoken.DefaultID holds the default value on creation for the id field.
+	accesstoken.DefaultID = accesstokenDescID.Default.(func() uuid.UUID)
 	answerFields := schema.Answer{}.Fields()
 	_ = answerFields
 	// answerDescCreatedAt is the schema descriptor for created_at field.
@@ -43,14 +58,26 @@ func init() {
 	member.DefaultID = memberDescID.Default.(func() uuid.UUID)
 	questionFields := schema.Question{}.Fields()
 	_ = questionFields
-	// questionDescTheme is the schema descriptor for theme field.
-	questionDescTheme := questionFields[1].Descriptor()
-	// question.ThemeValidator is a validator for the "theme" field. It is called by the builders before save.
-	question.ThemeValidator = questionDescTheme.Validators[0].(func(string) error)
 	// questionDescCreatedAt is the schema descriptor for created_at field.
-	questionDescCreatedAt := questionFields[2].Descriptor()
+	questionDescCreatedAt := questionFields[1].Descriptor()
 	// question.DefaultCreatedAt holds the default value on creation for the created_at field.
 	question.DefaultCreatedAt = questionDescCreatedAt.Default.(func() int64)
+	// questionDescRequired is the schema descriptor for required field.
+	questionDescRequired := questionFields[3].Descriptor()
+	// question.DefaultRequired holds the default value on creation for the required field.
+	question.DefaultRequired = questionDescRequired.Default.(bool)
+	// questionDescPosition is the schema descriptor for position field.
+	questionDescPosition := questionFields[4].Descriptor()
+	// question.DefaultPosition holds the default value on creation for the position field.
+	question.DefaultPosition = questionDescPosition.Default.(int)
+	// question.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	question.PositionValidator = questionDescPosition.Validators[0].(func(int) error)
+	// questionDescVersion is the schema descriptor for version field.
+	questionDescVersion := questionFields[5].Descriptor()
+	// question.DefaultVersion holds the default value on creation for the version field.
+	question.DefaultVersion = questionDescVersion.Default.(int)
+	// question.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	question.VersionValidator = questionDescVersion.Validators[0].(func(int) error)
 	// questionDescID is the schema descriptor for id field.
 	questionDescID := questionFields[0].Descriptor()
 	// question.DefaultID holds the default value on creation for the id field.
@@ -61,14 +88,54 @@ func init() {
 	questionnaireDescIsPublished := questionnaireFields[3].Descriptor()
 	// questionnaire.DefaultIsPublished holds the default value on creation for the is_published field.
 	questionnaire.DefaultIsPublished = questionnaireDescIsPublished.Default.(bool)
+	// questionnaireDescLockAfterSubmit is the schema descriptor for lock_after_submit field.
+	questionnaireDescLockAfterSubmit := questionnaireFields[4].Descriptor()
+	// questionnaire.DefaultLockAfterSubmit holds the default value on creation for the lock_after_submit field.
+	questionnaire.DefaultLockAfterSubmit = questionnaireDescLockAfterSubmit.Default.(bool)
 	// questionnaireDescCreatedAt is the schema descriptor for created_at field.
-	questionnaireDescCreatedAt := questionnaireFields[4].Descriptor()
+	questionnaireDescCreatedAt := questionnaireFields[5].Descriptor()
 	// questionnaire.DefaultCreatedAt holds the default value on creation for the created_at field.
 	questionnaire.DefaultCreatedAt = questionnaireDescCreatedAt.Default.(func() int64)
+	// questionnaireDescVersion is the schema descriptor for version field.
+	questionnaireDescVersion := questionnaireFields[7].Descriptor()
+	// questionnaire.DefaultVersion holds the default value on creation for the version field.
+	questionnaire.DefaultVersion = questionnaireDescVersion.Default.(int)
+	// questionnaire.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	questionnaire.VersionValidator = questionnaireDescVersion.Validators[0].(func(int) error)
 	// questionnaireDescID is the schema descriptor for id field.
 	questionnaireDescID := questionnaireFields[0].Descriptor()
 	// questionnaire.DefaultID holds the default value on creation for the id field.
 	questionnaire.DefaultID = questionnaireDescID.Default.(func() uuid.UUID)
+	ruleFields := schema.Rule{}.Fields()
+	_ = ruleFields
+	// ruleDescCreatedAt is the schema descriptor for created_at field.
+	ruleDescCreatedAt := ruleFields[2].Descriptor()
+	// rule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rule.DefaultCreatedAt = ruleDescCreatedAt.Default.(func() int64)
+	// ruleDescID is the schema descriptor for id field.
+	ruleDescID := ruleFields[0].Descriptor()
+	// rule.DefaultID holds the default value on creation for the id field.
+	rule.DefaultID = ruleDescID.Default.(func() uuid.UUID)
+	sectionFields := schema.Section{}.Fields()
+	_ = sectionFields
+	// sectionDescTitle is the schema descriptor for title field.
+	sectionDescTitle := sectionFields[1].Descriptor()
+	// section.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	section.TitleValidator = sectionDescTitle.Validators[0].(func(string) error)
+	// sectionDescPosition is the schema descriptor for position field.
+	sectionDescPosition := sectionFields[3].Descriptor()
+	// section.DefaultPosition holds the default value on creation for the position field.
+	section.DefaultPosition = sectionDescPosition.Default.(int)
+	// section.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	section.PositionValidator = sectionDescPosition.Validators[0].(func(int) error)
+	// sectionDescCreatedAt is the schema descriptor for created_at field.
+	sectionDescCreatedAt := sectionFields[4].Descriptor()
+	// section.DefaultCreatedAt holds the default value on creation for the created_at field.
+	section.DefaultCreatedAt = sectionDescCreatedAt.Default.(func() int64)
+	// sectionDescID is the schema descriptor for id field.
+	sectionDescID := sectionFields[0].Descriptor()
+	// section.DefaultID holds the default value on creation for the id field.
+	section.DefaultID = sectionDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
@@ -79,4 +146,34 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[4].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() int64)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() int64)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() int64)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
 }
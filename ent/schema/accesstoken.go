@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AccessToken holds the schema definition for the AccessToken entity, a
+// personal access token a user creates for their scripts. It acts as the
+// user within its scopes until it expires or is revoked.
+type AccessToken struct {
+	ent.Schema
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
+		field.String("name"),
+		field.Bytes("token_hash").Unique().Immutable().Sensitive().Comment("SHA-256 of the token, which is only shown once"),
+		field.String("prefix").Immutable().Comment("Start of the token, to tell tokens apart"),
+		field.Strings("scopes"),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
+		field.Int64("expires_at").Optional().Nillable().Immutable(),
+		field.Int64("last_used_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the AccessToken.
+func (AccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("access_tokens").Unique().Required().Immutable(),
+	}
+}
+
+// Annotations of the AccessToken.
+func (AccessToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
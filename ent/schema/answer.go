@@ -3,9 +3,12 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -19,8 +22,10 @@ func (Answer) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
 		field.Enum("answer_value").Values("Yes", "No", "Pass"),
-		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
-		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Annotations(entgql.OrderField("UPDATED_AT")),
 	}
 }
 
@@ -31,3 +36,19 @@ func (Answer) Edges() []ent.Edge {
 		edge.From("member", Member.Type).Ref("answers").Unique().Required(),
 	}
 }
+
+// Indexes of the Answer.
+func (Answer) Indexes() []ent.Index {
+	return []ent.Index{
+		// A member has at most one answer per question, answering again
+		// updates it in place
+		index.Edges("member", "question").Unique(),
+	}
+}
+
+// Annotations of the Answer.
+func (Answer) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
@@ -0,0 +1,13 @@
+package schema
+
+import "entgo.io/contrib/entgql"
+
+// privateDirective marks the fields the GraphQL API only shows to whom they
+// concern: the name of a user to themselves and to the owners of the
+// questionnaires they joined, the identifier of a member to the owner of
+// their questionnaire. The REST API applies the same rules.
+//
+// Neither these fields nor the edges leading to members and answers can be
+// filtered on: a filter is evaluated in the database, past the rows the
+// caller may see, and would let them probe the others.
+var privateDirective = entgql.NewDirective("private")
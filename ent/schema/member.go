@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -19,10 +21,15 @@ type Member struct {
 func (Member) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
-		field.String("display_name"),
-		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
-		field.String("unique_identifier").Comment("Something that only the member knows so they can prove who they are"),
-		field.Bytes("pass_code").Comment("It is generated as a string the clear text is send to the member only once, then only the hash is stored. Is not requiered if the member is related to a user."),
+		field.String("display_name").
+			Annotations(entgql.OrderField("DISPLAY_NAME")),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.String("unique_identifier").Comment("Something that only the member knows so they can prove who they are").
+			Annotations(entgql.Directives(privateDirective), entgql.Skip(entgql.SkipWhereInput)),
+		field.Int64("completed_at").Optional().Nillable().Comment("When the member submitted the questionnaire, cleared if they change an answer afterwards"),
+		field.Bytes("pass_code").Comment("It is generated as a string the clear text is send to the member only once, then only the hash is stored. Is not requiered if the member is related to a user.").
+			Annotations(entgql.Skip()),
 	}
 }
 
@@ -31,7 +38,8 @@ func (Member) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).Ref("memberships").Unique(),
 		edge.From("questionnaire", Questionnaire.Type).Ref("members").Unique().Required(),
-		edge.To("answers", Answer.Type),
+		edge.To("answers", Answer.Type).
+			Annotations(entgql.RelayConnection()),
 	}
 }
 
@@ -42,3 +50,10 @@ func (Member) Indexes() []ent.Index {
 			Unique(),
 	}
 }
+
+// Annotations of the Member.
+func (Member) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
@@ -3,9 +3,12 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -18,9 +21,13 @@ type Question struct {
 func (Question) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
-		field.String("theme").MaxRuneLen(255),
-		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.String("text"),
+		field.Bool("required").Default(false),
+		field.Int("position").Default(0).NonNegative().Comment("Zero based order of the question within its questionnaire").
+			Annotations(entgql.OrderField("POSITION")),
+		field.Int("version").Default(1).Positive().Comment("Incremented on every edit, backs the ETag of the question"),
 	}
 }
 
@@ -28,6 +35,24 @@ func (Question) Fields() []ent.Field {
 func (Question) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("questionnaire", Questionnaire.Type).Ref("questions").Unique().Required(),
-		edge.To("answers", Answer.Type),
+		edge.From("section", Section.Type).Ref("questions").Unique(),
+		edge.To("answers", Answer.Type).
+			Annotations(entgql.RelayConnection(), entgql.Skip(entgql.SkipWhereInput)),
+		edge.To("rules", Rule.Type),
+		edge.To("dependents", Rule.Type),
+	}
+}
+
+func (Question) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").
+			Edges("questionnaire"),
+	}
+}
+
+// Annotations of the Question.
+func (Question) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
 	}
 }
@@ -3,7 +3,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -18,10 +20,15 @@ type Questionnaire struct {
 func (Questionnaire) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
-		field.String("title"),
+		field.String("title").
+			Annotations(entgql.OrderField("TITLE")),
 		field.String("description").Optional(),
 		field.Bool("is_published").Default(false),
-		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
+		field.Bool("lock_after_submit").Default(false).Comment("Members cannot change their answers once they submitted"),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.Int64("closed_at").Optional().Nillable().Comment("When the owner closed the questionnaire, which then takes no more members or answers"),
+		field.Int("version").Default(1).Positive().Comment("Incremented on every edit, backs the ETag of the questionnaire"),
 	}
 }
 
@@ -29,7 +36,20 @@ func (Questionnaire) Fields() []ent.Field {
 func (Questionnaire) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("owner", User.Type).Ref("questionnaires").Unique().Required(),
-		edge.To("members", Member.Type),
-		edge.To("questions", Question.Type),
+		edge.To("members", Member.Type).
+			Annotations(entgql.RelayConnection(), entgql.Skip(entgql.SkipWhereInput)),
+		edge.To("questions", Question.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("sections", Section.Type),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations of the Questionnaire.
+func (Questionnaire) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
 	}
 }
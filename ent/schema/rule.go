@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Rule holds the schema definition for the Rule entity. A question with
+// rules is only shown to a member whose answer to the source question of
+// every rule is one of its answer values.
+type Rule struct {
+	ent.Schema
+}
+
+// Fields of the Rule.
+func (Rule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
+		field.Strings("answer_values").Comment("Answers to the source question that show the question"),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
+	}
+}
+
+// Edges of the Rule.
+func (Rule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("question", Question.Type).Ref("rules").Unique().Required(),
+		edge.From("source", Question.Type).Ref("dependents").Unique().Required(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Section holds the schema definition for the Section entity.
+type Section struct {
+	ent.Schema
+}
+
+// Fields of the Section.
+func (Section) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
+		field.String("title").MaxRuneLen(200),
+		field.String("description").Optional(),
+		field.Int("position").Default(0).NonNegative().Comment("Zero based order of the section within its questionnaire"),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
+	}
+}
+
+// Edges of the Section.
+func (Section) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("questionnaire", Questionnaire.Type).Ref("sections").Unique().Required(),
+		edge.To("questions", Question.Type),
+	}
+}
+
+func (Section) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").
+			Edges("questionnaire"),
+	}
+}
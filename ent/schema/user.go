@@ -3,6 +3,7 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -18,18 +19,25 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
-		field.String("name"),
-		field.String("username").Unique().Immutable(),
+		field.String("name").
+			Annotations(entgql.Directives(privateDirective), entgql.Skip(entgql.SkipWhereInput)),
+		field.String("username").Unique().Immutable().
+			Annotations(entgql.Directives(privateDirective), entgql.Skip(entgql.SkipWhereInput)),
 		field.String("display_name").Optional(),
 		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
-		field.Bytes("password"),
+		field.Bytes("password").
+			Annotations(entgql.Skip()),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("questionnaires", Questionnaire.Type),
-		edge.To("memberships", Member.Type),
+		edge.To("questionnaires", Questionnaire.Type).
+			Annotations(entgql.RelayConnection(), entgql.Skip(entgql.SkipWhereInput)),
+		edge.To("memberships", Member.Type).
+			Annotations(entgql.RelayConnection(), entgql.Skip(entgql.SkipWhereInput)),
+		edge.To("access_tokens", AccessToken.Type).
+			Annotations(entgql.Skip()),
 	}
 }
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Webhook holds the schema definition for the Webhook entity, an URL the
+// owner of a questionnaire subscribes to some of its events. The payloads
+// sent to it are signed with its secret.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
+		field.String("url"),
+		field.String("secret").Immutable().Sensitive().Comment("HMAC-SHA256 key of the signatures, only shown once"),
+		field.Strings("events"),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("questionnaire", Questionnaire.Type).Ref("webhooks").Unique().Required().Immutable(),
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+// Annotations of the Webhook.
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery
+// entity, one event to send to a webhook. The pending deliveries are the
+// queue of the webhook dispatcher, and they are kept once done as the log
+// of the webhook.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).Default(uuid.New).Immutable(),
+		field.String("event").Immutable(),
+		field.Bytes("payload").Immutable().Comment("Body sent on every attempt, a redelivery sends it again"),
+		field.Enum("status").Values("pending", "succeeded", "failed").Default("pending"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Int64("next_attempt_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).
+			Comment("When a pending delivery is due. A dispatcher sending it pushes it back for the time of the attempt."),
+		field.Int64("last_attempt_at").Optional().Nillable(),
+		field.Int("response_status").Optional().Nillable().Comment("HTTP status of the last attempt, unset when no response came"),
+		field.String("error").Optional().Comment("Why the last attempt failed"),
+		field.Int64("created_at").DefaultFunc(func() int64 { return time.Now().UnixMilli() }).Immutable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).Ref("deliveries").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
+
+// Annotations of the WebhookDelivery.
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
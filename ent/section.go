@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"radgifa/ent/questionnaire"
+	"radgifa/ent/section"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Section is the model entity for the Section schema.
+type Section struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Zero based order of the section within its questionnaire
+	Position int `json:"position,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SectionQuery when eager-loading is set.
+	Edges                  SectionEdges `json:"edges"`
+	questionnaire_sections *uuid.UUID
+	selectValues           sql.SelectValues
+}
+
+// SectionEdges holds the relations/edges for other nodes in the graph.
+type SectionEdges struct {
+	// Questionnaire holds the value of the questionnaire edge.
+	Questionnaire *Questionnaire `json:"questionnaire,omitempty"`
+	// Questions holds the value of the questions edge.
+	Questions []*Question `json:"questions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedQuestions map[string][]*Question
+}
+
+// QuestionnaireOrErr returns the Questionnaire value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SectionEdges) QuestionnaireOrErr() (*Questionnaire, error) {
+	if e.Questionnaire != nil {
+		return e.Questionnaire, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: questionnaire.Label}
+	}
+	return nil, &NotLoadedError{edge: "questionnaire"}
+}
+
+// QuestionsOrErr returns the Questions value or an error if the edge
+// was not loaded in eager-loading.
+func (e SectionEdges) QuestionsOrErr() ([]*Question, error) {
+	if e.loadedTypes[1] {
+		return e.Questions, nil
+	}
+	return nil, &NotLoadedError{edge: "questions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Section) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case section.FieldPosition, section.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case section.FieldTitle, section.FieldDescription:
+			values[i] = new(sql.NullString)
+		case section.FieldID:
+			values[i] = new(uuid.UUID)
+		case section.ForeignKeys[0]: // questionnaire_sections
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Section fields.
+func (_m *Section) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case section.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case section.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case section.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case section.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case section.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Int64
+			}
+		case section.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field questionnaire_sections", values[i])
+			} else if value.Valid {
+				_m.questionnaire_sections = new(uuid.UUID)
+				*_m.questionnaire_sections = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Section.
+// This includes values selected through modifiers, order, etc.
+func (_m *Section) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryQuestionnaire queries the "questionnaire" edge of the Section entity.
+func (_m *Section) QueryQuestionnaire() *QuestionnaireQuery {
+	return NewSectionClient(_m.config).QueryQuestionnaire(_m)
+}
+
+// QueryQuestions queries the "questions" edge of the Section entity.
+func (_m *Section) QueryQuestions() *QuestionQuery {
+	return NewSectionClient(_m.config).QueryQuestions(_m)
+}
+
+// Update returns a builder for updating this Section.
+// Note that you need to call Section.Unwrap() before calling this method if this Section
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Section) Update() *SectionUpdateOne {
+	return NewSectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Section entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Section) Unwrap() *Section {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Section is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Section) String() string {
+	var builder strings.Builder
+	builder.WriteString("Section(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedQuestions returns the Questions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Section) NamedQuestions(name string) ([]*Question, error) {
+	if _m.Edges.namedQuestions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedQuestions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Section) appendNamedQuestions(name string, edges ...*Question) {
+	if _m.Edges.namedQuestions == nil {
+		_m.Edges.namedQuestions = make(map[string][]*Question)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedQuestions[name] = []*Question{}
+	} else {
+		_m.Edges.namedQuestions[name] = append(_m.Edges.namedQuestions[name], edges...)
+	}
+}
+
+// Sections is a parsable slice of Section.
+type Sections []*Section
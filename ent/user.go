@@ -39,9 +39,17 @@ type UserEdges struct {
 	Questionnaires []*Questionnaire `json:"questionnaires,omitempty"`
 	// Memberships holds the value of the memberships edge.
 	Memberships []*Member `json:"memberships,omitempty"`
+	// AccessTokens holds the value of the access_tokens edge.
+	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedQuestionnaires map[string][]*Questionnaire
+	namedMemberships    map[string][]*Member
+	namedAccessTokens   map[string][]*AccessToken
 }
 
 // QuestionnairesOrErr returns the Questionnaires value or an error if the edge
@@ -62,6 +70,15 @@ func (e UserEdges) MembershipsOrErr() ([]*Member, error) {
 	return nil, &NotLoadedError{edge: "memberships"}
 }
 
+// AccessTokensOrErr returns the AccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
+	if e.loadedTypes[2] {
+		return e.AccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "access_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -149,6 +166,11 @@ func (_m *User) QueryMemberships() *MemberQuery {
 	return NewUserClient(_m.config).QueryMemberships(_m)
 }
 
+// QueryAccessTokens queries the "access_tokens" edge of the User entity.
+func (_m *User) QueryAccessTokens() *AccessTokenQuery {
+	return NewUserClient(_m.config).QueryAccessTokens(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -190,5 +212,77 @@ func (_m *User) String() string {
 	return builder.String()
 }
 
+// NamedQuestionnaires returns the Questionnaires named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedQuestionnaires(name string) ([]*Questionnaire, error) {
+	if _m.Edges.namedQuestionnaires == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedQuestionnaires[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedQuestionnaires(name string, edges ...*Questionnaire) {
+	if _m.Edges.namedQuestionnaires == nil {
+		_m.Edges.namedQuestionnaires = make(map[string][]*Questionnaire)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedQuestionnaires[name] = []*Questionnaire{}
+	} else {
+		_m.Edges.namedQuestionnaires[name] = append(_m.Edges.namedQuestionnaires[name], edges...)
+	}
+}
+
+// NamedMemberships returns the Memberships named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedMemberships(name string) ([]*Member, error) {
+	if _m.Edges.namedMemberships == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedMemberships[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedMemberships(name string, edges ...*Member) {
+	if _m.Edges.namedMemberships == nil {
+		_m.Edges.namedMemberships = make(map[string][]*Member)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedMemberships[name] = []*Member{}
+	} else {
+		_m.Edges.namedMemberships[name] = append(_m.Edges.namedMemberships[name], edges...)
+	}
+}
+
+// NamedAccessTokens returns the AccessTokens named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedAccessTokens(name string) ([]*AccessToken, error) {
+	if _m.Edges.namedAccessTokens == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedAccessTokens[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedAccessTokens(name string, edges ...*AccessToken) {
+	if _m.Edges.namedAccessTokens == nil {
+		_m.Edges.namedAccessTokens = make(map[string][]*AccessToken)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedAccessTokens[name] = []*AccessToken{}
+	} else {
+		_m.Edges.namedAccessTokens[name] = append(_m.Edges.namedAccessTokens[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
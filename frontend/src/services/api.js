@@ -36,61 +36,118 @@ api.interceptors.response.use(
   }
 )
 
+// listAll follows next_cursor through every page of a paginated listing and
+// resolves to a response whose data is the concatenated items
+const listAll = async (url, config = {}) => {
+  const items = []
+  let cursor
+  let response
+  do {
+    response = await api.get(url, {
+      ...config,
+      params: { ...config.params, limit: 100, ...(cursor && { cursor }) },
+    })
+    items.push(...(response.data.items || []))
+    cursor = response.data.next_cursor
+  } while (cursor)
+  return { ...response, data: items }
+}
+
 export const authAPI = {
-  register: (userData) => api.post('/register', userData),
-  login: (credentials) => api.post('/login', credentials),
-  checkUsername: (username) => api.post('/check/username', { value: username }),
+  register: (userData) => api.post('/api/v1/register', userData),
+  login: (credentials) => api.post('/api/v1/login', credentials),
+  checkUsername: (username) => api.post('/api/v1/check/username', { value: username }),
 }
 
 
 export const questionnaireAPI = {
   
-  getMyQuestionnaires: (config = {}) => api.get('/api/questionnaires', config),
+  getMyQuestionnaires: (config = {}) => listAll('/api/v1/questionnaires', config),
+
+  getMyMemberships: (config = {}) => listAll('/api/v1/memberships', config),
+  
+  
+  create: (data, config = {}) => api.post('/api/v1/questionnaires', data, config),
+  
+  
+  update: (id, data, config = {}) => api.put(`/api/v1/questionnaires/${id}`, data, config),
+  
+  
+  delete: (id, config = {}) => api.delete(`/api/v1/questionnaires/${id}`, config),
+  
+  
+  publish: (id, config = {}) => api.post(`/api/v1/questionnaires/${id}/publish`, null, config),
+  
+  
+  getDetails: (id, config = {}) => api.get(`/api/v1/questionnaires/${id}`, config),
+  
   
+  getQuestions: (id, config = {}) => listAll(`/api/v1/questionnaires/${id}/questions`, config),
   
-  create: (data, config = {}) => api.post('/api/questionnaires', data, config),
   
+  getMyAnswers: (id, config = {}) => listAll(`/api/v1/questionnaires/${id}/my-answers`, config),
   
-  update: (id, data, config = {}) => api.put(`/api/questionnaires/${id}`, data, config),
   
+  getMyQuestions: (id, config = {}) => api.get(`/api/v1/questionnaires/${id}/my-questions`, config),
   
-  delete: (id, config = {}) => api.delete(`/api/questionnaires/${id}`, config),
   
+  submitQuestionnaire: (id, config = {}) => api.post(`/api/v1/questionnaires/${id}/submit`, null, config),
   
-  publish: (id, config = {}) => api.post(`/api/questionnaires/${id}/publish`, null, config),
   
+  getMembers: (id, config = {}) => listAll(`/api/v1/questionnaires/${id}/members`, config),
   
-  getDetails: (id, config = {}) => api.get(`/api/questionnaires/${id}`, config),
+  generateInvite: (id, config = {}) => api.post(`/api/v1/questionnaires/${id}/invite`, null, config),
   
   
-  getQuestions: (id, config = {}) => api.get(`/api/questionnaires/${id}/questions`, config),
+  createQuestion: (id, questionData, config = {}) => api.post(`/api/v1/questionnaires/${id}/question`, questionData, config),
   
   
-  getMyAnswers: (id, config = {}) => api.get(`/api/questionnaires/${id}/my-answers`, config),
+  updateQuestion: (questionnaireId, questionId, questionData, config = {}) => api.put(`/api/v1/questionnaires/${questionnaireId}/questions/${questionId}`, questionData, config),
   
   
-  getMembers: (id, config = {}) => api.get(`/api/questionnaires/${id}/members`, config),
+  deleteQuestion: (questionnaireId, questionId, config = {}) => api.delete(`/api/v1/questionnaires/${questionnaireId}/questions/${questionId}`, config),
   
-  generateInvite: (id, config = {}) => api.post(`/api/questionnaires/${id}/invite`, null, config),
   
+  reorderQuestions: (questionnaireId, questionIds, config = {}) => api.put(`/api/v1/questionnaires/${questionnaireId}/questions/order`, { question_ids: questionIds }, config),
   
-  createQuestion: (id, questionData, config = {}) => api.post(`/api/questionnaires/${id}/question`, questionData, config),
   
+  getSections: (id, config = {}) => api.get(`/api/v1/questionnaires/${id}/sections`, config),
   
-  updateQuestion: (questionnaireId, questionId, questionData, config = {}) => api.put(`/api/questionnaires/${questionnaireId}/questions/${questionId}`, questionData, config),
   
+  createSection: (id, sectionData, config = {}) => api.post(`/api/v1/questionnaires/${id}/sections`, sectionData, config),
   
-  deleteQuestion: (questionnaireId, questionId, config = {}) => api.delete(`/api/questionnaires/${questionnaireId}/questions/${questionId}`, config),
+  
+  updateSection: (questionnaireId, sectionId, sectionData, config = {}) => api.put(`/api/v1/questionnaires/${questionnaireId}/sections/${sectionId}`, sectionData, config),
+  
+  
+  deleteSection: (questionnaireId, sectionId, config = {}) => api.delete(`/api/v1/questionnaires/${questionnaireId}/sections/${sectionId}`, config),
+  
+  
+  reorderSections: (questionnaireId, sectionIds, config = {}) => api.put(`/api/v1/questionnaires/${questionnaireId}/sections/order`, { section_ids: sectionIds }, config),
+  
+  
+  getCompletion: (id, config = {}) => api.get(`/api/v1/questionnaires/${id}/completion`, config),
+  
+  
+  getRules: (questionnaireId, questionId, config = {}) => api.get(`/api/v1/questionnaires/${questionnaireId}/questions/${questionId}/rules`, config),
+  
+  
+  createRule: (questionnaireId, questionId, ruleData, config = {}) => api.post(`/api/v1/questionnaires/${questionnaireId}/questions/${questionId}/rules`, ruleData, config),
+  
+  
+  deleteRule: (questionnaireId, questionId, ruleId, config = {}) => api.delete(`/api/v1/questionnaires/${questionnaireId}/questions/${questionId}/rules/${ruleId}`, config),
 }
 
 export const participationAPI = {
-  joinQuestionnaire: (token, memberData) => api.post(`/join/${token}`, memberData),
+  joinQuestionnaire: (token, memberData) => api.post(`/api/v1/join/${token}`, memberData),
+  
+  checkMemberIdentifier: (token, identifier) => api.post(`/api/v1/check/member/${token}`, { value: identifier }),
   
-  checkMemberIdentifier: (token, identifier) => api.post(`/check/member/${token}`, { value: identifier }),
+  getQuestionnaireInfo: (token) => api.get(`/api/v1/join/${token}/info`),
   
-  getQuestionnaireInfo: (token) => api.get(`/join/${token}/info`),
+  answerQuestion: (questionId, answer, config = {}) => api.post(`/api/v1/question/${questionId}`, answer, config),
   
-  answerQuestion: (questionId, answer, config = {}) => api.post(`/api/question/${questionId}`, answer, config),
+  answerQuestions: (questionnaireId, answers, config = {}) => api.post(`/api/v1/questionnaires/${questionnaireId}/answers`, { answers }, config),
 }
 
 
@@ -0,0 +1,605 @@
+// Package config loads the Radgifa settings from a config file, environment
+// variables and command line flags, in that order of precedence (flags win).
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	_ "github.com/joho/godotenv/autoload"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	redacted = "******"
+
+	// minJWTSecretLength is the minimum key size for HS256 (256 bits)
+	minJWTSecretLength = 32
+)
+
+// Config holds every setting of the application
+type Config struct {
+	Server   ServerConfig   `yaml:"server" toml:"server"`
+	Auth     AuthConfig     `yaml:"auth" toml:"auth"`
+	Database DatabaseConfig `yaml:"database" toml:"database"`
+	KV       KVConfig       `yaml:"kv" toml:"kv"`
+	Tracing  TracingConfig  `yaml:"tracing" toml:"tracing"`
+	Log      LogConfig      `yaml:"log" toml:"log"`
+	Webhooks WebhookConfig  `yaml:"webhooks" toml:"webhooks"`
+}
+
+// ServerConfig configures the HTTP server. ShutdownTimeoutSeconds bounds
+// the whole graceful shutdown, from draining requests to closing the stores.
+// ShutdownDrainSeconds is how long /readyz fails before the listeners
+// close, so load balancers stop routing to the server first.
+// IdempotencyTTLSeconds is how long a response is replayed for retries
+// bearing the same Idempotency-Key. LegacyAPISunset is the date, as
+// YYYY-MM-DD, announced in the Sunset header of the unversioned API routes;
+// empty leaves the header out.
+type ServerConfig struct {
+	Port                   int    `yaml:"port" toml:"port"`
+	RequestsPerSecond      int    `yaml:"requests_per_second" toml:"requests_per_second"`
+	RoutePrefix            string `yaml:"route_prefix" toml:"route_prefix"`
+	ShutdownTimeoutSeconds int    `yaml:"shutdown_timeout_seconds" toml:"shutdown_timeout_seconds"`
+	ShutdownDrainSeconds   int    `yaml:"shutdown_drain_seconds" toml:"shutdown_drain_seconds"`
+	IdempotencyTTLSeconds  int    `yaml:"idempotency_ttl_seconds" toml:"idempotency_ttl_seconds"`
+	LegacyAPISunset        string `yaml:"legacy_api_sunset" toml:"legacy_api_sunset"`
+}
+
+// AuthConfig holds the signing key and password hashing cost. AdminToken
+// guards the /admin endpoints, which are disabled when it is empty.
+type AuthConfig struct {
+	JWTSecret  string `yaml:"jwt_secret" toml:"jwt_secret"`
+	BcryptCost int    `yaml:"bcrypt_cost" toml:"bcrypt_cost"`
+	AdminToken string `yaml:"admin_token" toml:"admin_token"`
+}
+
+type DatabaseConfig struct {
+	Host     string `yaml:"host" toml:"host"`
+	Port     int    `yaml:"port" toml:"port"`
+	Name     string `yaml:"name" toml:"name"`
+	Username string `yaml:"username" toml:"username"`
+	Password string `yaml:"password" toml:"password"`
+	Schema   string `yaml:"schema" toml:"schema"`
+	SSLMode  string `yaml:"sslmode" toml:"sslmode"`
+}
+
+// KVConfig locates the Badger store. MinFreeMB is the free disk space below
+// which /readyz reports the store as failing.
+type KVConfig struct {
+	StoragePath string `yaml:"storage_path" toml:"storage_path"`
+	MinFreeMB   int    `yaml:"min_free_mb" toml:"min_free_mb"`
+}
+
+// TracingConfig selects where OpenTelemetry spans are exported: "none",
+// "otlp" (HTTP, to Endpoint), "stdout" or "file" (JSON lines to FilePath)
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter" toml:"exporter"`
+	Endpoint    string  `yaml:"endpoint" toml:"endpoint"`
+	Insecure    bool    `yaml:"insecure" toml:"insecure"`
+	FilePath    string  `yaml:"file_path" toml:"file_path"`
+	SampleRatio float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+	ServiceName string  `yaml:"service_name" toml:"service_name"`
+}
+
+// LogConfig selects the log level, the encoding ("json" or "console"), the
+// sinks ("stdout", "stderr", "file") and how personal fields are redacted:
+// "hash" replaces identifiers with a keyed hash and masks free text, "mask"
+// masks both and "off" logs them verbatim
+type LogConfig struct {
+	Level        string        `yaml:"level" toml:"level"`
+	Format       string        `yaml:"format" toml:"format"`
+	Sinks        []string      `yaml:"sinks" toml:"sinks"`
+	File         LogFileConfig `yaml:"file" toml:"file"`
+	Redaction    string        `yaml:"redaction" toml:"redaction"`
+	RedactionKey string        `yaml:"redaction_key" toml:"redaction_key"`
+}
+
+// LogFileConfig configures the rotated log file used by the "file" sink
+type LogFileConfig struct {
+	Path       string `yaml:"path" toml:"path"`
+	MaxSizeMB  int    `yaml:"max_size_mb" toml:"max_size_mb"`
+	MaxBackups int    `yaml:"max_backups" toml:"max_backups"`
+	MaxAgeDays int    `yaml:"max_age_days" toml:"max_age_days"`
+	Compress   bool   `yaml:"compress" toml:"compress"`
+}
+
+// WebhookConfig tunes the delivery of the webhooks: how often the queue is
+// polled, how long a receiver has to answer and how many times a delivery
+// is tried, waiting RetryDelaySeconds after the first failure and twice as
+// long after every other one. Receivers on loopback, private and link-local
+// addresses are refused unless AllowPrivateNetworks.
+type WebhookConfig struct {
+	PollIntervalSeconds  int  `yaml:"poll_interval_seconds" toml:"poll_interval_seconds"`
+	TimeoutSeconds       int  `yaml:"timeout_seconds" toml:"timeout_seconds"`
+	MaxAttempts          int  `yaml:"max_attempts" toml:"max_attempts"`
+	RetryDelaySeconds    int  `yaml:"retry_delay_seconds" toml:"retry_delay_seconds"`
+	AllowPrivateNetworks bool `yaml:"allow_private_networks" toml:"allow_private_networks"`
+}
+
+// Default returns the configuration used when nothing else is provided
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Port:                   8080,
+			RequestsPerSecond:      10,
+			ShutdownTimeoutSeconds: 15,
+			ShutdownDrainSeconds:   5,
+			IdempotencyTTLSeconds:  86400,
+			LegacyAPISunset:        "2027-04-30",
+		},
+		Auth: AuthConfig{
+			BcryptCost: bcrypt.DefaultCost,
+		},
+		Database: DatabaseConfig{
+			Host:    "localhost",
+			Port:    5432,
+			Schema:  "public",
+			SSLMode: "disable",
+		},
+		KV: KVConfig{
+			StoragePath: "./tmp",
+			MinFreeMB:   100,
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			SampleRatio: 1,
+			ServiceName: "radgifa",
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+			Sinks:  []string{"stdout", "file"},
+			File: LogFileConfig{
+				Path:       "logs/app.log",
+				MaxSizeMB:  50,
+				MaxBackups: 7,
+				MaxAgeDays: 14,
+				Compress:   true,
+			},
+			Redaction: "hash",
+		},
+		Webhooks: WebhookConfig{
+			PollIntervalSeconds: 5,
+			TimeoutSeconds:      10,
+			MaxAttempts:         8,
+			RetryDelaySeconds:   60,
+		},
+	}
+}
+
+// Load builds the configuration from defaults, the config file, the
+// environment and the given command line arguments. The file is taken from
+// the -config flag or the RADGIFA_CONFIG variable. Load only fails on values
+// it cannot parse; call Validate to check the result.
+func Load(args []string) (*Config, error) {
+	cfg, rest, err := Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(rest, " "))
+	}
+	return cfg, nil
+}
+
+// Parse works like Load but stops at the first non-flag argument and returns
+// the remaining arguments, so commands can take their own
+func Parse(args []string) (*Config, []string, error) {
+	// A first pass only finds the config file and rejects malformed flags
+	scratch := Default()
+	fs := newFlagSet(&scratch)
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	rest := fs.Args()
+	args = args[:len(args)-len(rest)]
+
+	cfg := Default()
+	if path := fs.Lookup("config").Value.String(); path != "" {
+		if err := loadFile(&cfg, path); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := loadEnv(&cfg, os.LookupEnv); err != nil {
+		return nil, nil, err
+	}
+
+	// Flags are bound to the values loaded so far, so only the ones present
+	// in args override them
+	if err := newFlagSet(&cfg).Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	return &cfg, rest, nil
+}
+
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, cfg)
+	case ".toml":
+		err = toml.Unmarshal(data, cfg)
+	default:
+		return fmt.Errorf("config file %s: unsupported format, use .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// envVar maps an environment variable to a config field
+type envVar struct {
+	name    string
+	string  *string
+	int     *int
+	float   *float64
+	boolean *bool
+	list    *[]string
+}
+
+func envVars(cfg *Config) []envVar {
+	return []envVar{
+		{name: "PORT", int: &cfg.Server.Port},
+		{name: "REQUESTS_PER_SECOND", int: &cfg.Server.RequestsPerSecond},
+		{name: "ROUTE_PREFIX", string: &cfg.Server.RoutePrefix},
+		{name: "SHUTDOWN_TIMEOUT_SECONDS", int: &cfg.Server.ShutdownTimeoutSeconds},
+		{name: "SHUTDOWN_DRAIN_SECONDS", int: &cfg.Server.ShutdownDrainSeconds},
+		{name: "IDEMPOTENCY_TTL_SECONDS", int: &cfg.Server.IdempotencyTTLSeconds},
+		{name: "LEGACY_API_SUNSET", string: &cfg.Server.LegacyAPISunset},
+		{name: "JWT_SECRET", string: &cfg.Auth.JWTSecret},
+		{name: "BCRYPT_COST", int: &cfg.Auth.BcryptCost},
+		{name: "ADMIN_TOKEN", string: &cfg.Auth.AdminToken},
+		{name: "DB_HOST", string: &cfg.Database.Host},
+		{name: "DB_PORT", int: &cfg.Database.Port},
+		{name: "DB_NAME", string: &cfg.Database.Name},
+		{name: "DB_USERNAME", string: &cfg.Database.Username},
+		{name: "DB_PASSWORD", string: &cfg.Database.Password},
+		{name: "DB_SCHEMA", string: &cfg.Database.Schema},
+		{name: "DB_SSLMODE", string: &cfg.Database.SSLMode},
+		{name: "KV_STORAGE_PATH", string: &cfg.KV.StoragePath},
+		{name: "KV_MIN_FREE_MB", int: &cfg.KV.MinFreeMB},
+		{name: "TRACING_EXPORTER", string: &cfg.Tracing.Exporter},
+		{name: "TRACING_ENDPOINT", string: &cfg.Tracing.Endpoint},
+		{name: "TRACING_INSECURE", boolean: &cfg.Tracing.Insecure},
+		{name: "TRACING_FILE_PATH", string: &cfg.Tracing.FilePath},
+		{name: "TRACING_SAMPLE_RATIO", float: &cfg.Tracing.SampleRatio},
+		{name: "OTEL_SERVICE_NAME", string: &cfg.Tracing.ServiceName},
+		{name: "LOG_LEVEL", string: &cfg.Log.Level},
+		{name: "LOG_FORMAT", string: &cfg.Log.Format},
+		{name: "LOG_SINKS", list: &cfg.Log.Sinks},
+		{name: "LOG_FILE_PATH", string: &cfg.Log.File.Path},
+		{name: "LOG_FILE_MAX_SIZE_MB", int: &cfg.Log.File.MaxSizeMB},
+		{name: "LOG_FILE_MAX_BACKUPS", int: &cfg.Log.File.MaxBackups},
+		{name: "LOG_FILE_MAX_AGE_DAYS", int: &cfg.Log.File.MaxAgeDays},
+		{name: "LOG_FILE_COMPRESS", boolean: &cfg.Log.File.Compress},
+		{name: "LOG_REDACTION", string: &cfg.Log.Redaction},
+		{name: "LOG_REDACTION_KEY", string: &cfg.Log.RedactionKey},
+		{name: "WEBHOOK_POLL_INTERVAL_SECONDS", int: &cfg.Webhooks.PollIntervalSeconds},
+		{name: "WEBHOOK_TIMEOUT_SECONDS", int: &cfg.Webhooks.TimeoutSeconds},
+		{name: "WEBHOOK_MAX_ATTEMPTS", int: &cfg.Webhooks.MaxAttempts},
+		{name: "WEBHOOK_RETRY_DELAY_SECONDS", int: &cfg.Webhooks.RetryDelaySeconds},
+		{name: "WEBHOOK_ALLOW_PRIVATE_NETWORKS", boolean: &cfg.Webhooks.AllowPrivateNetworks},
+	}
+}
+
+func loadEnv(cfg *Config, lookup func(string) (string, bool)) error {
+	var errs []error
+	for _, v := range envVars(cfg) {
+		value, ok := lookup(v.name)
+		if !ok || value == "" {
+			continue
+		}
+		switch {
+		case v.string != nil:
+			*v.string = value
+		case v.int != nil:
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a valid integer", v.name, value))
+				continue
+			}
+			*v.int = n
+		case v.float != nil:
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a valid number", v.name, value))
+				continue
+			}
+			*v.float = f
+		case v.boolean != nil:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a valid boolean", v.name, value))
+				continue
+			}
+			*v.boolean = b
+		case v.list != nil:
+			*v.list = splitList(value)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// splitList parses a comma separated list, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// listFlag is a comma separated flag.Value
+type listFlag struct {
+	list *[]string
+}
+
+func (f listFlag) String() string {
+	if f.list == nil {
+		return ""
+	}
+	return strings.Join(*f.list, ",")
+}
+
+func (f listFlag) Set(value string) error {
+	*f.list = splitList(value)
+	return nil
+}
+
+// newFlagSet declares one flag per setting, defaulting to the current
+// values in cfg
+func newFlagSet(cfg *Config) *flag.FlagSet {
+	fs := flag.NewFlagSet("radgifa", flag.ContinueOnError)
+	fs.String("config", os.Getenv("RADGIFA_CONFIG"), "path to a YAML or TOML config file")
+	fs.IntVar(&cfg.Server.Port, "port", cfg.Server.Port, "HTTP port")
+	fs.IntVar(&cfg.Server.RequestsPerSecond, "requests-per-second", cfg.Server.RequestsPerSecond, "rate limit for login and register")
+	fs.StringVar(&cfg.Server.RoutePrefix, "route-prefix", cfg.Server.RoutePrefix, "path prefix for every route")
+	fs.IntVar(&cfg.Server.ShutdownTimeoutSeconds, "shutdown-timeout-seconds", cfg.Server.ShutdownTimeoutSeconds, "deadline for the graceful shutdown")
+	fs.IntVar(&cfg.Server.ShutdownDrainSeconds, "shutdown-drain-seconds", cfg.Server.ShutdownDrainSeconds, "how long /readyz fails before the listeners close on shutdown")
+	fs.IntVar(&cfg.Server.IdempotencyTTLSeconds, "idempotency-ttl-seconds", cfg.Server.IdempotencyTTLSeconds, "how long responses are replayed for a repeated Idempotency-Key")
+	fs.StringVar(&cfg.Server.LegacyAPISunset, "legacy-api-sunset", cfg.Server.LegacyAPISunset, "sunset date (YYYY-MM-DD) of the unversioned API routes")
+	fs.StringVar(&cfg.Auth.JWTSecret, "jwt-secret", cfg.Auth.JWTSecret, "HS256 signing key")
+	fs.IntVar(&cfg.Auth.BcryptCost, "bcrypt-cost", cfg.Auth.BcryptCost, "bcrypt cost for passwords and passcodes")
+	fs.StringVar(&cfg.Auth.AdminToken, "admin-token", cfg.Auth.AdminToken, "bearer token for the /admin endpoints, empty disables them")
+	fs.StringVar(&cfg.Database.Host, "db-host", cfg.Database.Host, "Postgres host")
+	fs.IntVar(&cfg.Database.Port, "db-port", cfg.Database.Port, "Postgres port")
+	fs.StringVar(&cfg.Database.Name, "db-name", cfg.Database.Name, "Postgres database")
+	fs.StringVar(&cfg.Database.Username, "db-username", cfg.Database.Username, "Postgres user")
+	fs.StringVar(&cfg.Database.Password, "db-password", cfg.Database.Password, "Postgres password")
+	fs.StringVar(&cfg.Database.Schema, "db-schema", cfg.Database.Schema, "Postgres search_path")
+	fs.StringVar(&cfg.Database.SSLMode, "db-sslmode", cfg.Database.SSLMode, "Postgres sslmode")
+	fs.StringVar(&cfg.KV.StoragePath, "kv-storage-path", cfg.KV.StoragePath, "directory for the Badger store")
+	fs.IntVar(&cfg.KV.MinFreeMB, "kv-min-free-mb", cfg.KV.MinFreeMB, "free disk space, in MB, below which the server is not ready")
+	fs.StringVar(&cfg.Tracing.Exporter, "tracing-exporter", cfg.Tracing.Exporter, "span exporter: none, otlp, stdout or file")
+	fs.StringVar(&cfg.Tracing.Endpoint, "tracing-endpoint", cfg.Tracing.Endpoint, "OTLP/HTTP endpoint, host:port")
+	fs.BoolVar(&cfg.Tracing.Insecure, "tracing-insecure", cfg.Tracing.Insecure, "send OTLP over plain HTTP")
+	fs.StringVar(&cfg.Tracing.FilePath, "tracing-file-path", cfg.Tracing.FilePath, "file for the file exporter")
+	fs.Float64Var(&cfg.Tracing.SampleRatio, "tracing-sample-ratio", cfg.Tracing.SampleRatio, "fraction of traces to sample, 0 to 1")
+	fs.StringVar(&cfg.Log.Level, "log-level", cfg.Log.Level, "log level: debug, info, warn or error")
+	fs.StringVar(&cfg.Log.Format, "log-format", cfg.Log.Format, "log encoding: json or console")
+	fs.Var(listFlag{&cfg.Log.Sinks}, "log-sinks", "comma separated log sinks: stdout, stderr, file")
+	fs.StringVar(&cfg.Log.File.Path, "log-file-path", cfg.Log.File.Path, "log file for the file sink")
+	fs.IntVar(&cfg.Log.File.MaxSizeMB, "log-file-max-size-mb", cfg.Log.File.MaxSizeMB, "size in MB before the log file is rotated")
+	fs.IntVar(&cfg.Log.File.MaxBackups, "log-file-max-backups", cfg.Log.File.MaxBackups, "rotated log files to keep")
+	fs.IntVar(&cfg.Log.File.MaxAgeDays, "log-file-max-age-days", cfg.Log.File.MaxAgeDays, "days to keep rotated log files")
+	fs.BoolVar(&cfg.Log.File.Compress, "log-file-compress", cfg.Log.File.Compress, "gzip rotated log files")
+	fs.StringVar(&cfg.Log.Redaction, "log-redaction", cfg.Log.Redaction, "personal field redaction: hash, mask or off")
+	fs.StringVar(&cfg.Log.RedactionKey, "log-redaction-key", cfg.Log.RedactionKey, "key for hashed fields, random per process when empty")
+	fs.IntVar(&cfg.Webhooks.PollIntervalSeconds, "webhook-poll-interval-seconds", cfg.Webhooks.PollIntervalSeconds, "how often the webhook deliveries are polled")
+	fs.IntVar(&cfg.Webhooks.TimeoutSeconds, "webhook-timeout-seconds", cfg.Webhooks.TimeoutSeconds, "how long a webhook receiver has to answer")
+	fs.IntVar(&cfg.Webhooks.MaxAttempts, "webhook-max-attempts", cfg.Webhooks.MaxAttempts, "attempts before a webhook delivery fails")
+	fs.IntVar(&cfg.Webhooks.RetryDelaySeconds, "webhook-retry-delay-seconds", cfg.Webhooks.RetryDelaySeconds, "wait after the first failed webhook attempt, doubled after every other one")
+	fs.BoolVar(&cfg.Webhooks.AllowPrivateNetworks, "webhook-allow-private-networks", cfg.Webhooks.AllowPrivateNetworks, "let webhooks reach loopback, private and link-local addresses")
+	return fs
+}
+
+// Validate reports every invalid setting at once
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		add("server.port: %d is out of range 1-65535", c.Server.Port)
+	}
+	if c.Server.RequestsPerSecond <= 0 {
+		add("server.requests_per_second: must be positive, got %d", c.Server.RequestsPerSecond)
+	}
+	if c.Server.ShutdownTimeoutSeconds <= 0 {
+		add("server.shutdown_timeout_seconds: must be positive, got %d", c.Server.ShutdownTimeoutSeconds)
+	}
+	if c.Server.ShutdownDrainSeconds < 0 || c.Server.ShutdownDrainSeconds >= c.Server.ShutdownTimeoutSeconds {
+		add("server.shutdown_drain_seconds: must be at least 0 and less than shutdown_timeout_seconds, got %d", c.Server.ShutdownDrainSeconds)
+	}
+	if c.Server.IdempotencyTTLSeconds <= 0 {
+		add("server.idempotency_ttl_seconds: must be positive, got %d", c.Server.IdempotencyTTLSeconds)
+	}
+	if c.Server.LegacyAPISunset != "" {
+		if _, err := time.Parse(time.DateOnly, c.Server.LegacyAPISunset); err != nil {
+			add("server.legacy_api_sunset: %q is not a YYYY-MM-DD date", c.Server.LegacyAPISunset)
+		}
+	}
+	if c.Server.RoutePrefix != "" && !strings.HasPrefix(c.Server.RoutePrefix, "/") {
+		add("server.route_prefix: %q must start with /", c.Server.RoutePrefix)
+	}
+
+	if c.Auth.JWTSecret == "" {
+		add("auth.jwt_secret: is required")
+	} else if len(c.Auth.JWTSecret) < minJWTSecretLength {
+		add("auth.jwt_secret: must be at least %d bytes long", minJWTSecretLength)
+	}
+	if c.Auth.BcryptCost < bcrypt.MinCost || c.Auth.BcryptCost > bcrypt.MaxCost {
+		add("auth.bcrypt_cost: %d is out of range %d-%d", c.Auth.BcryptCost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	if c.Database.Host == "" {
+		add("database.host: is required")
+	}
+	if c.Database.Port < 1 || c.Database.Port > 65535 {
+		add("database.port: %d is out of range 1-65535", c.Database.Port)
+	}
+	if c.Database.Name == "" {
+		add("database.name: is required")
+	}
+	if c.Database.Username == "" {
+		add("database.username: is required")
+	}
+
+	if c.KV.StoragePath == "" {
+		add("kv.storage_path: is required")
+	}
+	if c.KV.MinFreeMB < 0 {
+		add("kv.min_free_mb: %d must not be negative", c.KV.MinFreeMB)
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.Endpoint == "" && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+			add("tracing.endpoint: is required for the otlp exporter")
+		}
+	case "file":
+		if c.Tracing.FilePath == "" {
+			add("tracing.file_path: is required for the file exporter")
+		}
+	default:
+		add("tracing.exporter: %q must be one of none, otlp, stdout or file", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		add("tracing.sample_ratio: %v is out of range 0-1", c.Tracing.SampleRatio)
+	}
+
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		add("log.level: %q must be one of debug, info, warn or error", c.Log.Level)
+	}
+	switch c.Log.Format {
+	case "json", "console":
+	default:
+		add("log.format: %q must be json or console", c.Log.Format)
+	}
+	if len(c.Log.Sinks) == 0 {
+		add("log.sinks: at least one sink is required")
+	}
+	for _, sink := range c.Log.Sinks {
+		switch sink {
+		case "stdout", "stderr":
+		case "file":
+			if c.Log.File.Path == "" {
+				add("log.file.path: is required for the file sink")
+			}
+		default:
+			add("log.sinks: %q must be one of stdout, stderr or file", sink)
+		}
+	}
+	if c.Log.File.MaxSizeMB < 0 || c.Log.File.MaxBackups < 0 || c.Log.File.MaxAgeDays < 0 {
+		add("log.file: rotation limits must not be negative")
+	}
+	switch c.Log.Redaction {
+	case "hash", "mask", "off":
+	default:
+		add("log.redaction: %q must be one of hash, mask or off", c.Log.Redaction)
+	}
+
+	if c.Webhooks.PollIntervalSeconds <= 0 {
+		add("webhooks.poll_interval_seconds: must be positive, got %d", c.Webhooks.PollIntervalSeconds)
+	}
+	if c.Webhooks.TimeoutSeconds <= 0 {
+		add("webhooks.timeout_seconds: must be positive, got %d", c.Webhooks.TimeoutSeconds)
+	}
+	if c.Webhooks.MaxAttempts <= 0 {
+		add("webhooks.max_attempts: must be positive, got %d", c.Webhooks.MaxAttempts)
+	}
+	if c.Webhooks.RetryDelaySeconds <= 0 {
+		add("webhooks.retry_delay_seconds: must be positive, got %d", c.Webhooks.RetryDelaySeconds)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Redacted returns a copy with every secret masked, safe to print or log
+func (c Config) Redacted() Config {
+	if c.Auth.JWTSecret != "" {
+		c.Auth.JWTSecret = redacted
+	}
+	if c.Auth.AdminToken != "" {
+		c.Auth.AdminToken = redacted
+	}
+	if c.Database.Password != "" {
+		c.Database.Password = redacted
+	}
+	if c.Log.RedactionKey != "" {
+		c.Log.RedactionKey = redacted
+	}
+	return c
+}
+
+// Print writes the redacted configuration as YAML
+func (c Config) Print(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c.Redacted()); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// DSN returns the Postgres connection string
+func (d DatabaseConfig) DSN() string {
+	query := url.Values{}
+	query.Set("sslmode", d.SSLMode)
+	if d.Schema != "" {
+		query.Set("search_path", d.Schema)
+	}
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(d.Username, d.Password),
+		Host:     net.JoinHostPort(d.Host, strconv.Itoa(d.Port)),
+		Path:     "/" + d.Name,
+		RawQuery: query.Encode(),
+	}
+	return dsn.String()
+}
+
+// ShutdownTimeout returns the graceful shutdown deadline
+func (s ServerConfig) ShutdownTimeout() time.Duration {
+	return time.Duration(s.ShutdownTimeoutSeconds) * time.Second
+}
+
+// ShutdownDrain returns how long /readyz fails before the listeners close
+func (s ServerConfig) ShutdownDrain() time.Duration {
+	return time.Duration(s.ShutdownDrainSeconds) * time.Second
+}
+
+// IdempotencyTTL returns how long idempotent responses are kept
+func (s ServerConfig) IdempotencyTTL() time.Duration {
+	return time.Duration(s.IdempotencyTTLSeconds) * time.Second
+}
+
+// LegacySunset returns the sunset date of the unversioned API routes, the
+// zero time when none is set
+func (s ServerConfig) LegacySunset() time.Time {
+	t, _ := time.Parse(time.DateOnly, s.LegacyAPISunset)
+	return t
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestLoadPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "radgifa.yaml")
+	content := "server:\n  port: 7000\n  requests_per_second: 3\ndatabase:\n  name: from_file\n  host: file-host\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("RADGIFA_CONFIG", path)
+	t.Setenv("PORT", "7100")
+	t.Setenv("DB_HOST", "env-host")
+
+	cfg, err := Load([]string{"-db-host", "flag-host"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if cfg.Server.RequestsPerSecond != 3 {
+		t.Errorf("requests_per_second = %d, want 3 from the file", cfg.Server.RequestsPerSecond)
+	}
+	if cfg.Database.Name != "from_file" {
+		t.Errorf("database.name = %q, want value from the file", cfg.Database.Name)
+	}
+	if cfg.Server.Port != 7100 {
+		t.Errorf("server.port = %d, want 7100 from the environment", cfg.Server.Port)
+	}
+	if cfg.Database.Host != "flag-host" {
+		t.Errorf("database.host = %q, want value from the flag", cfg.Database.Host)
+	}
+	if cfg.KV.StoragePath != Default().KV.StoragePath {
+		t.Errorf("kv.storage_path = %q, want default", cfg.KV.StoragePath)
+	}
+}
+
+func TestLoadTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "radgifa.toml")
+	if err := os.WriteFile(path, []byte("[kv]\nstorage_path = \"/data\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load([]string{"-config", path})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.KV.StoragePath != "/data" {
+		t.Errorf("kv.storage_path = %q, want /data", cfg.KV.StoragePath)
+	}
+}
+
+func TestLoadRejectsInvalidEnv(t *testing.T) {
+	t.Setenv("REQUESTS_PER_SECOND", "ten")
+
+	_, err := Load(nil)
+	if err == nil || !strings.Contains(err.Error(), "REQUESTS_PER_SECOND") {
+		t.Fatalf("Load() error = %v, want an error naming REQUESTS_PER_SECOND", err)
+	}
+}
+
+func TestLoadLogSinks(t *testing.T) {
+	t.Setenv("LOG_SINKS", "stderr, file")
+
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := strings.Join(cfg.Log.Sinks, ","); got != "stderr,file" {
+		t.Errorf("log.sinks = %q from the environment, want stderr,file", got)
+	}
+
+	cfg, err = Load([]string{"-log-sinks", "stdout"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := strings.Join(cfg.Log.Sinks, ","); got != "stdout" {
+		t.Errorf("log.sinks = %q from the flag, want stdout", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := Default()
+	cfg.Database.Name = "radgifa"
+	cfg.Database.Username = "radgifa"
+	cfg.Auth.JWTSecret = testSecret
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	cfg.Auth.JWTSecret = ""
+	cfg.Server.Port = 0
+	cfg.Server.ShutdownDrainSeconds = cfg.Server.ShutdownTimeoutSeconds
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Validate() accepted an empty JWT secret, port 0 and a drain as long as the shutdown")
+	}
+	for _, want := range []string{"auth.jwt_secret", "server.port", "server.shutdown_drain_seconds"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() error %q does not mention %s", err, want)
+		}
+	}
+}
+
+func TestPrintRedactsSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.Auth.JWTSecret = testSecret
+	cfg.Database.Password = "hunter2"
+	cfg.Auth.AdminToken = "admin-token"
+	cfg.Log.RedactionKey = "redaction-key"
+
+	var out strings.Builder
+	if err := cfg.Print(&out); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	for _, secret := range []string{testSecret, "hunter2", "admin-token", "redaction-key"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("Print() output leaks %q", secret)
+		}
+	}
+}
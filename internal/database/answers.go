@@ -0,0 +1,148 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+	"radgifa/ent/question"
+	"radgifa/ent/questionnaire"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidAnswers is returned by CreateAnswers, wrapped with the reason,
+// when the batch cannot be saved as a whole
+var ErrInvalidAnswers = newError(ErrInvalid, "invalid_answers", "invalid answers")
+
+// NewAnswer is one answer of a batch
+type NewAnswer struct {
+	QuestionID  uuid.UUID
+	AnswerValue string
+}
+
+// CreateAnswers records several answers of a member in one transaction:
+// either all of them are saved or none is. Every question must belong to the
+// member's questionnaire, appear once in the batch and be visible once the
+// whole batch is taken into account. Like CreateAnswer, it returns
+// ErrAnswersLocked after a locked submission and otherwise reopens it.
+func (s *service) CreateAnswers(memberID uuid.UUID, answers []NewAnswer, ctx context.Context) ([]*ent.Answer, error) {
+	if len(answers) == 0 {
+		return nil, fmt.Errorf("%w: no answers", ErrInvalidAnswers)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// Lock the member so the batch and a submission do not interleave
+	m, err := tx.Member.Query().
+		Where(member.ID(memberID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	q, err := tx.Questionnaire.Query().
+		Where(questionnaire.HasMembersWith(member.ID(memberID))).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if q.ClosedAt != nil {
+		return nil, rollback(tx, ErrQuestionnaireClosed)
+	}
+	if m.CompletedAt != nil && q.LockAfterSubmit {
+		return nil, rollback(tx, ErrAnswersLocked)
+	}
+
+	questions, err := tx.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.ID(q.ID))).
+		WithRules(func(q *ent.RuleQuery) {
+			q.WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) })
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	inQuestionnaire := make(map[uuid.UUID]bool, len(questions))
+	for _, question := range questions {
+		inQuestionnaire[question.ID] = true
+	}
+
+	byQuestion, err := memberAnswers(tx.Answer, memberID, q.ID, ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	inBatch := make(map[uuid.UUID]bool, len(answers))
+	for _, a := range answers {
+		value := answer.AnswerValue(a.AnswerValue)
+		if err := answer.AnswerValueValidator(value); err != nil {
+			return nil, rollback(tx, fmt.Errorf("%w: unknown answer value %q", ErrInvalidAnswers, a.AnswerValue))
+		}
+		if !inQuestionnaire[a.QuestionID] {
+			return nil, rollback(tx, fmt.Errorf("%w: question %s is not in the questionnaire", ErrInvalidAnswers, a.QuestionID))
+		}
+		if inBatch[a.QuestionID] {
+			return nil, rollback(tx, fmt.Errorf("%w: question %s is answered twice", ErrInvalidAnswers, a.QuestionID))
+		}
+		inBatch[a.QuestionID] = true
+		byQuestion[a.QuestionID] = value
+	}
+
+	// Visibility is checked against the answers as they will be once the
+	// batch is saved, so a batch can answer a question and its dependents
+	visible := visibleQuestions(questions, byQuestion)
+	for _, a := range answers {
+		if !visible[a.QuestionID] {
+			return nil, rollback(tx, fmt.Errorf("%w: question %s", ErrQuestionHidden, a.QuestionID))
+		}
+	}
+
+	if m.CompletedAt != nil {
+		if err := tx.Member.UpdateOneID(memberID).ClearCompletedAt().Exec(ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	saved := make([]*ent.Answer, 0, len(answers))
+	for _, a := range answers {
+		created, err := saveAnswer(tx.Answer, memberID, a.QuestionID, answer.AnswerValue(a.AnswerValue), ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		saved = append(saved, created)
+	}
+	return saved, tx.Commit()
+}
+
+// saveAnswer replaces the answer of the member to the question, or creates
+// it. It takes the answer client of a transaction when called inside one.
+// The upsert relies on the unique (member, question) index, so concurrent
+// calls for the same pair end up updating a single row.
+func saveAnswer(client *ent.AnswerClient, memberID, questionID uuid.UUID, value answer.AnswerValue, ctx context.Context) (*ent.Answer, error) {
+	id, err := client.Create().
+		SetMemberID(memberID).
+		SetQuestionID(questionID).
+		SetAnswerValue(value).
+		OnConflict(sql.ConflictColumns(answer.MemberColumn, answer.QuestionColumn)).
+		Update(func(u *ent.AnswerUpsert) {
+			u.UpdateAnswerValue()
+			u.UpdateUpdatedAt()
+		}).
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.Get(ctx, id)
+}
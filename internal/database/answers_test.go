@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCreateAnswers(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Batcher")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	pizza := f.question(q.ID, "Pizza?", nil, false)
+	topping := f.question(q.ID, "Pineapple?", nil, false)
+	if _, err := srv.CreateRule(topping.ID, pizza.ID, []string{"Yes"}, ctx); err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+
+	other := f.questionnaire(owner.ID, "Other", false)
+	foreign := f.question(other.ID, "Elsewhere?", nil, false)
+
+	alice := f.member(owner.ID, q.ID, "Alice")
+
+	countAnswers := func() int {
+		t.Helper()
+		page, err := srv.ListMemberAnswers(alice.ID, q.ID, AnswerFilter{}, PageRequest{}, ctx)
+		if err != nil {
+			t.Fatalf("ListMemberAnswers() error = %v", err)
+		}
+		return page.Total
+	}
+
+	// One bad answer rejects the whole batch
+	_, err := srv.CreateAnswers(alice.ID, []NewAnswer{
+		{QuestionID: pizza.ID, AnswerValue: "Yes"},
+		{QuestionID: foreign.ID, AnswerValue: "Yes"},
+	}, ctx)
+	if !errors.Is(err, ErrInvalidAnswers) {
+		t.Fatalf("CreateAnswers() with a foreign question error = %v, want ErrInvalidAnswers", err)
+	}
+	if n := countAnswers(); n != 0 {
+		t.Fatalf("%d answers saved by a rejected batch, want 0", n)
+	}
+
+	_, err = srv.CreateAnswers(alice.ID, []NewAnswer{
+		{QuestionID: pizza.ID, AnswerValue: "No"},
+		{QuestionID: topping.ID, AnswerValue: "Yes"},
+	}, ctx)
+	if !errors.Is(err, ErrQuestionHidden) {
+		t.Fatalf("CreateAnswers() with a hidden question error = %v, want ErrQuestionHidden", err)
+	}
+
+	// The topping question is shown by the pizza answer of the same batch
+	saved, err := srv.CreateAnswers(alice.ID, []NewAnswer{
+		{QuestionID: pizza.ID, AnswerValue: "Yes"},
+		{QuestionID: topping.ID, AnswerValue: "No"},
+	}, ctx)
+	if err != nil {
+		t.Fatalf("CreateAnswers() error = %v", err)
+	}
+	if len(saved) != 2 {
+		t.Errorf("CreateAnswers() saved %d answers, want 2", len(saved))
+	}
+
+	// Answering again replaces the answers
+	if _, err := srv.CreateAnswers(alice.ID, []NewAnswer{{QuestionID: topping.ID, AnswerValue: "Pass"}}, ctx); err != nil {
+		t.Fatalf("CreateAnswers() error = %v", err)
+	}
+	if n := countAnswers(); n != 2 {
+		t.Errorf("%d answers after answering again, want 2", n)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log"
-	"os"
 	"strconv"
 	"time"
 
@@ -16,29 +15,35 @@ import (
 	"radgifa/ent/member"
 	"radgifa/ent/question"
 	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
 	"radgifa/ent/user"
+	"radgifa/ent/webhook"
+	"radgifa/ent/webhookdelivery"
+	"radgifa/internal/config"
 
 	"entgo.io/ent/dialect"
 	enSQL "entgo.io/ent/dialect/sql"
+	"github.com/XSAM/otelsql"
 	"github.com/google/uuid"
 	_ "github.com/jackc/pgx/v5/stdlib"
-	_ "github.com/joho/godotenv/autoload"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
 	"golang.org/x/crypto/bcrypt"
 )
 
-var (
-	bcryptCost = getCurrentBcryptCost()
-)
-
 type Service interface {
 	Health() map[string]string
+	Ping(ctx context.Context) error
+	Stats() sql.DBStats
 	Close() error
 	Client() *ent.Client
 
 	CreateUser(name, displayName, username, password string, ctx context.Context) (*ent.User, error)
 	ValidateUserCredentials(username, password string, ctx context.Context) (*ent.User, error)
 	IsUsernameAvailable(username string, ctx context.Context) (bool, error)
-	CreateQuestionnaire(userID uuid.UUID, title, description string, ctx context.Context) (*ent.Questionnaire, error)
+	GetUserByUsername(username string, ctx context.Context) (*ent.User, error)
+	UpdateUserPassword(userID uuid.UUID, password string, ctx context.Context) error
+	CreateQuestionnaire(userID uuid.UUID, title, description string, lockAfterSubmit bool, ctx context.Context) (*ent.Questionnaire, error)
 	GetQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error)
 
 	CreateMember(userID, questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, error)
@@ -46,62 +51,134 @@ type Service interface {
 	ValidateMemberCredentials(uniqueIdentifier, passcode string, ctx context.Context) (*ent.Member, error)
 	GetMemberWithQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error)
 	IsMemberIdentifierAvailable(questionnaireID uuid.UUID, uniqueIdentifier string, ctx context.Context) (bool, error)
-	CreateNewQuestion(questionnaireID uuid.UUID, text, theme string, ctx context.Context) (*ent.Question, error)
-	UpdateQuestionnaire(questionnaireID uuid.UUID, title, description string, ctx context.Context) (*ent.Questionnaire, error)
+	CreateNewQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ctx context.Context) (*ent.Question, error)
+	InsertQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, index int, ctx context.Context) (*ent.Question, error)
+	ReorderQuestions(questionnaireID uuid.UUID, questionIDs []uuid.UUID, ctx context.Context) ([]*ent.Question, error)
+	UpdateQuestionnaire(questionnaireID uuid.UUID, title, description string, lockAfterSubmit bool, ifVersion int, ctx context.Context) (*ent.Questionnaire, error)
 	PublishQuestionnaire(questionnaireID, userID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error)
+	UnpublishQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error)
+	CloseQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error)
 	DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) error
-	UpdateQuestion(questionID uuid.UUID, text, theme string, ctx context.Context) (*ent.Question, error)
+	UpdateQuestion(questionID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ifVersion int, ctx context.Context) (*ent.Question, error)
 	DeleteQuestion(questionID uuid.UUID, ctx context.Context) error
 	GetQuestionWithQuestionnaire(questionID uuid.UUID, ctx context.Context) (*ent.Question, error)
 	GetMemberByUserAndQuestionnaire(userID, questionnaireID uuid.UUID, ctx context.Context) (*ent.Member, error)
 	CreateAnswer(memberID, questionID uuid.UUID, answerValue string, ctx context.Context) (*ent.Answer, error)
+	CreateAnswers(memberID uuid.UUID, answers []NewAnswer, ctx context.Context) ([]*ent.Answer, error)
 
 	// New GET methods
-	GetUserQuestionnaires(userID uuid.UUID, ctx context.Context) ([]*ent.Questionnaire, error)
 	GetQuestionnaireWithDetails(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error)
 	GetQuestionnaireQuestions(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error)
-	GetQuestionnaireQuestionsWithAnswers(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error)
-	GetQuestionnaireMembers(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Member, error)
-	GetMemberAnswers(memberID, questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Answer, error)
-}
+
+	// Paginated listings
+	ListUserQuestionnaires(userID uuid.UUID, filter QuestionnaireFilter, req PageRequest, ctx context.Context) (Page[*ent.Questionnaire], error)
+	ListQuestionnaireQuestions(questionnaireID uuid.UUID, filter QuestionFilter, req PageRequest, ctx context.Context) (Page[*ent.Question], error)
+	ListQuestionnaireMembers(questionnaireID uuid.UUID, filter MemberFilter, req PageRequest, ctx context.Context) (Page[*ent.Member], error)
+	ListMemberAnswers(memberID, questionnaireID uuid.UUID, filter AnswerFilter, req PageRequest, ctx context.Context) (Page[*ent.Answer], error)
+	ListUserMemberships(userID uuid.UUID, filter MembershipFilter, req PageRequest, ctx context.Context) (Page[Membership], error)
+
+	// Sections
+	CreateSection(questionnaireID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error)
+	GetSectionWithQuestionnaire(sectionID uuid.UUID, ctx context.Context) (*ent.Section, error)
+	GetQuestionnaireSections(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Section, error)
+	UpdateSection(sectionID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error)
+	DeleteSection(sectionID uuid.UUID, ctx context.Context) error
+	ReorderSections(questionnaireID uuid.UUID, sectionIDs []uuid.UUID, ctx context.Context) ([]*ent.Section, error)
+	GetQuestionnaireCompletion(questionnaireID uuid.UUID, ctx context.Context) (Completion, error)
+
+	// Branching rules
+	CreateRule(questionID, sourceID uuid.UUID, answerValues []string, ctx context.Context) (*ent.Rule, error)
+	GetRuleWithQuestion(ruleID uuid.UUID, ctx context.Context) (*ent.Rule, error)
+	GetQuestionRules(questionID uuid.UUID, ctx context.Context) ([]*ent.Rule, error)
+	DeleteRule(ruleID uuid.UUID, ctx context.Context) error
+	SubmitQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error)
+	GetVisibleQuestions(memberID, questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error)
+
+	// Personal access tokens
+	CreateAccessToken(userID uuid.UUID, name string, scopes []string, expiresAt *int64, ctx context.Context) (*ent.AccessToken, string, error)
+	ListAccessTokens(userID uuid.UUID, ctx context.Context) ([]*ent.AccessToken, error)
+	RevokeAccessToken(userID, tokenID uuid.UUID, ctx context.Context) error
+	AuthenticateAccessToken(token string, ctx context.Context) (*ent.AccessToken, error)
+
+	// Webhooks
+	CreateWebhook(questionnaireID uuid.UUID, url string, events []string, ctx context.Context) (*ent.Webhook, error)
+	ListWebhooks(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Webhook, error)
+	GetWebhookWithQuestionnaire(webhookID uuid.UUID, ctx context.Context) (*ent.Webhook, error)
+	DeleteWebhook(webhookID uuid.UUID, ctx context.Context) error
+	ListWebhookDeliveries(webhookID uuid.UUID, req PageRequest, ctx context.Context) (Page[*ent.WebhookDelivery], error)
+	RedeliverWebhookDelivery(webhookID, deliveryID uuid.UUID, ctx context.Context) (*ent.WebhookDelivery, error)
+	ClaimWebhookDeliveries(limit int, lease time.Duration, ctx context.Context) ([]*ent.WebhookDelivery, error)
+	RecordWebhookAttempt(deliveryID uuid.UUID, attempt WebhookAttempt, ctx context.Context) error
+}
+
+// ErrInvalidQuestionOrder is returned by ReorderQuestions when the new order
+// does not list every question of the questionnaire exactly once
+var ErrInvalidQuestionOrder = newError(ErrInvalid, "invalid_question_order", "the order must list every question of the questionnaire exactly once")
+
+// ErrVersionMismatch is returned by the updates given a version when the
+// stored version differs, that is someone else changed the entity meanwhile
+var ErrVersionMismatch = newError(ErrConflict, "version_mismatch", "modified since it was read")
+
+// ErrQuestionnaireClosed is returned when joining, answering or submitting a
+// questionnaire its owner closed
+var ErrQuestionnaireClosed = newError(ErrConflict, "questionnaire_closed", "the questionnaire is closed")
 
 type service struct {
-	db     *sql.DB
-	client *ent.Client
-}
-
-var (
-	database   = os.Getenv("DB_NAME")
-	password   = os.Getenv("DB_PASSWORD")
-	username   = os.Getenv("DB_USERNAME")
-	port       = os.Getenv("DB_PORT")
-	host       = os.Getenv("DB_HOST")
-	schema     = os.Getenv("DB_SCHEMA")
-	dbInstance *service
-)
+	db         *sql.DB
+	client     *ent.Client
+	name       string
+	bcryptCost int
+}
+
+// Open connects to the Postgres database described by cfg and makes sure the
+// schema exists. Every service call and SQL statement is traced, and the
+// storage errors are returned as domain errors.
+func Open(cfg *config.Config) (Service, error) {
+	db, err := otelsql.Open("pgx", cfg.Database.DSN(),
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{OmitConnResetSession: true, OmitRows: true}),
+	)
+	if err != nil {
+		return nil, err
+	}
 
-func New() Service {
-	if dbInstance != nil {
-		return dbInstance
+	srv, err := newService(db, cfg.Auth.BcryptCost)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed creating schema resources: %w", err)
 	}
-	connStr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable&search_path=%s", username, password, host, port, database, schema)
-	db, err := sql.Open("pgx", connStr)
+	srv.name = cfg.Database.Name
+	return WithTracing(withDomainErrors(srv)), nil
+}
+
+// NewFromDB builds a Service on top of an already opened Postgres pool and
+// makes sure the schema exists. Closing the Service closes db.
+func NewFromDB(db *sql.DB) (Service, error) {
+	srv, err := newService(db, bcrypt.DefaultCost)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
+	return WithTracing(withDomainErrors(srv)), nil
+}
 
+func newService(db *sql.DB, bcryptCost int) (*service, error) {
 	drv := enSQL.OpenDB(dialect.Postgres, db)
 	client := ent.NewClient(ent.Driver(drv))
 
-	if err := client.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+	if err := migrate(context.Background(), client, db); err != nil {
+		return nil, err
 	}
 
-	dbInstance = &service{
-		db:     db,
-		client: client,
-	}
-	return dbInstance
+	return &service{
+		db:         db,
+		client:     client,
+		bcryptCost: bcryptCost,
+	}, nil
+}
+
+// Ping checks that the database answers within the deadline of ctx
+func (s *service) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
 }
 
 func (s *service) Health() map[string]string {
@@ -110,11 +187,10 @@ func (s *service) Health() map[string]string {
 
 	stats := make(map[string]string)
 
-	err := s.db.PingContext(ctx)
+	err := s.Ping(ctx)
 	if err != nil {
 		stats["status"] = "down"
 		stats["error"] = fmt.Sprintf("db down: %v", err)
-		log.Fatalf("db down: %v", err)
 		return stats
 	}
 
@@ -149,12 +225,17 @@ func (s *service) Health() map[string]string {
 	return stats
 }
 
+// Stats returns the connection pool statistics.
+func (s *service) Stats() sql.DBStats {
+	return s.db.Stats()
+}
+
 // Close closes the database connection.
 // It logs a message indicating the disconnection from the specific database.
 // If the connection is successfully closed, it returns nil.
 // If an error occurs while closing the connection, it returns the error.
 func (s *service) Close() error {
-	log.Printf("Disconnected from database: %s", database)
+	log.Printf("Disconnected from database: %s", s.name)
 	if err := s.client.Close(); err != nil {
 		log.Printf("Error closing Ent client: %v", err)
 	}
@@ -166,18 +247,6 @@ func (s *service) Client() *ent.Client {
 	return s.client
 }
 
-func getCurrentBcryptCost() int {
-	costStr := os.Getenv("BCRYPT_COST")
-	if costStr == "" {
-		return bcrypt.DefaultCost
-	}
-	cost, err := strconv.Atoi(costStr)
-	if err != nil {
-		return bcrypt.DefaultCost
-	}
-	return cost
-}
-
 func generateSecurePasscode() (string, error) {
 	bytes := make([]byte, 6) // 6 bytes = 48 bits
 	if _, err := rand.Read(bytes); err != nil {
@@ -187,7 +256,7 @@ func generateSecurePasscode() (string, error) {
 }
 
 func (s *service) CreateUser(name, displayName, username, password string, ctx context.Context) (*ent.User, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost)
 	if err != nil {
 		return nil, err
 	}
@@ -220,10 +289,27 @@ func (s *service) IsUsernameAvailable(username string, ctx context.Context) (boo
 	return count == 0, nil
 }
 
-func (s *service) CreateQuestionnaire(userID uuid.UUID, title, description string, ctx context.Context) (*ent.Questionnaire, error) {
+func (s *service) GetUserByUsername(username string, ctx context.Context) (*ent.User, error) {
+	return s.client.User.Query().
+		Where(user.Username(username)).
+		Only(ctx)
+}
+
+func (s *service) UpdateUserPassword(userID uuid.UUID, password string, ctx context.Context) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost)
+	if err != nil {
+		return err
+	}
+	return s.client.User.UpdateOneID(userID).
+		SetPassword(hashedPassword).
+		Exec(ctx)
+}
+
+func (s *service) CreateQuestionnaire(userID uuid.UUID, title, description string, lockAfterSubmit bool, ctx context.Context) (*ent.Questionnaire, error) {
 	questionnaire, err := s.client.Questionnaire.Create().
 		SetTitle(title).
 		SetDescription(description).
+		SetLockAfterSubmit(lockAfterSubmit).
 		SetOwnerID(userID).
 		Save(ctx)
 	if err != nil {
@@ -245,16 +331,15 @@ func (s *service) GetQuestionnaire(questionnaireID uuid.UUID, ctx context.Contex
 }
 
 func (s *service) CreateMember(userID, questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, error) {
-	member, err := s.client.Member.Create().
-		SetQuestionnaireID(questionnaireID).
-		SetUserID(userID).
-		SetUniqueIdentifier(uniqueIdentifier).
-		SetDisplayName(displayName).
-		Save(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return member, nil
+	// Members linked to a user log in as the user, they have no passcode
+	return s.joinQuestionnaire(questionnaireID, ctx, func(tx *ent.Tx) *ent.MemberCreate {
+		return tx.Member.Create().
+			SetQuestionnaireID(questionnaireID).
+			SetUserID(userID).
+			SetUniqueIdentifier(uniqueIdentifier).
+			SetDisplayName(displayName).
+			SetPassCode([]byte{})
+	})
 }
 
 func (s *service) CreateAnonymousMember(questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, string, error) {
@@ -263,23 +348,61 @@ func (s *service) CreateAnonymousMember(questionnaireID uuid.UUID, uniqueIdentif
 		return nil, "", err
 	}
 
-	hashedPasscode, err := bcrypt.GenerateFromPassword([]byte(passcode), bcryptCost)
+	hashedPasscode, err := bcrypt.GenerateFromPassword([]byte(passcode), s.bcryptCost)
 	if err != nil {
 		return nil, "", err
 	}
 
-	member, err := s.client.Member.Create().
-		SetQuestionnaireID(questionnaireID).
-		SetDisplayName(displayName).
-		SetUniqueIdentifier(uniqueIdentifier).
-		SetPassCode(hashedPasscode).
-		Save(ctx)
+	member, err := s.joinQuestionnaire(questionnaireID, ctx, func(tx *ent.Tx) *ent.MemberCreate {
+		return tx.Member.Create().
+			SetQuestionnaireID(questionnaireID).
+			SetDisplayName(displayName).
+			SetUniqueIdentifier(uniqueIdentifier).
+			SetPassCode(hashedPasscode)
+	})
 	if err != nil {
 		return nil, "", err
 	}
 	return member, passcode, nil
 }
 
+// joinQuestionnaire saves the member built by create unless the
+// questionnaire is closed, and announces them to the webhooks
+func (s *service) joinQuestionnaire(questionnaireID uuid.UUID, ctx context.Context, create func(tx *ent.Tx) *ent.MemberCreate) (*ent.Member, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// Shared lock, so members join concurrently but not while the
+	// questionnaire is being closed
+	q, err := tx.Questionnaire.Query().
+		Where(questionnaire.ID(questionnaireID)).
+		ForShare().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if q.ClosedAt != nil {
+		return nil, rollback(tx, ErrQuestionnaireClosed)
+	}
+
+	member, err := create(tx).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := enqueueEvent(tx.Client(), questionnaireID, EventMemberJoined, memberEvent(member), ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	return member, tx.Commit()
+}
+
 func (s *service) ValidateMemberCredentials(uniqueIdentifier, passcode string, ctx context.Context) (*ent.Member, error) {
 	member, err := s.client.Member.Query().
 		Where(member.UniqueIdentifier(uniqueIdentifier)).
@@ -315,27 +438,168 @@ func (s *service) IsMemberIdentifierAvailable(questionnaireID uuid.UUID, uniqueI
 	return count == 0, nil
 }
 
-func (s *service) CreateNewQuestion(questionnaireID uuid.UUID, text, theme string, ctx context.Context) (*ent.Question, error) {
-	question, err := s.client.Question.Create().
+// CreateNewQuestion appends a question to the questionnaire, in the given
+// section or outside any section when sectionID is nil
+func (s *service) CreateNewQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ctx context.Context) (*ent.Question, error) {
+	return s.InsertQuestion(questionnaireID, text, sectionID, required, -1, ctx)
+}
+
+// InsertQuestion adds a question at the zero based index, shifting the
+// following questions down. An index out of range appends the question.
+func (s *service) InsertQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, index int, ctx context.Context) (*ent.Question, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// Lock the questionnaire so concurrent inserts do not share a position
+	_, err = tx.Questionnaire.Query().
+		Where(questionnaire.ID(questionnaireID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	count, err := tx.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Count(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if index < 0 || index > count {
+		index = count
+	}
+
+	if err := checkSection(tx, questionnaireID, sectionID, ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	_, err = tx.Question.Update().
+		Where(
+			question.HasQuestionnaireWith(questionnaire.ID(questionnaireID)),
+			question.PositionGTE(index),
+		).
+		AddPosition(1).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	created, err := tx.Question.Create().
 		SetQuestionnaireID(questionnaireID).
 		SetText(text).
-		SetTheme(theme).
+		SetNillableSectionID(sectionID).
+		SetRequired(required).
+		SetPosition(index).
 		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return created, tx.Commit()
+}
+
+// ReorderQuestions rewrites the positions of the questions of a questionnaire
+// in one transaction. questionIDs must list every question exactly once.
+func (s *service) ReorderQuestions(questionnaireID uuid.UUID, questionIDs []uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return question, nil
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	_, err = tx.Questionnaire.Query().
+		Where(questionnaire.ID(questionnaireID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	existing, err := tx.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if !samePermutation(existing, questionIDs) {
+		return nil, rollback(tx, ErrInvalidQuestionOrder)
+	}
+
+	for position, id := range questionIDs {
+		err = tx.Question.UpdateOneID(id).SetPosition(position).Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	questions, err := tx.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Order(ent.Asc(question.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return questions, tx.Commit()
+}
+
+// samePermutation tells whether ordered lists every id of existing once
+func samePermutation(existing, ordered []uuid.UUID) bool {
+	if len(existing) != len(ordered) {
+		return false
+	}
+	remaining := make(map[uuid.UUID]bool, len(existing))
+	for _, id := range existing {
+		remaining[id] = true
+	}
+	for _, id := range ordered {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
 }
 
-func (s *service) UpdateQuestionnaire(questionnaireID uuid.UUID, title, description string, ctx context.Context) (*ent.Questionnaire, error) {
-	questionnaire, err := s.client.Questionnaire.UpdateOneID(questionnaireID).
+// UpdateQuestionnaire edits a questionnaire and increments its version. With
+// ifVersion other than 0 the edit only applies to that version, otherwise it
+// returns ErrVersionMismatch.
+func (s *service) UpdateQuestionnaire(questionnaireID uuid.UUID, title, description string, lockAfterSubmit bool, ifVersion int, ctx context.Context) (*ent.Questionnaire, error) {
+	update := s.client.Questionnaire.UpdateOneID(questionnaireID).
 		SetTitle(title).
 		SetDescription(description).
-		Save(ctx)
+		SetLockAfterSubmit(lockAfterSubmit).
+		AddVersion(1)
+	if ifVersion != 0 {
+		update.Where(questionnaire.Version(ifVersion))
+	}
+	updated, err := update.Save(ctx)
+	if ent.IsNotFound(err) && ifVersion != 0 {
+		exists, xerr := s.client.Questionnaire.Query().Where(questionnaire.ID(questionnaireID)).Exist(ctx)
+		if xerr != nil {
+			return nil, xerr
+		}
+		if exists {
+			return nil, ErrVersionMismatch
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
-	return questionnaire, nil
+	return updated, nil
 }
 
 func (s *service) PublishQuestionnaire(questionnaireID, userID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
@@ -352,6 +616,7 @@ func (s *service) PublishQuestionnaire(questionnaireID, userID uuid.UUID, ctx co
 
 	updatedQuestionnaire, err := tx.Questionnaire.UpdateOneID(questionnaireID).
 		SetIsPublished(true).
+		AddVersion(1).
 		Save(ctx)
 	if err != nil {
 		return nil, rollback(tx, fmt.Errorf("failed to update questionnaire: %w", err))
@@ -399,6 +664,10 @@ func (s *service) PublishQuestionnaire(questionnaireID, userID uuid.UUID, ctx co
 	} else if err != nil {
 		return nil, rollback(tx, fmt.Errorf("failed to query existing member: %w", err))
 	}
+	err = enqueueEvent(tx.Client(), questionnaireID, EventQuestionnairePublished, questionnaireEvent(updatedQuestionnaire), ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to queue webhook deliveries: %w", err))
+	}
 	if err = tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -406,6 +675,52 @@ func (s *service) PublishQuestionnaire(questionnaireID, userID uuid.UUID, ctx co
 	return updatedQuestionnaire, nil
 }
 
+func (s *service) UnpublishQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	return s.client.Questionnaire.UpdateOneID(questionnaireID).
+		SetIsPublished(false).
+		AddVersion(1).
+		Save(ctx)
+}
+
+// CloseQuestionnaire closes the questionnaire: it takes no more members,
+// answers or submissions, and the answers given so far are final. Closing
+// a closed questionnaire returns ErrQuestionnaireClosed.
+func (s *service) CloseQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	n, err := tx.Questionnaire.Update().
+		Where(questionnaire.ID(questionnaireID), questionnaire.ClosedAtIsNil()).
+		SetClosedAt(time.Now().UnixMilli()).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if n == 0 {
+		if _, err := tx.Questionnaire.Get(ctx, questionnaireID); err != nil {
+			return nil, rollback(tx, err)
+		}
+		return nil, rollback(tx, ErrQuestionnaireClosed)
+	}
+	closed, err := tx.Questionnaire.Get(ctx, questionnaireID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := enqueueEvent(tx.Client(), questionnaireID, EventQuestionnaireClosed, questionnaireEvent(closed), ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	return closed, tx.Commit()
+}
+
 func (s *service) DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) error {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
@@ -424,6 +739,12 @@ func (s *service) DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Con
 	if err != nil {
 		return rollback(tx, err)
 	}
+	_, err = tx.Rule.Delete().
+		Where(rule.HasQuestionWith(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID)))).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
 	_, err = tx.Question.Delete().
 		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
 		Exec(ctx)
@@ -431,6 +752,13 @@ func (s *service) DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Con
 		return rollback(tx, err)
 	}
 
+	_, err = tx.Section.Delete().
+		Where(section.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
 	_, err = tx.Member.Delete().
 		Where(member.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
 		Exec(ctx)
@@ -438,6 +766,19 @@ func (s *service) DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Con
 		return rollback(tx, err)
 	}
 
+	_, err = tx.WebhookDelivery.Delete().
+		Where(webhookdelivery.HasWebhookWith(webhook.HasQuestionnaireWith(questionnaire.ID(questionnaireID)))).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	_, err = tx.Webhook.Delete().
+		Where(webhook.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
 	err = tx.Questionnaire.DeleteOneID(questionnaireID).Exec(ctx)
 	if err != nil {
 		return rollback(tx, err)
@@ -446,15 +787,49 @@ func (s *service) DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Con
 	return tx.Commit()
 }
 
-func (s *service) UpdateQuestion(questionID uuid.UUID, text, theme string, ctx context.Context) (*ent.Question, error) {
-	question, err := s.client.Question.UpdateOneID(questionID).
-		SetText(text).
-		SetTheme(theme).
-		Save(ctx)
+// UpdateQuestion sets the text, the section and the required flag of a
+// question and increments its version. A nil sectionID moves the question out
+// of its section. With ifVersion other than 0 the edit only applies to that
+// version, otherwise it returns ErrVersionMismatch.
+func (s *service) UpdateQuestion(questionID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ifVersion int, ctx context.Context) (*ent.Question, error) {
+	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return question, nil
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	existing, err := tx.Question.Query().
+		Where(question.ID(questionID)).
+		WithQuestionnaire().
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if ifVersion != 0 && existing.Version != ifVersion {
+		return nil, rollback(tx, ErrVersionMismatch)
+	}
+	if err := checkSection(tx, existing.Edges.Questionnaire.ID, sectionID, ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	update := tx.Question.UpdateOneID(questionID).SetText(text).SetRequired(required).AddVersion(1)
+	if sectionID != nil {
+		update.SetSectionID(*sectionID)
+	} else {
+		update.ClearSection()
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return updated, tx.Commit()
 }
 
 func (s *service) DeleteQuestion(questionID uuid.UUID, ctx context.Context) error {
@@ -469,6 +844,14 @@ func (s *service) DeleteQuestion(questionID uuid.UUID, ctx context.Context) erro
 		}
 	}()
 
+	deleted, err := tx.Question.Query().
+		Where(question.ID(questionID)).
+		WithQuestionnaire().
+		Only(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
 	_, err = tx.Answer.Delete().
 		Where(answer.HasQuestionWith(question.ID(questionID))).
 		Exec(ctx)
@@ -476,11 +859,33 @@ func (s *service) DeleteQuestion(questionID uuid.UUID, ctx context.Context) erro
 		return rollback(tx, err)
 	}
 
+	_, err = tx.Rule.Delete().
+		Where(rule.Or(
+			rule.HasQuestionWith(question.ID(questionID)),
+			rule.HasSourceWith(question.ID(questionID)),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
 	err = tx.Question.DeleteOneID(questionID).Exec(ctx)
 	if err != nil {
 		return rollback(tx, err)
 	}
 
+	// Close the gap left in the positions
+	_, err = tx.Question.Update().
+		Where(
+			question.HasQuestionnaireWith(questionnaire.ID(deleted.Edges.Questionnaire.ID)),
+			question.PositionGT(deleted.Position),
+		).
+		AddPosition(-1).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
 	return tx.Commit()
 }
 
@@ -507,36 +912,59 @@ func (s *service) GetMemberByUserAndQuestionnaire(userID, questionnaireID uuid.U
 		Only(ctx)
 }
 
+// CreateAnswer records the answer of a member, replacing their previous
+// answer to the question. It returns ErrQuestionHidden when the branching
+// rules hide the question from the member, and ErrAnswersLocked when the
+// member submitted a questionnaire that locks answers. Otherwise changing an
+// answer after submitting reopens the submission.
 func (s *service) CreateAnswer(memberID, questionID uuid.UUID, answerValue string, ctx context.Context) (*ent.Answer, error) {
-	existingAnswer, err := s.client.Answer.Query().
-		Where(
-			answer.HasMemberWith(member.ID(memberID)),
-			answer.HasQuestionWith(question.ID(questionID)),
-		).Only(ctx)
-
-	if err == nil {
-		return existingAnswer.Update().
-			SetAnswerValue(answer.AnswerValue(answerValue)).
-			SetUpdatedAt(time.Now().UnixMilli()).
-			Save(ctx)
-	}
-
-	if !ent.IsNotFound(err) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
 
-	return s.client.Answer.Create().
-		SetMemberID(memberID).
-		SetQuestionID(questionID).
-		SetAnswerValue(answer.AnswerValue(answerValue)).
-		Save(ctx)
-}
+	// Lock the member so the answer and a submission do not interleave: the
+	// checks below stay true until the answer is saved
+	m, err := tx.Member.Query().
+		Where(member.ID(memberID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	q, err := tx.Questionnaire.Query().
+		Where(questionnaire.HasMembersWith(member.ID(memberID))).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if q.ClosedAt != nil {
+		return nil, rollback(tx, ErrQuestionnaireClosed)
+	}
+	if m.CompletedAt != nil && q.LockAfterSubmit {
+		return nil, rollback(tx, ErrAnswersLocked)
+	}
+	if err := checkVisible(tx.Client(), memberID, q.ID, questionID, ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
 
-func (s *service) GetUserQuestionnaires(userID uuid.UUID, ctx context.Context) ([]*ent.Questionnaire, error) {
-	return s.client.Questionnaire.Query().
-		Where(questionnaire.HasOwnerWith(user.ID(userID))).
-		Order(ent.Desc("created_at")).
-		All(ctx)
+	// Changing an answer reopens the submission
+	if m.CompletedAt != nil {
+		if err := tx.Member.UpdateOneID(memberID).ClearCompletedAt().Exec(ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	saved, err := saveAnswer(tx.Answer, memberID, questionID, answer.AnswerValue(answerValue), ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	return saved, tx.Commit()
 }
 
 func (s *service) GetQuestionnaireWithDetails(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
@@ -546,7 +974,12 @@ func (s *service) GetQuestionnaireWithDetails(questionnaireID uuid.UUID, ctx con
 		WithMembers(func(q *ent.MemberQuery) {
 			q.WithUser()
 		}).
+		WithSections(func(q *ent.SectionQuery) {
+			q.Order(ent.Asc(section.FieldPosition))
+		}).
 		WithQuestions(func(q *ent.QuestionQuery) {
+			q.Order(ent.Asc(question.FieldPosition))
+			q.WithSection()
 			q.WithAnswers(func(a *ent.AnswerQuery) {
 				a.WithMember()
 			})
@@ -557,37 +990,6 @@ func (s *service) GetQuestionnaireWithDetails(questionnaireID uuid.UUID, ctx con
 func (s *service) GetQuestionnaireQuestions(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
 	return s.client.Question.Query().
 		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
-		Order(ent.Asc("created_at")).
-		All(ctx)
-}
-
-func (s *service) GetQuestionnaireQuestionsWithAnswers(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
-	return s.client.Question.Query().
-		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
-		WithAnswers(func(q *ent.AnswerQuery) {
-			q.WithMember()
-		}).
-		Order(ent.Asc("created_at")).
-		All(ctx)
-}
-
-func (s *service) GetQuestionnaireMembers(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Member, error) {
-	return s.client.Member.Query().
-		Where(member.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
-		WithUser().
-		Order(ent.Asc("created_at")).
-		All(ctx)
-}
-
-func (s *service) GetMemberAnswers(memberID, questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Answer, error) {
-	return s.client.Answer.Query().
-		Where(
-			answer.HasMemberWith(member.ID(memberID)),
-			answer.HasQuestionWith(
-				question.HasQuestionnaireWith(questionnaire.ID(questionnaireID)),
-			),
-		).
-		WithQuestion().
-		Order(ent.Asc("created_at")).
+		Order(ent.Asc(question.FieldPosition)).
 		All(ctx)
 }
@@ -3,14 +3,22 @@ package database
 import (
 	"context"
 	"log"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"radgifa/ent"
+	"radgifa/internal/config"
+
+	"github.com/google/uuid"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
+var testConfig = config.Default()
+
 func mustStartPostgresContainer() (func(context.Context, ...testcontainers.TerminateOption) error, error) {
 	var (
 		dbName = "database"
@@ -33,9 +41,9 @@ func mustStartPostgresContainer() (func(context.Context, ...testcontainers.Termi
 		return nil, err
 	}
 
-	database = dbName
-	password = dbPwd
-	username = dbUser
+	testConfig.Database.Name = dbName
+	testConfig.Database.Password = dbPwd
+	testConfig.Database.Username = dbUser
 
 	dbHost, err := dbContainer.Host(context.Background())
 	if err != nil {
@@ -47,8 +55,8 @@ func mustStartPostgresContainer() (func(context.Context, ...testcontainers.Termi
 		return dbContainer.Terminate, err
 	}
 
-	host = dbHost
-	port = dbPort.Port()
+	testConfig.Database.Host = dbHost
+	testConfig.Database.Port, err = strconv.Atoi(dbPort.Port())
 
 	return dbContainer.Terminate, err
 }
@@ -66,15 +74,78 @@ func TestMain(m *testing.M) {
 	}
 }
 
-func TestNew(t *testing.T) {
-	srv := New()
+func mustOpen(t *testing.T) Service {
+	t.Helper()
+	srv, err := Open(&testConfig)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return srv
+}
+
+// fixture creates the rows a test builds on and fails the test when one
+// cannot be created. Usernames and member identifiers get a random suffix, so
+// tests sharing the container do not collide.
+type fixture struct {
+	t   *testing.T
+	srv Service
+	ctx context.Context
+}
+
+func newFixture(t *testing.T, srv Service) *fixture {
+	return &fixture{t: t, srv: srv, ctx: context.Background()}
+}
+
+func (f *fixture) user(name string) *ent.User {
+	f.t.Helper()
+	user, err := f.srv.CreateUser(name, name, uniqueName(name), "password123", f.ctx)
+	if err != nil {
+		f.t.Fatalf("CreateUser() error = %v", err)
+	}
+	return user
+}
+
+func (f *fixture) questionnaire(ownerID uuid.UUID, title string, lockAfterSubmit bool) *ent.Questionnaire {
+	f.t.Helper()
+	q, err := f.srv.CreateQuestionnaire(ownerID, title, "", lockAfterSubmit, f.ctx)
+	if err != nil {
+		f.t.Fatalf("CreateQuestionnaire() error = %v", err)
+	}
+	return q
+}
+
+func (f *fixture) question(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool) *ent.Question {
+	f.t.Helper()
+	question, err := f.srv.CreateNewQuestion(questionnaireID, text, sectionID, required, f.ctx)
+	if err != nil {
+		f.t.Fatalf("CreateNewQuestion() error = %v", err)
+	}
+	return question
+}
+
+func (f *fixture) member(userID, questionnaireID uuid.UUID, name string) *ent.Member {
+	f.t.Helper()
+	m, err := f.srv.CreateMember(userID, questionnaireID, uniqueName(name), name, f.ctx)
+	if err != nil {
+		f.t.Fatalf("CreateMember() error = %v", err)
+	}
+	return m
+}
+
+// uniqueName lowercases name and appends a random suffix
+func uniqueName(name string) string {
+	return strings.ToLower(name) + "_" + uuid.NewString()[:8]
+}
+
+func TestOpen(t *testing.T) {
+	srv := mustOpen(t)
 	if srv == nil {
-		t.Fatal("New() returned nil")
+		t.Fatal("Open() returned nil")
 	}
 }
 
 func TestHealth(t *testing.T) {
-	srv := New()
+	srv := mustOpen(t)
 
 	stats := srv.Health()
 
@@ -92,7 +163,7 @@ func TestHealth(t *testing.T) {
 }
 
 func TestClose(t *testing.T) {
-	srv := New()
+	srv := mustOpen(t)
 
 	if srv.Close() != nil {
 		t.Fatalf("expected Close() to return nil")
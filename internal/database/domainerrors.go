@@ -0,0 +1,303 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+)
+
+// domainErrorService wraps a Service so every error it returns goes through
+// domainError, the one place the storage errors are translated
+type domainErrorService struct {
+	Service
+}
+
+// withDomainErrors returns a Service whose errors are domain errors
+func withDomainErrors(s Service) Service {
+	return &domainErrorService{Service: s}
+}
+
+func (e *domainErrorService) CreateUser(name, displayName, username, password string, ctx context.Context) (*ent.User, error) {
+	res, err := e.Service.CreateUser(name, displayName, username, password, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ValidateUserCredentials(username, password string, ctx context.Context) (*ent.User, error) {
+	res, err := e.Service.ValidateUserCredentials(username, password, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) IsUsernameAvailable(username string, ctx context.Context) (bool, error) {
+	res, err := e.Service.IsUsernameAvailable(username, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetUserByUsername(username string, ctx context.Context) (*ent.User, error) {
+	res, err := e.Service.GetUserByUsername(username, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) UpdateUserPassword(userID uuid.UUID, password string, ctx context.Context) error {
+	return domainError(e.Service.UpdateUserPassword(userID, password, ctx))
+}
+
+func (e *domainErrorService) CreateQuestionnaire(userID uuid.UUID, title, description string, lockAfterSubmit bool, ctx context.Context) (*ent.Questionnaire, error) {
+	res, err := e.Service.CreateQuestionnaire(userID, title, description, lockAfterSubmit, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	res, err := e.Service.GetQuestionnaire(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateMember(userID, questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, error) {
+	res, err := e.Service.CreateMember(userID, questionnaireID, uniqueIdentifier, displayName, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateAnonymousMember(questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, string, error) {
+	member, passcode, err := e.Service.CreateAnonymousMember(questionnaireID, uniqueIdentifier, displayName, ctx)
+	return member, passcode, domainError(err)
+}
+
+func (e *domainErrorService) ValidateMemberCredentials(uniqueIdentifier, passcode string, ctx context.Context) (*ent.Member, error) {
+	res, err := e.Service.ValidateMemberCredentials(uniqueIdentifier, passcode, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetMemberWithQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	res, err := e.Service.GetMemberWithQuestionnaire(memberID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) IsMemberIdentifierAvailable(questionnaireID uuid.UUID, uniqueIdentifier string, ctx context.Context) (bool, error) {
+	res, err := e.Service.IsMemberIdentifierAvailable(questionnaireID, uniqueIdentifier, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateNewQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ctx context.Context) (*ent.Question, error) {
+	res, err := e.Service.CreateNewQuestion(questionnaireID, text, sectionID, required, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) UpdateQuestionnaire(questionnaireID uuid.UUID, title, description string, lockAfterSubmit bool, ifVersion int, ctx context.Context) (*ent.Questionnaire, error) {
+	res, err := e.Service.UpdateQuestionnaire(questionnaireID, title, description, lockAfterSubmit, ifVersion, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) PublishQuestionnaire(questionnaireID, userID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	res, err := e.Service.PublishQuestionnaire(questionnaireID, userID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) UnpublishQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	res, err := e.Service.UnpublishQuestionnaire(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CloseQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	res, err := e.Service.CloseQuestionnaire(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) error {
+	return domainError(e.Service.DeleteQuestionnaire(questionnaireID, ctx))
+}
+
+func (e *domainErrorService) UpdateQuestion(questionID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ifVersion int, ctx context.Context) (*ent.Question, error) {
+	res, err := e.Service.UpdateQuestion(questionID, text, sectionID, required, ifVersion, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) DeleteQuestion(questionID uuid.UUID, ctx context.Context) error {
+	return domainError(e.Service.DeleteQuestion(questionID, ctx))
+}
+
+func (e *domainErrorService) GetQuestionWithQuestionnaire(questionID uuid.UUID, ctx context.Context) (*ent.Question, error) {
+	res, err := e.Service.GetQuestionWithQuestionnaire(questionID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetMemberByUserAndQuestionnaire(userID, questionnaireID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	res, err := e.Service.GetMemberByUserAndQuestionnaire(userID, questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateAnswer(memberID, questionID uuid.UUID, answerValue string, ctx context.Context) (*ent.Answer, error) {
+	res, err := e.Service.CreateAnswer(memberID, questionID, answerValue, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateAnswers(memberID uuid.UUID, answers []NewAnswer, ctx context.Context) ([]*ent.Answer, error) {
+	res, err := e.Service.CreateAnswers(memberID, answers, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetQuestionnaireWithDetails(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	res, err := e.Service.GetQuestionnaireWithDetails(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetQuestionnaireQuestions(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	res, err := e.Service.GetQuestionnaireQuestions(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ListUserQuestionnaires(userID uuid.UUID, filter QuestionnaireFilter, req PageRequest, ctx context.Context) (Page[*ent.Questionnaire], error) {
+	res, err := e.Service.ListUserQuestionnaires(userID, filter, req, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ListQuestionnaireQuestions(questionnaireID uuid.UUID, filter QuestionFilter, req PageRequest, ctx context.Context) (Page[*ent.Question], error) {
+	res, err := e.Service.ListQuestionnaireQuestions(questionnaireID, filter, req, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ListQuestionnaireMembers(questionnaireID uuid.UUID, filter MemberFilter, req PageRequest, ctx context.Context) (Page[*ent.Member], error) {
+	res, err := e.Service.ListQuestionnaireMembers(questionnaireID, filter, req, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ListMemberAnswers(memberID, questionnaireID uuid.UUID, filter AnswerFilter, req PageRequest, ctx context.Context) (Page[*ent.Answer], error) {
+	res, err := e.Service.ListMemberAnswers(memberID, questionnaireID, filter, req, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ListUserMemberships(userID uuid.UUID, filter MembershipFilter, req PageRequest, ctx context.Context) (Page[Membership], error) {
+	res, err := e.Service.ListUserMemberships(userID, filter, req, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) InsertQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, index int, ctx context.Context) (*ent.Question, error) {
+	res, err := e.Service.InsertQuestion(questionnaireID, text, sectionID, required, index, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ReorderQuestions(questionnaireID uuid.UUID, questionIDs []uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	res, err := e.Service.ReorderQuestions(questionnaireID, questionIDs, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateSection(questionnaireID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error) {
+	res, err := e.Service.CreateSection(questionnaireID, title, description, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetSectionWithQuestionnaire(sectionID uuid.UUID, ctx context.Context) (*ent.Section, error) {
+	res, err := e.Service.GetSectionWithQuestionnaire(sectionID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetQuestionnaireSections(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Section, error) {
+	res, err := e.Service.GetQuestionnaireSections(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) UpdateSection(sectionID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error) {
+	res, err := e.Service.UpdateSection(sectionID, title, description, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) DeleteSection(sectionID uuid.UUID, ctx context.Context) error {
+	return domainError(e.Service.DeleteSection(sectionID, ctx))
+}
+
+func (e *domainErrorService) ReorderSections(questionnaireID uuid.UUID, sectionIDs []uuid.UUID, ctx context.Context) ([]*ent.Section, error) {
+	res, err := e.Service.ReorderSections(questionnaireID, sectionIDs, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetQuestionnaireCompletion(questionnaireID uuid.UUID, ctx context.Context) (Completion, error) {
+	res, err := e.Service.GetQuestionnaireCompletion(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateRule(questionID, sourceID uuid.UUID, answerValues []string, ctx context.Context) (*ent.Rule, error) {
+	res, err := e.Service.CreateRule(questionID, sourceID, answerValues, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetRuleWithQuestion(ruleID uuid.UUID, ctx context.Context) (*ent.Rule, error) {
+	res, err := e.Service.GetRuleWithQuestion(ruleID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetQuestionRules(questionID uuid.UUID, ctx context.Context) ([]*ent.Rule, error) {
+	res, err := e.Service.GetQuestionRules(questionID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) DeleteRule(ruleID uuid.UUID, ctx context.Context) error {
+	return domainError(e.Service.DeleteRule(ruleID, ctx))
+}
+
+func (e *domainErrorService) GetVisibleQuestions(memberID, questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	res, err := e.Service.GetVisibleQuestions(memberID, questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) SubmitQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	res, err := e.Service.SubmitQuestionnaire(memberID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateAccessToken(userID uuid.UUID, name string, scopes []string, expiresAt *int64, ctx context.Context) (*ent.AccessToken, string, error) {
+	res, token, err := e.Service.CreateAccessToken(userID, name, scopes, expiresAt, ctx)
+	return res, token, domainError(err)
+}
+
+func (e *domainErrorService) ListAccessTokens(userID uuid.UUID, ctx context.Context) ([]*ent.AccessToken, error) {
+	res, err := e.Service.ListAccessTokens(userID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) RevokeAccessToken(userID, tokenID uuid.UUID, ctx context.Context) error {
+	return domainError(e.Service.RevokeAccessToken(userID, tokenID, ctx))
+}
+
+func (e *domainErrorService) AuthenticateAccessToken(token string, ctx context.Context) (*ent.AccessToken, error) {
+	res, err := e.Service.AuthenticateAccessToken(token, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) CreateWebhook(questionnaireID uuid.UUID, url string, events []string, ctx context.Context) (*ent.Webhook, error) {
+	res, err := e.Service.CreateWebhook(questionnaireID, url, events, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ListWebhooks(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Webhook, error) {
+	res, err := e.Service.ListWebhooks(questionnaireID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) GetWebhookWithQuestionnaire(webhookID uuid.UUID, ctx context.Context) (*ent.Webhook, error) {
+	res, err := e.Service.GetWebhookWithQuestionnaire(webhookID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) DeleteWebhook(webhookID uuid.UUID, ctx context.Context) error {
+	return domainError(e.Service.DeleteWebhook(webhookID, ctx))
+}
+
+func (e *domainErrorService) ListWebhookDeliveries(webhookID uuid.UUID, req PageRequest, ctx context.Context) (Page[*ent.WebhookDelivery], error) {
+	res, err := e.Service.ListWebhookDeliveries(webhookID, req, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) RedeliverWebhookDelivery(webhookID, deliveryID uuid.UUID, ctx context.Context) (*ent.WebhookDelivery, error) {
+	res, err := e.Service.RedeliverWebhookDelivery(webhookID, deliveryID, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) ClaimWebhookDeliveries(limit int, lease time.Duration, ctx context.Context) ([]*ent.WebhookDelivery, error) {
+	res, err := e.Service.ClaimWebhookDeliveries(limit, lease, ctx)
+	return res, domainError(err)
+}
+
+func (e *domainErrorService) RecordWebhookAttempt(deliveryID uuid.UUID, attempt WebhookAttempt, ctx context.Context) error {
+	return domainError(e.Service.RecordWebhookAttempt(deliveryID, attempt, ctx))
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"errors"
+	"strings"
+
+	"radgifa/ent"
+)
+
+// Kinds of domain errors. Every error of the service a caller can act on
+// matches one of them with errors.Is, next to its own sentinel, so callers
+// that only care about the kind need not know every sentinel.
+var (
+	ErrNotFound  = errors.New("not found")
+	ErrConflict  = errors.New("conflict")
+	ErrForbidden = errors.New("forbidden")
+	ErrInvalid   = errors.New("invalid")
+)
+
+// Error is a domain error: the kind it belongs to, a stable code clients can
+// switch on and a message. Errors wrapping one are matched by both the Error
+// itself and its kind.
+type Error struct {
+	Kind    error
+	Code    string
+	Message string
+
+	err error
+}
+
+func newError(kind error, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+// Unwrap returns the storage error an Error was made from, if any, so that
+// ent.IsNotFound and the like keep working
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// domainError turns the storage errors callers care about, a missing row or
+// a violated constraint, into domain errors. Other errors are returned as is.
+func domainError(err error) error {
+	var domain *Error
+	switch {
+	case err == nil || errors.As(err, &domain):
+		return err
+	case ent.IsNotFound(err):
+		return &Error{Kind: ErrNotFound, Code: "not_found", Message: strings.TrimPrefix(err.Error(), "ent: "), err: err}
+	case ent.IsConstraintError(err):
+		// The message of a constraint error tells about the schema
+		return &Error{Kind: ErrConflict, Code: "conflict", Message: "conflicts with existing data", err: err}
+	}
+	return err
+}
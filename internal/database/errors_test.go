@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+)
+
+func TestErrorKinds(t *testing.T) {
+	tests := []struct {
+		err  error
+		kind error
+	}{
+		{fmt.Errorf("%w: question answered twice", ErrInvalidAnswers), ErrInvalid},
+		{ErrVersionMismatch, ErrConflict},
+		{ErrAnswersLocked, ErrConflict},
+		{&MissingAnswersError{}, ErrInvalid},
+		{domainError(&ent.NotFoundError{}), ErrNotFound},
+		{domainError(&ent.ConstraintError{}), ErrConflict},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.kind) {
+			t.Errorf("errors.Is(%v, %v) = false", tt.err, tt.kind)
+		}
+	}
+	if !errors.Is(&MissingAnswersError{}, ErrMissingRequiredAnswers) {
+		t.Error("MissingAnswersError does not match ErrMissingRequiredAnswers")
+	}
+	if err := errors.New("connection refused"); domainError(err) != err {
+		t.Errorf("domainError changed %v", err)
+	}
+}
+
+func TestServiceNotFound(t *testing.T) {
+	srv := mustOpen(t)
+
+	_, err := srv.GetQuestionnaire(uuid.New(), context.Background())
+	if !errors.Is(err, ErrNotFound) || !ent.IsNotFound(err) {
+		t.Fatalf("GetQuestionnaire(unknown) error = %v, want ErrNotFound wrapping ent's", err)
+	}
+}
+
+// notFoundService fails the calls the error mapping test makes
+type notFoundService struct {
+	Service
+}
+
+func (notFoundService) CreateAnonymousMember(questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, string, error) {
+	return nil, "", &ent.NotFoundError{}
+}
+
+func (notFoundService) DeleteRule(ruleID uuid.UUID, ctx context.Context) error {
+	return &ent.ConstraintError{}
+}
+
+func TestWithDomainErrors(t *testing.T) {
+	srv := withDomainErrors(notFoundService{})
+	ctx := context.Background()
+
+	if _, _, err := srv.CreateAnonymousMember(uuid.New(), "alice", "Alice", ctx); !errors.Is(err, ErrNotFound) {
+		t.Errorf("CreateAnonymousMember() error = %v, want ErrNotFound", err)
+	}
+	if err := srv.DeleteRule(uuid.New(), ctx); !errors.Is(err, ErrConflict) {
+		t.Errorf("DeleteRule() error = %v, want ErrConflict", err)
+	}
+}
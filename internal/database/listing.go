@@ -0,0 +1,362 @@
+package database
+
+import (
+	"context"
+	"strconv"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+	"radgifa/ent/predicate"
+	"radgifa/ent/question"
+	"radgifa/ent/questionnaire"
+	"radgifa/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// CreatedRange bounds created_at, in Unix milliseconds. After is inclusive,
+// Before is exclusive and zero leaves a side open.
+type CreatedRange struct {
+	After  int64
+	Before int64
+}
+
+// QuestionnaireFilter narrows ListUserQuestionnaires. Title matches a case
+// insensitive substring.
+type QuestionnaireFilter struct {
+	Published *bool
+	Title     string
+	Created   CreatedRange
+}
+
+// QuestionFilter narrows ListQuestionnaireQuestions. Text matches a case
+// insensitive substring.
+type QuestionFilter struct {
+	Text    string
+	Created CreatedRange
+}
+
+// MemberFilter narrows ListQuestionnaireMembers. Answered keeps the members
+// that answered at least one question, or none when false.
+type MemberFilter struct {
+	Answered    *bool
+	DisplayName string
+	Created     CreatedRange
+}
+
+// AnswerFilter narrows ListMemberAnswers
+type AnswerFilter struct {
+	Value   string
+	Created CreatedRange
+}
+
+var questionnaireSortFields = map[string]sortField[*ent.Questionnaire]{
+	"created_at": createdAtField(func(q *ent.Questionnaire) int64 { return q.CreatedAt }),
+	"title":      {column: questionnaire.FieldTitle, value: func(q *ent.Questionnaire) string { return q.Title }},
+}
+
+var questionSortFields = map[string]sortField[*ent.Question]{
+	"position": {
+		column:  question.FieldPosition,
+		numeric: true,
+		value:   func(q *ent.Question) string { return strconv.Itoa(q.Position) },
+	},
+	"created_at": createdAtField(func(q *ent.Question) int64 { return q.CreatedAt }),
+}
+
+var memberSortFields = map[string]sortField[*ent.Member]{
+	"created_at":   createdAtField(func(m *ent.Member) int64 { return m.CreatedAt }),
+	"display_name": {column: member.FieldDisplayName, value: func(m *ent.Member) string { return m.DisplayName }},
+}
+
+var answerSortFields = map[string]sortField[*ent.Answer]{
+	"created_at": createdAtField(func(a *ent.Answer) int64 { return a.CreatedAt }),
+	"updated_at": {
+		column:  answer.FieldUpdatedAt,
+		numeric: true,
+		value:   func(a *ent.Answer) string { return strconv.FormatInt(a.UpdatedAt, 10) },
+	},
+}
+
+func (s *service) ListUserQuestionnaires(userID uuid.UUID, filter QuestionnaireFilter, req PageRequest, ctx context.Context) (Page[*ent.Questionnaire], error) {
+	p, err := newPageQuery(req, questionnaireSortFields, "created_at", "desc")
+	if err != nil {
+		return Page[*ent.Questionnaire]{}, err
+	}
+
+	where := []predicate.Questionnaire{questionnaire.HasOwnerWith(user.ID(userID))}
+	if filter.Published != nil {
+		where = append(where, questionnaire.IsPublished(*filter.Published))
+	}
+	if filter.Title != "" {
+		where = append(where, questionnaire.TitleContainsFold(filter.Title))
+	}
+	if filter.Created.After != 0 {
+		where = append(where, questionnaire.CreatedAtGTE(filter.Created.After))
+	}
+	if filter.Created.Before != 0 {
+		where = append(where, questionnaire.CreatedAtLT(filter.Created.Before))
+	}
+
+	query := s.client.Questionnaire.Query().Where(where...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Page[*ent.Questionnaire]{}, err
+	}
+	items, err := query.
+		Where(p.where).
+		Order(p.orderBy).
+		Limit(p.limit + 1).
+		All(ctx)
+	if err != nil {
+		return Page[*ent.Questionnaire]{}, err
+	}
+	return p.page(items, total, func(q *ent.Questionnaire) uuid.UUID { return q.ID }), nil
+}
+
+// ListQuestionnaireQuestions returns a page of questions with their answers
+// and the members who gave them
+func (s *service) ListQuestionnaireQuestions(questionnaireID uuid.UUID, filter QuestionFilter, req PageRequest, ctx context.Context) (Page[*ent.Question], error) {
+	p, err := newPageQuery(req, questionSortFields, "position", "asc")
+	if err != nil {
+		return Page[*ent.Question]{}, err
+	}
+
+	where := []predicate.Question{question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))}
+	if filter.Text != "" {
+		where = append(where, question.TextContainsFold(filter.Text))
+	}
+	if filter.Created.After != 0 {
+		where = append(where, question.CreatedAtGTE(filter.Created.After))
+	}
+	if filter.Created.Before != 0 {
+		where = append(where, question.CreatedAtLT(filter.Created.Before))
+	}
+
+	query := s.client.Question.Query().Where(where...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Page[*ent.Question]{}, err
+	}
+	items, err := query.
+		Where(p.where).
+		WithSection().
+		WithRules(func(q *ent.RuleQuery) {
+			q.WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) })
+		}).
+		WithAnswers(func(q *ent.AnswerQuery) {
+			q.WithMember()
+		}).
+		Order(p.orderBy).
+		Limit(p.limit + 1).
+		All(ctx)
+	if err != nil {
+		return Page[*ent.Question]{}, err
+	}
+	return p.page(items, total, func(q *ent.Question) uuid.UUID { return q.ID }), nil
+}
+
+func (s *service) ListQuestionnaireMembers(questionnaireID uuid.UUID, filter MemberFilter, req PageRequest, ctx context.Context) (Page[*ent.Member], error) {
+	p, err := newPageQuery(req, memberSortFields, "created_at", "asc")
+	if err != nil {
+		return Page[*ent.Member]{}, err
+	}
+
+	where := []predicate.Member{member.HasQuestionnaireWith(questionnaire.ID(questionnaireID))}
+	if filter.Answered != nil {
+		if *filter.Answered {
+			where = append(where, member.HasAnswers())
+		} else {
+			where = append(where, member.Not(member.HasAnswers()))
+		}
+	}
+	if filter.DisplayName != "" {
+		where = append(where, member.DisplayNameContainsFold(filter.DisplayName))
+	}
+	if filter.Created.After != 0 {
+		where = append(where, member.CreatedAtGTE(filter.Created.After))
+	}
+	if filter.Created.Before != 0 {
+		where = append(where, member.CreatedAtLT(filter.Created.Before))
+	}
+
+	query := s.client.Member.Query().Where(where...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Page[*ent.Member]{}, err
+	}
+	items, err := query.
+		Where(p.where).
+		WithUser().
+		Order(p.orderBy).
+		Limit(p.limit + 1).
+		All(ctx)
+	if err != nil {
+		return Page[*ent.Member]{}, err
+	}
+	return p.page(items, total, func(m *ent.Member) uuid.UUID { return m.ID }), nil
+}
+
+func (s *service) ListMemberAnswers(memberID, questionnaireID uuid.UUID, filter AnswerFilter, req PageRequest, ctx context.Context) (Page[*ent.Answer], error) {
+	p, err := newPageQuery(req, answerSortFields, "created_at", "asc")
+	if err != nil {
+		return Page[*ent.Answer]{}, err
+	}
+
+	where := []predicate.Answer{
+		answer.HasMemberWith(member.ID(memberID)),
+		answer.HasQuestionWith(
+			question.HasQuestionnaireWith(questionnaire.ID(questionnaireID)),
+		),
+	}
+	if filter.Value != "" {
+		where = append(where, answer.AnswerValueEQ(answer.AnswerValue(filter.Value)))
+	}
+	if filter.Created.After != 0 {
+		where = append(where, answer.CreatedAtGTE(filter.Created.After))
+	}
+	if filter.Created.Before != 0 {
+		where = append(where, answer.CreatedAtLT(filter.Created.Before))
+	}
+
+	query := s.client.Answer.Query().Where(where...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Page[*ent.Answer]{}, err
+	}
+	items, err := query.
+		Where(p.where).
+		WithQuestion().
+		Order(p.orderBy).
+		Limit(p.limit + 1).
+		All(ctx)
+	if err != nil {
+		return Page[*ent.Answer]{}, err
+	}
+	return p.page(items, total, func(a *ent.Answer) uuid.UUID { return a.ID }), nil
+}
+
+// Membership is a questionnaire a user joined, with how far they got. Both
+// counts leave out the questions the rules hide from the member.
+type Membership struct {
+	// Member has its Questionnaire edge loaded
+	Member        *ent.Member
+	AnsweredCount int
+	QuestionCount int
+}
+
+// MembershipFilter narrows ListUserMemberships. Title matches a case
+// insensitive substring of the questionnaire title.
+type MembershipFilter struct {
+	Published *bool
+	Title     string
+}
+
+var membershipSortFields = map[string]sortField[*ent.Member]{
+	"created_at": createdAtField(func(m *ent.Member) int64 { return m.CreatedAt }),
+}
+
+// ListUserMemberships returns a page of the questionnaires the user joined,
+// most recent first, with the number of questions they answered
+func (s *service) ListUserMemberships(userID uuid.UUID, filter MembershipFilter, req PageRequest, ctx context.Context) (Page[Membership], error) {
+	p, err := newPageQuery(req, membershipSortFields, "created_at", "desc")
+	if err != nil {
+		return Page[Membership]{}, err
+	}
+
+	questionnaireWhere := []predicate.Questionnaire{}
+	if filter.Published != nil {
+		questionnaireWhere = append(questionnaireWhere, questionnaire.IsPublished(*filter.Published))
+	}
+	if filter.Title != "" {
+		questionnaireWhere = append(questionnaireWhere, questionnaire.TitleContainsFold(filter.Title))
+	}
+	where := []predicate.Member{member.HasUserWith(user.ID(userID))}
+	if len(questionnaireWhere) > 0 {
+		where = append(where, member.HasQuestionnaireWith(questionnaireWhere...))
+	}
+
+	query := s.client.Member.Query().Where(where...)
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Page[Membership]{}, err
+	}
+	members, err := query.
+		Where(p.where).
+		WithQuestionnaire().
+		Order(p.orderBy).
+		Limit(p.limit + 1).
+		All(ctx)
+	if err != nil {
+		return Page[Membership]{}, err
+	}
+	memberPage := p.page(members, total, func(m *ent.Member) uuid.UUID { return m.ID })
+
+	memberIDs := make([]uuid.UUID, len(memberPage.Items))
+	questionnaireIDs := make([]uuid.UUID, len(memberPage.Items))
+	for i, m := range memberPage.Items {
+		memberIDs[i] = m.ID
+		questionnaireIDs[i] = m.Edges.Questionnaire.ID
+	}
+
+	// Rules hide questions per member, so both counts follow their answers
+	questions, err := s.client.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.IDIn(questionnaireIDs...))).
+		WithQuestionnaire(func(q *ent.QuestionnaireQuery) { q.Select(questionnaire.FieldID) }).
+		WithRules(func(q *ent.RuleQuery) {
+			q.WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) })
+		}).
+		All(ctx)
+	if err != nil {
+		return Page[Membership]{}, err
+	}
+	questionsIn := make(map[uuid.UUID][]*ent.Question, len(questionnaireIDs))
+	for _, q := range questions {
+		id := q.Edges.Questionnaire.ID
+		questionsIn[id] = append(questionsIn[id], q)
+	}
+
+	answers, err := s.client.Answer.Query().
+		Where(answer.HasMemberWith(member.IDIn(memberIDs...))).
+		WithMember(func(q *ent.MemberQuery) { q.Select(member.FieldID) }).
+		WithQuestion(func(q *ent.QuestionQuery) { q.Select(question.FieldID) }).
+		All(ctx)
+	if err != nil {
+		return Page[Membership]{}, err
+	}
+	// Keyed by question, so a question answered twice counts once
+	answered := make(map[uuid.UUID]map[uuid.UUID]answer.AnswerValue, len(memberIDs))
+	for _, a := range answers {
+		memberID := a.Edges.Member.ID
+		if answered[memberID] == nil {
+			answered[memberID] = map[uuid.UUID]answer.AnswerValue{}
+		}
+		answered[memberID][a.Edges.Question.ID] = a.AnswerValue
+	}
+
+	page := Page[Membership]{
+		Items:      make([]Membership, len(memberPage.Items)),
+		Total:      memberPage.Total,
+		NextCursor: memberPage.NextCursor,
+		Limit:      memberPage.Limit,
+		Sort:       memberPage.Sort,
+		Order:      memberPage.Order,
+	}
+	for i, m := range memberPage.Items {
+		visible := visibleQuestions(questionsIn[m.Edges.Questionnaire.ID], answered[m.ID])
+		item := Membership{Member: m}
+		for _, shown := range visible {
+			if shown {
+				item.QuestionCount++
+			}
+		}
+		for questionID := range answered[m.ID] {
+			if visible[questionID] {
+				item.AnsweredCount++
+			}
+		}
+		page.Items[i] = item
+	}
+	return page, nil
+}
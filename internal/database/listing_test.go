@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestListUserQuestionnaires(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Lister")
+	for i := range 5 {
+		q := f.questionnaire(owner.ID, fmt.Sprintf("Pizza night %d", i), false)
+		if i%2 == 0 {
+			if err := srv.Client().Questionnaire.UpdateOneID(q.ID).SetIsPublished(true).Exec(ctx); err != nil {
+				t.Fatalf("publishing: %v", err)
+			}
+		}
+	}
+
+	// Walk every page and make sure no questionnaire is skipped or repeated
+	seen := map[uuid.UUID]bool{}
+	req := PageRequest{Limit: 2}
+	for pages := 0; ; pages++ {
+		page, err := srv.ListUserQuestionnaires(owner.ID, QuestionnaireFilter{}, req, ctx)
+		if err != nil {
+			t.Fatalf("ListUserQuestionnaires() error = %v", err)
+		}
+		if page.Total != 5 {
+			t.Errorf("Total = %d, want 5", page.Total)
+		}
+		for _, q := range page.Items {
+			if seen[q.ID] {
+				t.Errorf("questionnaire %s returned twice", q.ID)
+			}
+			seen[q.ID] = true
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		if pages > 5 {
+			t.Fatal("pagination does not terminate")
+		}
+		req.Cursor = page.NextCursor
+	}
+	if len(seen) != 5 {
+		t.Errorf("walked %d questionnaires, want 5", len(seen))
+	}
+
+	published := true
+	page, err := srv.ListUserQuestionnaires(owner.ID, QuestionnaireFilter{Published: &published, Title: "NIGHT"}, PageRequest{Sort: "title", Order: "asc"}, ctx)
+	if err != nil {
+		t.Fatalf("ListUserQuestionnaires() error = %v", err)
+	}
+	if page.Total != 3 || len(page.Items) != 3 || page.Items[0].Title != "Pizza night 0" {
+		t.Errorf("published questionnaires = %d of %d, want 3 sorted by title", len(page.Items), page.Total)
+	}
+
+	_, err = srv.ListUserQuestionnaires(owner.ID, QuestionnaireFilter{}, PageRequest{Sort: "title", Cursor: req.Cursor}, ctx)
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("reusing a cursor with another sort: error = %v, want ErrInvalidCursor", err)
+	}
+	_, err = srv.ListUserQuestionnaires(owner.ID, QuestionnaireFilter{}, PageRequest{Sort: "description"}, ctx)
+	if !errors.Is(err, ErrInvalidSort) {
+		t.Errorf("sorting on description: error = %v, want ErrInvalidSort", err)
+	}
+}
+
+func TestListUserMemberships(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Owner")
+	guest := f.user("Guest")
+	q := f.questionnaire(owner.ID, "Movie night", false)
+	first := f.question(q.ID, "Comedy?", nil, false)
+	f.question(q.ID, "Drama?", nil, false)
+	// Hidden from the guest, who likes comedies
+	serious := f.question(q.ID, "Which drama?", nil, false)
+	if _, err := srv.CreateRule(serious.ID, first.ID, []string{"No"}, ctx); err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+	m, err := srv.CreateMember(guest.ID, q.ID, guest.Username, "Guest", ctx)
+	if err != nil {
+		t.Fatalf("CreateMember() error = %v", err)
+	}
+	if _, err := srv.CreateAnswer(m.ID, first.ID, "Yes", ctx); err != nil {
+		t.Fatalf("CreateAnswer() error = %v", err)
+	}
+
+	page, err := srv.ListUserMemberships(guest.ID, MembershipFilter{}, PageRequest{}, ctx)
+	if err != nil {
+		t.Fatalf("ListUserMemberships() error = %v", err)
+	}
+	if page.Total != 1 || len(page.Items) != 1 {
+		t.Fatalf("got %d of %d memberships, want 1", len(page.Items), page.Total)
+	}
+	got := page.Items[0]
+	if got.Member.Edges.Questionnaire.ID != q.ID || got.AnsweredCount != 1 || got.QuestionCount != 2 {
+		t.Errorf("membership = questionnaire %s, %d of %d answered", got.Member.Edges.Questionnaire.ID, got.AnsweredCount, got.QuestionCount)
+	}
+
+	page, err = srv.ListUserMemberships(owner.ID, MembershipFilter{}, PageRequest{}, ctx)
+	if err != nil {
+		t.Fatalf("ListUserMemberships() error = %v", err)
+	}
+	if page.Total != 0 {
+		t.Errorf("the owner has %d memberships, want 0", page.Total)
+	}
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"radgifa/ent"
+)
+
+// migrate brings the schema up to date and fixes the data of rows created
+// before a column existed. Every step is idempotent.
+func migrate(ctx context.Context, client *ent.Client, db *sql.DB) error {
+	// Before the schema, which adds a unique index duplicates would break
+	if _, err := db.ExecContext(ctx, dedupeAnswers); err != nil {
+		return fmt.Errorf("removing duplicate answers: %w", err)
+	}
+	if err := client.Schema.Create(ctx); err != nil {
+		return err
+	}
+	if _, err := db.ExecContext(ctx, backfillQuestionPositions); err != nil {
+		return fmt.Errorf("backfilling question positions: %w", err)
+	}
+	if err := migrateThemesToSections(ctx, db); err != nil {
+		return fmt.Errorf("moving question themes to sections: %w", err)
+	}
+	return nil
+}
+
+// dedupeAnswers keeps only the latest answer of a member to a question.
+// Older releases could save several under concurrent requests. It does
+// nothing on an empty database, where the table does not exist yet.
+const dedupeAnswers = `
+DO $$
+BEGIN
+	IF to_regclass('answers') IS NOT NULL THEN
+		DELETE FROM answers
+		WHERE id IN (
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (
+					PARTITION BY member_answers, question_answers
+					ORDER BY updated_at DESC, created_at DESC, id DESC
+				) AS rank
+				FROM answers
+			) AS ranked
+			WHERE rank > 1
+		);
+	END IF;
+END
+$$`
+
+// backfillQuestionPositions numbers the questions of every questionnaire
+// whose positions are not distinct, which is the case of questions created
+// before the position column existed. Creation order is kept.
+const backfillQuestionPositions = `
+UPDATE questions AS q
+SET position = ordered.position
+FROM (
+	SELECT id, ROW_NUMBER() OVER (PARTITION BY questionnaire_questions ORDER BY position, created_at, id) - 1 AS position
+	FROM questions
+	WHERE questionnaire_questions IN (
+		SELECT questionnaire_questions
+		FROM questions
+		GROUP BY questionnaire_questions
+		HAVING COUNT(DISTINCT position) < COUNT(*)
+	)
+) AS ordered
+WHERE q.id = ordered.id`
+
+// migrateThemesToSections replaces the free text theme of the questions
+// with sections. Themes differing only in case or surrounding spaces end up
+// in the same section, ordered by their first question. The theme column is
+// dropped once every question is moved, since it is not nullable and Ent no
+// longer sets it.
+func migrateThemesToSections(ctx context.Context, db *sql.DB) error {
+	var hasTheme bool
+	err := db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = 'questions' AND column_name = 'theme'
+		)`).Scan(&hasTheme)
+	if err != nil || !hasTheme {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range []string{createSectionsFromThemes, assignQuestionsToSections, `ALTER TABLE questions DROP COLUMN theme`} {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+const createSectionsFromThemes = `
+INSERT INTO sections (id, title, position, created_at, questionnaire_sections)
+SELECT gen_random_uuid(),
+	LEFT(MIN(BTRIM(theme)), 200),
+	ROW_NUMBER() OVER (PARTITION BY questionnaire_questions ORDER BY MIN(position), LOWER(BTRIM(theme))) - 1,
+	MIN(created_at),
+	questionnaire_questions
+FROM questions
+WHERE BTRIM(theme) <> '' AND section_questions IS NULL
+GROUP BY questionnaire_questions, LOWER(BTRIM(theme))`
+
+const assignQuestionsToSections = `
+UPDATE questions AS q
+SET section_questions = s.id
+FROM sections AS s
+WHERE s.questionnaire_sections = q.questionnaire_questions
+	AND LOWER(s.title) = LEFT(LOWER(BTRIM(q.theme)), 200)
+	AND q.section_questions IS NULL`
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+)
+
+func questionTexts(questions []*ent.Question) []string {
+	texts := make([]string, len(questions))
+	for i, q := range questions {
+		texts[i] = q.Text
+	}
+	return texts
+}
+
+func assertOrder(t *testing.T, questions []*ent.Question, want ...string) {
+	t.Helper()
+	got := questionTexts(questions)
+	if len(got) != len(want) {
+		t.Fatalf("questions = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] || questions[i].Position != i {
+			t.Fatalf("questions = %v, want %v with positions 0..%d", got, want, len(want)-1)
+		}
+	}
+}
+
+func TestQuestionOrdering(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Orderer")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+
+	ids := map[string]uuid.UUID{}
+	for _, text := range []string{"a", "b", "c"} {
+		question := f.question(q.ID, text, nil, false)
+		ids[text] = question.ID
+	}
+
+	inserted, err := srv.InsertQuestion(q.ID, "first", nil, false, 0, ctx)
+	if err != nil {
+		t.Fatalf("InsertQuestion() error = %v", err)
+	}
+	ids["first"] = inserted.ID
+	questions, err := srv.GetQuestionnaireQuestions(q.ID, ctx)
+	if err != nil {
+		t.Fatalf("GetQuestionnaireQuestions() error = %v", err)
+	}
+	assertOrder(t, questions, "first", "a", "b", "c")
+
+	questions, err = srv.ReorderQuestions(q.ID, []uuid.UUID{ids["c"], ids["a"], ids["first"], ids["b"]}, ctx)
+	if err != nil {
+		t.Fatalf("ReorderQuestions() error = %v", err)
+	}
+	assertOrder(t, questions, "c", "a", "first", "b")
+
+	// An incomplete order is rejected and leaves the positions untouched
+	_, err = srv.ReorderQuestions(q.ID, []uuid.UUID{ids["a"], ids["c"], ids["b"]}, ctx)
+	if !errors.Is(err, ErrInvalidQuestionOrder) {
+		t.Fatalf("ReorderQuestions() with a missing question error = %v, want ErrInvalidQuestionOrder", err)
+	}
+	_, err = srv.ReorderQuestions(q.ID, []uuid.UUID{ids["a"], ids["a"], ids["b"], ids["c"]}, ctx)
+	if !errors.Is(err, ErrInvalidQuestionOrder) {
+		t.Fatalf("ReorderQuestions() with a duplicate error = %v, want ErrInvalidQuestionOrder", err)
+	}
+
+	if err := srv.DeleteQuestion(ids["a"], ctx); err != nil {
+		t.Fatalf("DeleteQuestion() error = %v", err)
+	}
+	questions, err = srv.GetQuestionnaireQuestions(q.ID, ctx)
+	if err != nil {
+		t.Fatalf("GetQuestionnaireQuestions() error = %v", err)
+	}
+	assertOrder(t, questions, "c", "first", "b")
+}
+
+func TestSamePermutation(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	existing := []uuid.UUID{a, b, c}
+
+	tests := []struct {
+		name    string
+		ordered []uuid.UUID
+		want    bool
+	}{
+		{"same order", []uuid.UUID{a, b, c}, true},
+		{"permutation", []uuid.UUID{c, a, b}, true},
+		{"missing", []uuid.UUID{a, b}, false},
+		{"duplicate", []uuid.UUID{a, a, b}, false},
+		{"foreign", []uuid.UUID{a, b, uuid.New()}, false},
+	}
+	for _, tt := range tests {
+		if got := samePermutation(existing, tt.ordered); got != tt.want {
+			t.Errorf("%s: samePermutation() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
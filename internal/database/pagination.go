@@ -0,0 +1,175 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"radgifa/ent"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageLimit is used when a PageRequest has no limit
+	DefaultPageLimit = 20
+	// MaxPageLimit caps the size of a page
+	MaxPageLimit = 100
+)
+
+var (
+	ErrInvalidCursor = newError(ErrInvalid, "invalid_cursor", "invalid cursor")
+	ErrInvalidSort   = newError(ErrInvalid, "invalid_sort", "invalid sort")
+)
+
+// PageRequest selects a page of a listing. Cursor is the NextCursor of the
+// previous page and must be used with the same Sort and Order. Empty fields
+// take the defaults of the listing.
+type PageRequest struct {
+	Limit  int
+	Cursor string
+	Sort   string
+	Order  string // "asc" or "desc"
+}
+
+// Page is one page of a listing. Total counts every item matching the
+// filters, and NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T
+	Total      int
+	NextCursor string
+	Limit      int
+	Sort       string
+	Order      string
+}
+
+// sortField is a column a listing can be sorted on. Rows are ordered by the
+// column and then by id, so the order is total and cursors are stable.
+type sortField[T any] struct {
+	column  string
+	numeric bool
+	value   func(T) string
+}
+
+func createdAtField[T any](createdAt func(T) int64) sortField[T] {
+	return sortField[T]{
+		column:  "created_at",
+		numeric: true,
+		value:   func(item T) string { return strconv.FormatInt(createdAt(item), 10) },
+	}
+}
+
+// cursor is the position of the last item of a page
+type cursor struct {
+	Sort  string    `json:"s"`
+	Order string    `json:"o"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// pageQuery is a validated PageRequest for one listing
+type pageQuery[T any] struct {
+	limit int
+	sort  string
+	order string
+	field sortField[T]
+	after *cursor
+}
+
+func newPageQuery[T any](req PageRequest, fields map[string]sortField[T], defaultSort, defaultOrder string) (*pageQuery[T], error) {
+	p := &pageQuery[T]{limit: req.Limit, sort: req.Sort, order: req.Order}
+	if p.limit <= 0 {
+		p.limit = DefaultPageLimit
+	}
+	if p.limit > MaxPageLimit {
+		p.limit = MaxPageLimit
+	}
+	if p.sort == "" {
+		p.sort = defaultSort
+	}
+	if p.order == "" {
+		p.order = defaultOrder
+	}
+
+	field, ok := fields[p.sort]
+	if !ok {
+		return nil, fmt.Errorf("%w: cannot sort on %q", ErrInvalidSort, p.sort)
+	}
+	if p.order != "asc" && p.order != "desc" {
+		return nil, fmt.Errorf("%w: order must be asc or desc", ErrInvalidSort)
+	}
+	p.field = field
+
+	if req.Cursor != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(req.Cursor)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		var c cursor
+		if err := json.Unmarshal(raw, &c); err != nil || c.Sort != p.sort || c.Order != p.order {
+			return nil, ErrInvalidCursor
+		}
+		if field.numeric {
+			if _, err := strconv.ParseInt(c.Value, 10, 64); err != nil {
+				return nil, ErrInvalidCursor
+			}
+		}
+		p.after = &c
+	}
+	return p, nil
+}
+
+// where restricts the query to the rows after the cursor
+func (p *pageQuery[T]) where(s *sql.Selector) {
+	if p.after == nil {
+		return
+	}
+	var value any = p.after.Value
+	if p.field.numeric {
+		value, _ = strconv.ParseInt(p.after.Value, 10, 64)
+	}
+
+	past := sql.GT
+	if p.order == "desc" {
+		past = sql.LT
+	}
+	s.Where(sql.Or(
+		past(s.C(p.field.column), value),
+		sql.And(
+			sql.EQ(s.C(p.field.column), value),
+			past(s.C("id"), p.after.ID),
+		),
+	))
+}
+
+// orderBy sorts on the field, then on id
+func (p *pageQuery[T]) orderBy(s *sql.Selector) {
+	if p.order == "desc" {
+		ent.Desc(p.field.column, "id")(s)
+		return
+	}
+	ent.Asc(p.field.column, "id")(s)
+}
+
+// page builds the page from items fetched with a limit of p.limit+1, the
+// extra item telling whether there is a next page
+func (p *pageQuery[T]) page(items []T, total int, id func(T) uuid.UUID) Page[T] {
+	page := Page[T]{Items: items, Total: total, Limit: p.limit, Sort: p.sort, Order: p.order}
+	if len(items) > p.limit {
+		page.Items = items[:p.limit]
+		last := page.Items[p.limit-1]
+		raw, _ := json.Marshal(cursor{
+			Sort:  p.sort,
+			Order: p.order,
+			Value: p.field.value(last),
+			ID:    id(last),
+		})
+		page.NextCursor = base64.RawURLEncoding.EncodeToString(raw)
+	}
+	if page.Items == nil {
+		page.Items = []T{}
+	}
+	return page
+}
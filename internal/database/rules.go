@@ -0,0 +1,288 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+	"radgifa/ent/question"
+	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidRule is returned by CreateRule, wrapped with the reason
+	ErrInvalidRule = newError(ErrInvalid, "invalid_rule", "invalid rule")
+	// ErrQuestionHidden is returned when a member answers a question their
+	// previous answers hide
+	ErrQuestionHidden = newError(ErrConflict, "question_hidden", "question is hidden by previous answers")
+)
+
+// CreateRule shows questionID only to the members who answered sourceID with
+// one of answerValues. Both questions must be in the same questionnaire and
+// the rule must not make a question depend on itself.
+func (s *service) CreateRule(questionID, sourceID uuid.UUID, answerValues []string, ctx context.Context) (*ent.Rule, error) {
+	if len(answerValues) == 0 {
+		return nil, fmt.Errorf("%w: at least one answer value is required", ErrInvalidRule)
+	}
+	for _, v := range answerValues {
+		if err := answer.AnswerValueValidator(answer.AnswerValue(v)); err != nil {
+			return nil, fmt.Errorf("%w: unknown answer value %q", ErrInvalidRule, v)
+		}
+	}
+	if questionID == sourceID {
+		return nil, fmt.Errorf("%w: a question cannot depend on itself", ErrInvalidRule)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	target, err := tx.Question.Query().
+		Where(question.ID(questionID)).
+		WithQuestionnaire().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	questionnaireID := target.Edges.Questionnaire.ID
+
+	// Lock the questionnaire so two concurrent rules cannot close a cycle
+	_, err = tx.Questionnaire.Query().
+		Where(questionnaire.ID(questionnaireID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	sameQuestionnaire, err := tx.Question.Query().
+		Where(
+			question.ID(sourceID),
+			question.HasQuestionnaireWith(questionnaire.ID(questionnaireID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if !sameQuestionnaire {
+		return nil, rollback(tx, fmt.Errorf("%w: the source question is not in the questionnaire", ErrInvalidRule))
+	}
+
+	rules, err := tx.Rule.Query().
+		Where(rule.HasQuestionWith(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID)))).
+		WithQuestion(func(q *ent.QuestionQuery) { q.Select(question.FieldID) }).
+		WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) }).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if dependsOn(rules, sourceID, questionID) {
+		return nil, rollback(tx, fmt.Errorf("%w: the source question already depends on this question", ErrInvalidRule))
+	}
+
+	created, err := tx.Rule.Create().
+		SetQuestionID(questionID).
+		SetSourceID(sourceID).
+		SetAnswerValues(answerValues).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return created, tx.Commit()
+}
+
+// dependsOn tells whether the visibility of from depends, directly or not,
+// on the answer to to
+func dependsOn(rules []*ent.Rule, from, to uuid.UUID) bool {
+	sources := map[uuid.UUID][]uuid.UUID{}
+	for _, r := range rules {
+		sources[r.Edges.Question.ID] = append(sources[r.Edges.Question.ID], r.Edges.Source.ID)
+	}
+
+	seen := map[uuid.UUID]bool{}
+	pending := []uuid.UUID{from}
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if id == to {
+			return true
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		pending = append(pending, sources[id]...)
+	}
+	return false
+}
+
+func (s *service) GetRuleWithQuestion(ruleID uuid.UUID, ctx context.Context) (*ent.Rule, error) {
+	return s.client.Rule.Query().
+		Where(rule.ID(ruleID)).
+		WithQuestion().
+		Only(ctx)
+}
+
+func (s *service) GetQuestionRules(questionID uuid.UUID, ctx context.Context) ([]*ent.Rule, error) {
+	return s.client.Rule.Query().
+		Where(rule.HasQuestionWith(question.ID(questionID))).
+		WithSource().
+		Order(ent.Asc(rule.FieldCreatedAt), ent.Asc(rule.FieldID)).
+		All(ctx)
+}
+
+func (s *service) DeleteRule(ruleID uuid.UUID, ctx context.Context) error {
+	return s.client.Rule.DeleteOneID(ruleID).Exec(ctx)
+}
+
+// GetVisibleQuestions returns, in order, the questions of the questionnaire
+// the member sees given the answers they gave so far
+func (s *service) GetVisibleQuestions(memberID, questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	questions, err := s.client.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		WithSection().
+		WithRules(func(q *ent.RuleQuery) {
+			q.WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) })
+		}).
+		Order(ent.Asc(question.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := memberAnswers(s.client.Answer, memberID, questionnaireID, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := visibleQuestions(questions, answers)
+	shown := make([]*ent.Question, 0, len(questions))
+	for _, q := range questions {
+		if visible[q.ID] {
+			shown = append(shown, q)
+		}
+	}
+	return shown, nil
+}
+
+// checkVisible returns ErrQuestionHidden when the previous answers of the
+// member hide the question. It takes the client of a transaction when
+// called inside one.
+func checkVisible(client *ent.Client, memberID, questionnaireID, questionID uuid.UUID, ctx context.Context) error {
+	hasRules, err := client.Rule.Query().
+		Where(rule.HasQuestionWith(question.ID(questionID))).
+		Exist(ctx)
+	if err != nil || !hasRules {
+		return err
+	}
+
+	questions, err := client.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		WithRules(func(q *ent.RuleQuery) {
+			q.WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) })
+		}).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	answers, err := memberAnswers(client.Answer, memberID, questionnaireID, ctx)
+	if err != nil {
+		return err
+	}
+	if !visibleQuestions(questions, answers)[questionID] {
+		return ErrQuestionHidden
+	}
+	return nil
+}
+
+// memberAnswers maps the questions the member answered to their answer. It
+// takes the answer client of a transaction when called inside one.
+func memberAnswers(client *ent.AnswerClient, memberID, questionnaireID uuid.UUID, ctx context.Context) (map[uuid.UUID]answer.AnswerValue, error) {
+	answers, err := client.Query().
+		Where(
+			answer.HasMemberWith(member.ID(memberID)),
+			answer.HasQuestionWith(question.HasQuestionnaireWith(questionnaire.ID(questionnaireID))),
+		).
+		WithQuestion(func(q *ent.QuestionQuery) { q.Select(question.FieldID) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byQuestion := make(map[uuid.UUID]answer.AnswerValue, len(answers))
+	for _, a := range answers {
+		byQuestion[a.Edges.Question.ID] = a.AnswerValue
+	}
+	return byQuestion, nil
+}
+
+// visibleQuestions evaluates the rules of the questions, which must be
+// loaded with their source. A question is visible when all its rules hold,
+// and a rule holds when its source question is visible and was answered
+// with one of the rule values. Questions caught in a cycle are hidden.
+func visibleQuestions(questions []*ent.Question, answers map[uuid.UUID]answer.AnswerValue) map[uuid.UUID]bool {
+	const (
+		unknown = iota
+		evaluating
+		shown
+		hidden
+	)
+	byID := make(map[uuid.UUID]*ent.Question, len(questions))
+	for _, q := range questions {
+		byID[q.ID] = q
+	}
+	state := make(map[uuid.UUID]int, len(questions))
+
+	var visible func(id uuid.UUID) bool
+	visible = func(id uuid.UUID) bool {
+		switch state[id] {
+		case shown:
+			return true
+		case hidden, evaluating:
+			return false
+		}
+		q, ok := byID[id]
+		if !ok {
+			return false
+		}
+
+		state[id] = evaluating
+		result := shown
+		for _, r := range q.Edges.Rules {
+			source := r.Edges.Source.ID
+			if !visible(source) || !ruleMatches(r, answers[source]) {
+				result = hidden
+				break
+			}
+		}
+		state[id] = result
+		return result == shown
+	}
+
+	visibility := make(map[uuid.UUID]bool, len(questions))
+	for _, q := range questions {
+		visibility[q.ID] = visible(q.ID)
+	}
+	return visibility
+}
+
+func ruleMatches(r *ent.Rule, value answer.AnswerValue) bool {
+	for _, v := range r.AnswerValues {
+		if answer.AnswerValue(v) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+
+	"github.com/google/uuid"
+)
+
+// ruleOn builds a rule showing its question when source was answered with one of values
+func ruleOn(source *ent.Question, values ...string) *ent.Rule {
+	r := &ent.Rule{ID: uuid.New(), AnswerValues: values}
+	r.Edges.Source = &ent.Question{ID: source.ID}
+	return r
+}
+
+func TestVisibleQuestions(t *testing.T) {
+	a := &ent.Question{ID: uuid.New(), Text: "a"}
+	b := &ent.Question{ID: uuid.New(), Text: "b"}
+	c := &ent.Question{ID: uuid.New(), Text: "c"}
+	d := &ent.Question{ID: uuid.New(), Text: "d"}
+	b.Edges.Rules = []*ent.Rule{ruleOn(a, "Yes")}
+	c.Edges.Rules = []*ent.Rule{ruleOn(b, "Yes", "Pass")}
+	questions := []*ent.Question{a, b, c, d}
+
+	tests := []struct {
+		name    string
+		answers map[uuid.UUID]answer.AnswerValue
+		want    []string
+	}{
+		{"no answers", nil, []string{"a", "d"}},
+		{"a answered yes", map[uuid.UUID]answer.AnswerValue{a.ID: answer.AnswerValueYes}, []string{"a", "b", "d"}},
+		{"chain", map[uuid.UUID]answer.AnswerValue{a.ID: answer.AnswerValueYes, b.ID: answer.AnswerValuePass}, []string{"a", "b", "c", "d"}},
+		// c stays hidden once b is hidden, even with its stale answer
+		{"hidden source", map[uuid.UUID]answer.AnswerValue{a.ID: answer.AnswerValueNo, b.ID: answer.AnswerValueYes}, []string{"a", "d"}},
+	}
+	for _, tt := range tests {
+		visible := visibleQuestions(questions, tt.answers)
+		var got []string
+		for _, q := range questions {
+			if visible[q.ID] {
+				got = append(got, q.Text)
+			}
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: visible = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: visible = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestVisibleQuestionsCycle(t *testing.T) {
+	a := &ent.Question{ID: uuid.New()}
+	b := &ent.Question{ID: uuid.New()}
+	a.Edges.Rules = []*ent.Rule{ruleOn(b, "Yes")}
+	b.Edges.Rules = []*ent.Rule{ruleOn(a, "Yes")}
+
+	answers := map[uuid.UUID]answer.AnswerValue{a.ID: answer.AnswerValueYes, b.ID: answer.AnswerValueYes}
+	visible := visibleQuestions([]*ent.Question{a, b}, answers)
+	if visible[a.ID] || visible[b.ID] {
+		t.Errorf("questions in a cycle are visible: %v", visible)
+	}
+}
+
+func TestDependsOn(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	link := func(question, source uuid.UUID) *ent.Rule {
+		r := &ent.Rule{}
+		r.Edges.Question = &ent.Question{ID: question}
+		r.Edges.Source = &ent.Question{ID: source}
+		return r
+	}
+	// c depends on b, which depends on a
+	rules := []*ent.Rule{link(b, a), link(c, b)}
+
+	if !dependsOn(rules, c, a) {
+		t.Error("c does not depend on a through b")
+	}
+	if dependsOn(rules, a, c) {
+		t.Error("a depends on c")
+	}
+}
+
+func TestRules(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Brancher")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	pizza := f.question(q.ID, "Pizza?", nil, false)
+	topping := f.question(q.ID, "Pineapple?", nil, false)
+
+	if _, err := srv.CreateRule(topping.ID, pizza.ID, []string{"Yes"}, ctx); err != nil {
+		t.Fatalf("CreateRule() error = %v", err)
+	}
+	if _, err := srv.CreateRule(pizza.ID, topping.ID, []string{"Yes"}, ctx); !errors.Is(err, ErrInvalidRule) {
+		t.Fatalf("CreateRule() closing a cycle error = %v, want ErrInvalidRule", err)
+	}
+	if _, err := srv.CreateRule(pizza.ID, pizza.ID, []string{"Yes"}, ctx); !errors.Is(err, ErrInvalidRule) {
+		t.Fatalf("CreateRule() on itself error = %v, want ErrInvalidRule", err)
+	}
+
+	alice := f.member(owner.ID, q.ID, "Alice")
+
+	visible, err := srv.GetVisibleQuestions(alice.ID, q.ID, ctx)
+	if err != nil {
+		t.Fatalf("GetVisibleQuestions() error = %v", err)
+	}
+	if len(visible) != 1 || visible[0].ID != pizza.ID {
+		t.Fatalf("visible before answering = %v, want only the pizza question", visible)
+	}
+	if _, err := srv.CreateAnswer(alice.ID, topping.ID, "Yes", ctx); !errors.Is(err, ErrQuestionHidden) {
+		t.Fatalf("CreateAnswer() to a hidden question error = %v, want ErrQuestionHidden", err)
+	}
+
+	if _, err := srv.CreateAnswer(alice.ID, pizza.ID, "Yes", ctx); err != nil {
+		t.Fatalf("CreateAnswer() error = %v", err)
+	}
+	if _, err := srv.CreateAnswer(alice.ID, topping.ID, "No", ctx); err != nil {
+		t.Fatalf("CreateAnswer() to a shown question error = %v", err)
+	}
+
+	// Deleting the source question drops the rule with it
+	if err := srv.DeleteQuestion(pizza.ID, ctx); err != nil {
+		t.Fatalf("DeleteQuestion() error = %v", err)
+	}
+	rules, err := srv.GetQuestionRules(topping.ID, ctx)
+	if err != nil {
+		t.Fatalf("GetQuestionRules() error = %v", err)
+	}
+	if len(rules) != 0 {
+		t.Errorf("GetQuestionRules() = %d rules after deleting their source, want 0", len(rules))
+	}
+}
@@ -0,0 +1,313 @@
+package database
+
+import (
+	"context"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+	"radgifa/ent/question"
+	"radgifa/ent/questionnaire"
+	"radgifa/ent/section"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidSectionOrder is returned by ReorderSections when the new
+	// order does not list every section of the questionnaire exactly once
+	ErrInvalidSectionOrder = newError(ErrInvalid, "invalid_section_order", "the order must list every section of the questionnaire exactly once")
+	// ErrSectionNotInQuestionnaire is returned when a question is put in a
+	// section of another questionnaire
+	ErrSectionNotInQuestionnaire = newError(ErrInvalid, "section_not_in_questionnaire", "section does not belong to the questionnaire")
+)
+
+// checkSection makes sure sectionID, when set, is a section of the questionnaire
+func checkSection(tx *ent.Tx, questionnaireID uuid.UUID, sectionID *uuid.UUID, ctx context.Context) error {
+	if sectionID == nil {
+		return nil
+	}
+	exists, err := tx.Section.Query().
+		Where(
+			section.ID(*sectionID),
+			section.HasQuestionnaireWith(questionnaire.ID(questionnaireID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrSectionNotInQuestionnaire
+	}
+	return nil
+}
+
+// CreateSection appends a section to the questionnaire
+func (s *service) CreateSection(questionnaireID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// Lock the questionnaire so concurrent creations do not share a position
+	_, err = tx.Questionnaire.Query().
+		Where(questionnaire.ID(questionnaireID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	count, err := tx.Section.Query().
+		Where(section.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Count(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	created, err := tx.Section.Create().
+		SetQuestionnaireID(questionnaireID).
+		SetTitle(title).
+		SetDescription(description).
+		SetPosition(count).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return created, tx.Commit()
+}
+
+func (s *service) GetSectionWithQuestionnaire(sectionID uuid.UUID, ctx context.Context) (*ent.Section, error) {
+	return s.client.Section.Query().
+		Where(section.ID(sectionID)).
+		WithQuestionnaire().
+		Only(ctx)
+}
+
+func (s *service) GetQuestionnaireSections(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Section, error) {
+	return s.client.Section.Query().
+		Where(section.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Order(ent.Asc(section.FieldPosition)).
+		All(ctx)
+}
+
+func (s *service) UpdateSection(sectionID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error) {
+	return s.client.Section.UpdateOneID(sectionID).
+		SetTitle(title).
+		SetDescription(description).
+		Save(ctx)
+}
+
+// DeleteSection removes a section. Its questions are kept, outside any section.
+func (s *service) DeleteSection(sectionID uuid.UUID, ctx context.Context) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	deleted, err := tx.Section.Query().
+		Where(section.ID(sectionID)).
+		WithQuestionnaire().
+		Only(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	_, err = tx.Question.Update().
+		Where(question.HasSectionWith(section.ID(sectionID))).
+		ClearSection().
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	err = tx.Section.DeleteOneID(sectionID).Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	// Close the gap left in the positions
+	_, err = tx.Section.Update().
+		Where(
+			section.HasQuestionnaireWith(questionnaire.ID(deleted.Edges.Questionnaire.ID)),
+			section.PositionGT(deleted.Position),
+		).
+		AddPosition(-1).
+		Save(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// ReorderSections rewrites the positions of the sections of a questionnaire
+// in one transaction. sectionIDs must list every section exactly once.
+func (s *service) ReorderSections(questionnaireID uuid.UUID, sectionIDs []uuid.UUID, ctx context.Context) ([]*ent.Section, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	_, err = tx.Questionnaire.Query().
+		Where(questionnaire.ID(questionnaireID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	existing, err := tx.Section.Query().
+		Where(section.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if !samePermutation(existing, sectionIDs) {
+		return nil, rollback(tx, ErrInvalidSectionOrder)
+	}
+
+	for position, id := range sectionIDs {
+		err = tx.Section.UpdateOneID(id).SetPosition(position).Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	sections, err := tx.Section.Query().
+		Where(section.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Order(ent.Asc(section.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	return sections, tx.Commit()
+}
+
+// Completion breaks down the progress of the members of a questionnaire
+// section by section. MissingRequired counts, for each member, the required
+// questions they see but did not answer.
+type Completion struct {
+	Members         []*ent.Member
+	Sections        []SectionCompletion
+	MissingRequired map[uuid.UUID]int
+}
+
+// SectionCompletion counts the questions of a section and how many of them
+// each member answered. Section is nil for the questions outside any section.
+type SectionCompletion struct {
+	Section       *ent.Section
+	QuestionCount int
+	Answered      map[uuid.UUID]int
+}
+
+// GetQuestionnaireCompletion returns the sections in order, followed by the
+// questions outside any section when there are some
+func (s *service) GetQuestionnaireCompletion(questionnaireID uuid.UUID, ctx context.Context) (Completion, error) {
+	inQuestionnaire := questionnaire.ID(questionnaireID)
+
+	members, err := s.client.Member.Query().
+		Where(member.HasQuestionnaireWith(inQuestionnaire)).
+		Order(ent.Asc(member.FieldCreatedAt), ent.Asc(member.FieldID)).
+		All(ctx)
+	if err != nil {
+		return Completion{}, err
+	}
+
+	sections, err := s.GetQuestionnaireSections(questionnaireID, ctx)
+	if err != nil {
+		return Completion{}, err
+	}
+
+	questions, err := s.client.Question.Query().
+		Where(question.HasQuestionnaireWith(inQuestionnaire)).
+		WithSection().
+		WithRules(func(q *ent.RuleQuery) {
+			q.WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) })
+		}).
+		Order(ent.Asc(question.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return Completion{}, err
+	}
+
+	answers, err := s.client.Answer.Query().
+		Where(answer.HasQuestionWith(question.HasQuestionnaireWith(inQuestionnaire))).
+		WithMember(func(q *ent.MemberQuery) { q.Select(member.FieldID) }).
+		WithQuestion(func(q *ent.QuestionQuery) { q.Select(question.FieldID) }).
+		All(ctx)
+	if err != nil {
+		return Completion{}, err
+	}
+	// Keyed by question, so a question answered twice counts once
+	answered := make(map[uuid.UUID]map[uuid.UUID]answer.AnswerValue, len(members))
+	for _, a := range answers {
+		memberID := a.Edges.Member.ID
+		if answered[memberID] == nil {
+			answered[memberID] = map[uuid.UUID]answer.AnswerValue{}
+		}
+		answered[memberID][a.Edges.Question.ID] = a.AnswerValue
+	}
+
+	// uuid.Nil stands for the questions outside any section
+	bySection := make(map[uuid.UUID]*SectionCompletion, len(sections)+1)
+	completion := Completion{
+		Members:         members,
+		Sections:        make([]SectionCompletion, 0, len(sections)+1),
+		MissingRequired: make(map[uuid.UUID]int, len(members)),
+	}
+	for _, sec := range sections {
+		completion.Sections = append(completion.Sections, SectionCompletion{Section: sec, Answered: map[uuid.UUID]int{}})
+	}
+	completion.Sections = append(completion.Sections, SectionCompletion{Answered: map[uuid.UUID]int{}})
+	for i := range completion.Sections {
+		key := uuid.Nil
+		if sec := completion.Sections[i].Section; sec != nil {
+			key = sec.ID
+		}
+		bySection[key] = &completion.Sections[i]
+	}
+
+	sectionOf := make(map[uuid.UUID]uuid.UUID, len(questions))
+	for _, q := range questions {
+		key := uuid.Nil
+		if q.Edges.Section != nil {
+			key = q.Edges.Section.ID
+		}
+		sectionOf[q.ID] = key
+		bySection[key].QuestionCount++
+	}
+	for memberID, byQuestion := range answered {
+		for questionID := range byQuestion {
+			bySection[sectionOf[questionID]].Answered[memberID]++
+		}
+	}
+	for _, m := range members {
+		completion.MissingRequired[m.ID] = len(missingRequired(questions, answered[m.ID]))
+	}
+
+	if last := len(completion.Sections) - 1; completion.Sections[last].QuestionCount == 0 {
+		completion.Sections = completion.Sections[:last]
+	}
+	return completion, nil
+}
@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/ent/question"
+	"radgifa/ent/section"
+
+	"github.com/google/uuid"
+)
+
+func TestSections(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Sectioner")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	other := f.questionnaire(owner.ID, "Movie night", false)
+
+	dough, err := srv.CreateSection(q.ID, "Dough", "", ctx)
+	if err != nil {
+		t.Fatalf("CreateSection() error = %v", err)
+	}
+	toppings, err := srv.CreateSection(q.ID, "Toppings", "What goes on top", ctx)
+	if err != nil {
+		t.Fatalf("CreateSection() error = %v", err)
+	}
+	if dough.Position != 0 || toppings.Position != 1 {
+		t.Fatalf("positions = %d, %d, want 0, 1", dough.Position, toppings.Position)
+	}
+
+	thin := f.question(q.ID, "Thin crust?", &dough.ID, false)
+	cheese := f.question(q.ID, "Extra cheese?", &toppings.ID, false)
+	f.question(q.ID, "Delivery?", nil, false)
+	if _, err := srv.CreateNewQuestion(other.ID, "Comedy?", &dough.ID, false, ctx); !errors.Is(err, ErrSectionNotInQuestionnaire) {
+		t.Fatalf("CreateNewQuestion() in a foreign section error = %v, want ErrSectionNotInQuestionnaire", err)
+	}
+
+	sections, err := srv.ReorderSections(q.ID, []uuid.UUID{toppings.ID, dough.ID}, ctx)
+	if err != nil {
+		t.Fatalf("ReorderSections() error = %v", err)
+	}
+	if sections[0].ID != toppings.ID || sections[1].ID != dough.ID {
+		t.Fatalf("ReorderSections() = %v, want toppings then dough", sections)
+	}
+	if _, err := srv.ReorderSections(q.ID, []uuid.UUID{dough.ID}, ctx); !errors.Is(err, ErrInvalidSectionOrder) {
+		t.Fatalf("ReorderSections() with a missing section error = %v, want ErrInvalidSectionOrder", err)
+	}
+
+	alice := f.member(owner.ID, q.ID, "Alice")
+	if _, err := srv.CreateAnswer(alice.ID, cheese.ID, "Yes", ctx); err != nil {
+		t.Fatalf("CreateAnswer() error = %v", err)
+	}
+
+	completion, err := srv.GetQuestionnaireCompletion(q.ID, ctx)
+	if err != nil {
+		t.Fatalf("GetQuestionnaireCompletion() error = %v", err)
+	}
+	if len(completion.Sections) != 3 || len(completion.Members) != 1 {
+		t.Fatalf("completion has %d sections and %d members, want 3 and 1", len(completion.Sections), len(completion.Members))
+	}
+	if got := completion.Sections[0]; got.Section.ID != toppings.ID || got.QuestionCount != 1 || got.Answered[alice.ID] != 1 {
+		t.Errorf("toppings completion = %+v", got)
+	}
+	if got := completion.Sections[1]; got.Section.ID != dough.ID || got.QuestionCount != 1 || got.Answered[alice.ID] != 0 {
+		t.Errorf("dough completion = %+v", got)
+	}
+	if got := completion.Sections[2]; got.Section != nil || got.QuestionCount != 1 {
+		t.Errorf("completion outside sections = %+v", got)
+	}
+
+	// Deleting a section keeps its questions, outside any section
+	if err := srv.DeleteSection(toppings.ID, ctx); err != nil {
+		t.Fatalf("DeleteSection() error = %v", err)
+	}
+	kept, err := srv.Client().Question.Query().Where(question.ID(cheese.ID)).WithSection().Only(ctx)
+	if err != nil {
+		t.Fatalf("question of the deleted section: %v", err)
+	}
+	if kept.Edges.Section != nil {
+		t.Errorf("question still in section %s", kept.Edges.Section.ID)
+	}
+	sections, err = srv.GetQuestionnaireSections(q.ID, ctx)
+	if err != nil {
+		t.Fatalf("GetQuestionnaireSections() error = %v", err)
+	}
+	if len(sections) != 1 || sections[0].ID != dough.ID || sections[0].Position != 0 {
+		t.Errorf("sections after delete = %v, want dough at 0", sections)
+	}
+
+	if _, err := srv.UpdateQuestion(thin.ID, "Thin crust?", nil, false, 0, ctx); err != nil {
+		t.Fatalf("UpdateQuestion() error = %v", err)
+	}
+	if n, _ := srv.Client().Question.Query().Where(question.HasSectionWith(section.ID(dough.ID))).Count(ctx); n != 0 {
+		t.Errorf("dough still has %d questions after moving its only question out", n)
+	}
+}
+
+func TestMigrateThemesToSections(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	db, err := sql.Open("pgx", testConfig.Database.DSN())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	owner := f.user("Themer")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+
+	// Recreate the column of the releases before sections
+	if _, err := db.ExecContext(ctx, `ALTER TABLE questions ADD COLUMN theme varchar NOT NULL DEFAULT ''`); err != nil {
+		t.Fatal(err)
+	}
+	themes := []string{"Toppings", "Dough", " toppings ", ""}
+	ids := make([]uuid.UUID, len(themes))
+	for i, theme := range themes {
+		created := f.question(q.ID, "Question", nil, false)
+		ids[i] = created.ID
+		if _, err := db.ExecContext(ctx, `UPDATE questions SET theme = $1 WHERE id = $2`, theme, created.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := migrateThemesToSections(ctx, db); err != nil {
+		t.Fatalf("migrateThemesToSections() error = %v", err)
+	}
+	if err := migrateThemesToSections(ctx, db); err != nil {
+		t.Fatalf("migrateThemesToSections() second run error = %v", err)
+	}
+
+	sections, err := srv.GetQuestionnaireSections(q.ID, ctx)
+	if err != nil {
+		t.Fatalf("GetQuestionnaireSections() error = %v", err)
+	}
+	if len(sections) != 2 || sections[0].Title != "Toppings" || sections[1].Title != "Dough" {
+		t.Fatalf("sections = %v, want Toppings then Dough", sections)
+	}
+
+	sectionOf := func(id uuid.UUID) *ent.Section {
+		t.Helper()
+		q, err := srv.Client().Question.Query().Where(question.ID(id)).WithSection().Only(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return q.Edges.Section
+	}
+	if s := sectionOf(ids[2]); s == nil || s.ID != sections[0].ID {
+		t.Errorf("question themed %q is not in Toppings", themes[2])
+	}
+	if s := sectionOf(ids[3]); s != nil {
+		t.Errorf("question without a theme is in section %q", s.Title)
+	}
+}
@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+	"radgifa/ent/question"
+	"radgifa/ent/questionnaire"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrMissingRequiredAnswers is matched by the MissingAnswersError
+	// returned by SubmitQuestionnaire
+	ErrMissingRequiredAnswers = newError(ErrInvalid, "missing_required_answers", "required questions are not answered")
+	// ErrAnswersLocked is returned when a member who submitted a
+	// questionnaire that locks answers tries to change them
+	ErrAnswersLocked = newError(ErrConflict, "answers_locked", "answers are locked after submission")
+)
+
+// MissingAnswersError lists the required questions, visible to the member,
+// they did not answer yet
+type MissingAnswersError struct {
+	QuestionIDs []uuid.UUID
+}
+
+func (e *MissingAnswersError) Error() string {
+	return fmt.Sprintf("%s: %d missing", ErrMissingRequiredAnswers, len(e.QuestionIDs))
+}
+
+func (e *MissingAnswersError) Unwrap() error {
+	return ErrMissingRequiredAnswers
+}
+
+// SubmitQuestionnaire marks the member as done once they answered every
+// required question they see. Submitting again refreshes completed_at,
+// unless the questionnaire locks answers after submission.
+func (s *service) SubmitQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// Lock the member so a concurrent answer cannot slip in unnoticed
+	m, err := tx.Member.Query().
+		Where(member.ID(memberID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	q, err := tx.Questionnaire.Query().
+		Where(questionnaire.HasMembersWith(member.ID(memberID))).
+		Only(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if q.ClosedAt != nil {
+		return nil, rollback(tx, ErrQuestionnaireClosed)
+	}
+	if m.CompletedAt != nil && q.LockAfterSubmit {
+		return nil, rollback(tx, ErrAnswersLocked)
+	}
+
+	questions, err := tx.Question.Query().
+		Where(question.HasQuestionnaireWith(questionnaire.ID(q.ID))).
+		WithRules(func(q *ent.RuleQuery) {
+			q.WithSource(func(q *ent.QuestionQuery) { q.Select(question.FieldID) })
+		}).
+		Order(ent.Asc(question.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	answers, err := memberAnswers(tx.Answer, memberID, q.ID, ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if missing := missingRequired(questions, answers); len(missing) > 0 {
+		return nil, rollback(tx, &MissingAnswersError{QuestionIDs: missing})
+	}
+
+	m, err = tx.Member.UpdateOneID(memberID).
+		SetCompletedAt(time.Now().UnixMilli()).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := enqueueEvent(tx.Client(), q.ID, EventAnswersSubmitted, memberEvent(m), ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	return m, tx.Commit()
+}
+
+// missingRequired returns, in order, the required questions that are visible
+// given the answers but not answered. Questions must be loaded with their
+// rules and the rule sources.
+func missingRequired(questions []*ent.Question, answers map[uuid.UUID]answer.AnswerValue) []uuid.UUID {
+	visible := visibleQuestions(questions, answers)
+	var missing []uuid.UUID
+	for _, q := range questions {
+		if _, ok := answers[q.ID]; q.Required && visible[q.ID] && !ok {
+			missing = append(missing, q.ID)
+		}
+	}
+	return missing
+}
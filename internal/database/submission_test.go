@@ -0,0 +1,136 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+
+	"github.com/google/uuid"
+)
+
+func TestMissingRequired(t *testing.T) {
+	a := &ent.Question{ID: uuid.New(), Required: true}
+	b := &ent.Question{ID: uuid.New(), Required: true}
+	c := &ent.Question{ID: uuid.New()}
+	b.Edges.Rules = []*ent.Rule{ruleOn(a, "Yes")}
+	questions := []*ent.Question{a, b, c}
+
+	if got := missingRequired(questions, nil); len(got) != 1 || got[0] != a.ID {
+		t.Errorf("missing without answers = %v, want only a", got)
+	}
+	// b is hidden, so it is not missing
+	no := map[uuid.UUID]answer.AnswerValue{a.ID: answer.AnswerValueNo}
+	if got := missingRequired(questions, no); len(got) != 0 {
+		t.Errorf("missing with b hidden = %v, want none", got)
+	}
+	yes := map[uuid.UUID]answer.AnswerValue{a.ID: answer.AnswerValueYes}
+	if got := missingRequired(questions, yes); len(got) != 1 || got[0] != b.ID {
+		t.Errorf("missing with b shown = %v, want only b", got)
+	}
+}
+
+func TestSubmitQuestionnaire(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Submitter")
+
+	for _, locked := range []bool{false, true} {
+		q := f.questionnaire(owner.ID, "Pizza night", locked)
+		required := f.question(q.ID, "Pizza?", nil, true)
+		f.question(q.ID, "Dessert?", nil, false)
+		alice := f.member(owner.ID, q.ID, "Alice")
+
+		var missing *MissingAnswersError
+		_, err := srv.SubmitQuestionnaire(alice.ID, ctx)
+		if !errors.As(err, &missing) || !errors.Is(err, ErrMissingRequiredAnswers) {
+			t.Fatalf("SubmitQuestionnaire() without answers error = %v, want MissingAnswersError", err)
+		}
+		if len(missing.QuestionIDs) != 1 || missing.QuestionIDs[0] != required.ID {
+			t.Errorf("missing = %v, want the required question", missing.QuestionIDs)
+		}
+
+		if _, err := srv.CreateAnswer(alice.ID, required.ID, "Yes", ctx); err != nil {
+			t.Fatalf("CreateAnswer() error = %v", err)
+		}
+		submitted, err := srv.SubmitQuestionnaire(alice.ID, ctx)
+		if err != nil {
+			t.Fatalf("SubmitQuestionnaire() error = %v", err)
+		}
+		if submitted.CompletedAt == nil {
+			t.Fatal("SubmitQuestionnaire() did not set completed_at")
+		}
+
+		_, err = srv.CreateAnswer(alice.ID, required.ID, "No", ctx)
+		if locked {
+			if !errors.Is(err, ErrAnswersLocked) {
+				t.Errorf("CreateAnswer() after a locked submission error = %v, want ErrAnswersLocked", err)
+			}
+			if _, err := srv.SubmitQuestionnaire(alice.ID, ctx); !errors.Is(err, ErrAnswersLocked) {
+				t.Errorf("SubmitQuestionnaire() twice error = %v, want ErrAnswersLocked", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("CreateAnswer() after submitting error = %v", err)
+		}
+
+		completion, err := srv.GetQuestionnaireCompletion(q.ID, ctx)
+		if err != nil {
+			t.Fatalf("GetQuestionnaireCompletion() error = %v", err)
+		}
+		if len(completion.Members) != 1 || completion.Members[0].CompletedAt != nil {
+			t.Errorf("changing an answer did not reopen the submission")
+		}
+		if n := completion.MissingRequired[alice.ID]; n != 0 {
+			t.Errorf("MissingRequired = %d, want 0", n)
+		}
+	}
+}
+
+// TestAnswerWaitsForSubmission holds the member lock as SubmitQuestionnaire
+// does and checks an answer cannot be saved until it is released
+func TestAnswerWaitsForSubmission(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Racer")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	pizza := f.question(q.ID, "Pizza?", nil, false)
+	alice := f.member(owner.ID, q.ID, "Alice")
+
+	tx, err := srv.Client().Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Member.Query().Where(member.ID(alice.ID)).ForUpdate().Only(ctx); err != nil {
+		tx.Rollback()
+		t.Fatal(err)
+	}
+
+	saved := make(chan error, 1)
+	go func() {
+		_, err := srv.CreateAnswer(alice.ID, pizza.ID, "Yes", ctx)
+		saved <- err
+	}()
+	select {
+	case err := <-saved:
+		tx.Rollback()
+		t.Fatalf("CreateAnswer() returned %v while the member was locked", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-saved; err != nil {
+		t.Fatalf("CreateAnswer() error = %v", err)
+	}
+}
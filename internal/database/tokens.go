@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/ent/accesstoken"
+	"radgifa/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// Scopes of the personal access tokens. A token only reaches the routes of
+// its scopes, sessions reach them all.
+const (
+	// ScopeRead reads questionnaires, their questions, members, answers and
+	// results
+	ScopeRead = "read"
+	// ScopeQuestionnaires edits questionnaires, their sections, questions
+	// and rules, publishes them and invites members
+	ScopeQuestionnaires = "questionnaires:write"
+	// ScopeAnswers answers and submits the questionnaires the user joined
+	ScopeAnswers = "answers:write"
+)
+
+// Scopes lists every scope a token can have
+var Scopes = []string{ScopeRead, ScopeQuestionnaires, ScopeAnswers}
+
+// AccessTokenPrefix starts every personal access token, which tells them
+// from JWTs and lets secret scanners find them
+const AccessTokenPrefix = "rgf_"
+
+// lastUsedResolution is how stale the last use of a token may be, so that
+// every request does not write
+const lastUsedResolution = time.Minute
+
+var (
+	// ErrAccessTokenNotFound is returned when revoking a token the user
+	// does not have
+	ErrAccessTokenNotFound = newError(ErrNotFound, "not_found", "access token not found")
+	// ErrInvalidAccessToken is returned for a token that does not exist,
+	// was revoked or expired
+	ErrInvalidAccessToken = newError(ErrForbidden, "invalid_access_token", "invalid, revoked or expired access token")
+)
+
+// hashAccessToken hashes a token for storage. The tokens are random, a
+// fast hash is enough to make a leaked table useless.
+func hashAccessToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// CreateAccessToken creates a token of the user with the given scopes,
+// expiring at expiresAt in Unix milliseconds unless nil. The token itself
+// is returned once, only its hash is stored.
+func (s *service) CreateAccessToken(userID uuid.UUID, name string, scopes []string, expiresAt *int64, ctx context.Context) (*ent.AccessToken, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	token := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	scopes = slices.Clone(scopes)
+	slices.Sort(scopes)
+	created, err := s.client.AccessToken.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetTokenHash(hashAccessToken(token)).
+		SetPrefix(token[:len(AccessTokenPrefix)+6]).
+		SetScopes(slices.Compact(scopes)).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return created, token, nil
+}
+
+// ListAccessTokens returns the tokens of the user, newest first
+func (s *service) ListAccessTokens(userID uuid.UUID, ctx context.Context) ([]*ent.AccessToken, error) {
+	return s.client.AccessToken.Query().
+		Where(accesstoken.HasUserWith(user.ID(userID))).
+		Order(ent.Desc(accesstoken.FieldCreatedAt), ent.Desc(accesstoken.FieldID)).
+		All(ctx)
+}
+
+// RevokeAccessToken deletes a token of the user, which stops working at
+// once
+func (s *service) RevokeAccessToken(userID, tokenID uuid.UUID, ctx context.Context) error {
+	n, err := s.client.AccessToken.Delete().
+		Where(
+			accesstoken.ID(tokenID),
+			accesstoken.HasUserWith(user.ID(userID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrAccessTokenNotFound
+	}
+	return nil
+}
+
+// AuthenticateAccessToken returns the token matching token, with its user
+// loaded, and records its use
+func (s *service) AuthenticateAccessToken(token string, ctx context.Context) (*ent.AccessToken, error) {
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		return nil, ErrInvalidAccessToken
+	}
+	found, err := s.client.AccessToken.Query().
+		Where(accesstoken.TokenHash(hashAccessToken(token))).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidAccessToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if found.ExpiresAt != nil && *found.ExpiresAt <= now.UnixMilli() {
+		return nil, ErrInvalidAccessToken
+	}
+
+	if found.LastUsedAt == nil || now.Sub(time.UnixMilli(*found.LastUsedAt)) >= lastUsedResolution {
+		// Conditional, so concurrent requests write once
+		err := s.client.AccessToken.Update().
+			Where(
+				accesstoken.ID(found.ID),
+				accesstoken.Or(
+					accesstoken.LastUsedAtIsNil(),
+					accesstoken.LastUsedAtLT(now.Add(-lastUsedResolution).UnixMilli()),
+				),
+			).
+			SetLastUsedAt(now.UnixMilli()).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		last := now.UnixMilli()
+		found.LastUsedAt = &last
+	}
+	return found, nil
+}
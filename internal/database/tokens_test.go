@@ -0,0 +1,77 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"radgifa/ent/accesstoken"
+)
+
+func TestAccessTokens(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Scripter")
+	other := f.user("Other")
+
+	created, token, err := srv.CreateAccessToken(owner.ID, "ci", []string{ScopeRead, ScopeRead, ScopeAnswers}, nil, ctx)
+	if err != nil {
+		t.Fatalf("CreateAccessToken() error = %v", err)
+	}
+	if !strings.HasPrefix(token, created.Prefix) || !strings.HasPrefix(token, AccessTokenPrefix) {
+		t.Errorf("token %q does not start with %q", token, created.Prefix)
+	}
+	if len(created.Scopes) != 2 {
+		t.Errorf("scopes = %v, want them deduplicated", created.Scopes)
+	}
+	stored, err := srv.Client().AccessToken.Query().Where(accesstoken.ID(created.ID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(stored.TokenHash, []byte(token)) {
+		t.Error("the token is stored in clear")
+	}
+
+	found, err := srv.AuthenticateAccessToken(token, ctx)
+	if err != nil {
+		t.Fatalf("AuthenticateAccessToken() error = %v", err)
+	}
+	if found.ID != created.ID || found.Edges.User.ID != owner.ID || found.LastUsedAt == nil {
+		t.Errorf("AuthenticateAccessToken() = %+v, want the token of the owner, used", found)
+	}
+	if _, err := srv.AuthenticateAccessToken(token+"x", ctx); !errors.Is(err, ErrInvalidAccessToken) {
+		t.Errorf("AuthenticateAccessToken() of an unknown token error = %v, want ErrInvalidAccessToken", err)
+	}
+
+	past := time.Now().Add(-time.Hour).UnixMilli()
+	_, expired, err := srv.CreateAccessToken(owner.ID, "old", []string{ScopeRead}, &past, ctx)
+	if err != nil {
+		t.Fatalf("CreateAccessToken() error = %v", err)
+	}
+	if _, err := srv.AuthenticateAccessToken(expired, ctx); !errors.Is(err, ErrInvalidAccessToken) {
+		t.Errorf("AuthenticateAccessToken() of an expired token error = %v, want ErrInvalidAccessToken", err)
+	}
+
+	tokens, err := srv.ListAccessTokens(owner.ID, ctx)
+	if err != nil {
+		t.Fatalf("ListAccessTokens() error = %v", err)
+	}
+	if len(tokens) != 2 {
+		t.Errorf("ListAccessTokens() = %v, want both tokens", tokens)
+	}
+
+	if err := srv.RevokeAccessToken(other.ID, created.ID, ctx); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RevokeAccessToken() by another user error = %v, want ErrNotFound", err)
+	}
+	if err := srv.RevokeAccessToken(owner.ID, created.ID, ctx); err != nil {
+		t.Fatalf("RevokeAccessToken() error = %v", err)
+	}
+	if _, err := srv.AuthenticateAccessToken(token, ctx); !errors.Is(err, ErrInvalidAccessToken) {
+		t.Errorf("AuthenticateAccessToken() of a revoked token error = %v, want ErrInvalidAccessToken", err)
+	}
+}
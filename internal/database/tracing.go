@@ -0,0 +1,460 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "radgifa/internal/database"
+
+	// RequestIDBaggageKey is the baggage member carrying the HTTP request ID
+	RequestIDBaggageKey = "request_id"
+)
+
+// tracedService wraps a Service so every call gets its own span. Personal
+// data is never recorded, only entity IDs.
+type tracedService struct {
+	Service
+	tracer trace.Tracer
+}
+
+// WithTracing returns a Service that records a span for each call. It uses
+// the global tracer provider, so it costs next to nothing when tracing is off.
+func WithTracing(s Service) Service {
+	return &tracedService{
+		Service: s,
+		tracer:  otel.Tracer(tracerName),
+	}
+}
+
+func (t *tracedService) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if requestID := baggage.FromContext(ctx).Member(RequestIDBaggageKey).Value(); requestID != "" {
+		attrs = append(attrs, attribute.String("http.request_id", requestID))
+	}
+	return t.tracer.Start(ctx, "database.Service/"+method, trace.WithAttributes(attrs...))
+}
+
+// record marks the span as failed when err is set and returns err
+func record(span trace.Span, err error) error {
+	if err != nil && !ent.IsNotFound(err) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+func (t *tracedService) CreateUser(name, displayName, username, password string, ctx context.Context) (*ent.User, error) {
+	ctx, span := t.start(ctx, "CreateUser")
+	defer span.End()
+	res, err := t.Service.CreateUser(name, displayName, username, password, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ValidateUserCredentials(username, password string, ctx context.Context) (*ent.User, error) {
+	ctx, span := t.start(ctx, "ValidateUserCredentials")
+	defer span.End()
+	res, err := t.Service.ValidateUserCredentials(username, password, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) IsUsernameAvailable(username string, ctx context.Context) (bool, error) {
+	ctx, span := t.start(ctx, "IsUsernameAvailable")
+	defer span.End()
+	res, err := t.Service.IsUsernameAvailable(username, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetUserByUsername(username string, ctx context.Context) (*ent.User, error) {
+	ctx, span := t.start(ctx, "GetUserByUsername")
+	defer span.End()
+	res, err := t.Service.GetUserByUsername(username, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) UpdateUserPassword(userID uuid.UUID, password string, ctx context.Context) error {
+	ctx, span := t.start(ctx, "UpdateUserPassword", attribute.String("radgifa.user_id", userID.String()))
+	defer span.End()
+	return record(span, t.Service.UpdateUserPassword(userID, password, ctx))
+}
+
+func (t *tracedService) CreateQuestionnaire(userID uuid.UUID, title, description string, lockAfterSubmit bool, ctx context.Context) (*ent.Questionnaire, error) {
+	ctx, span := t.start(ctx, "CreateQuestionnaire", attribute.String("radgifa.user_id", userID.String()))
+	defer span.End()
+	res, err := t.Service.CreateQuestionnaire(userID, title, description, lockAfterSubmit, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	ctx, span := t.start(ctx, "GetQuestionnaire", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.GetQuestionnaire(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateMember(userID, questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, error) {
+	ctx, span := t.start(ctx, "CreateMember", attribute.String("radgifa.user_id", userID.String()), attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.CreateMember(userID, questionnaireID, uniqueIdentifier, displayName, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateAnonymousMember(questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, string, error) {
+	ctx, span := t.start(ctx, "CreateAnonymousMember", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	member, passcode, err := t.Service.CreateAnonymousMember(questionnaireID, uniqueIdentifier, displayName, ctx)
+	return member, passcode, record(span, err)
+}
+
+func (t *tracedService) ValidateMemberCredentials(uniqueIdentifier, passcode string, ctx context.Context) (*ent.Member, error) {
+	ctx, span := t.start(ctx, "ValidateMemberCredentials")
+	defer span.End()
+	res, err := t.Service.ValidateMemberCredentials(uniqueIdentifier, passcode, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetMemberWithQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	ctx, span := t.start(ctx, "GetMemberWithQuestionnaire", attribute.String("radgifa.member_id", memberID.String()))
+	defer span.End()
+	res, err := t.Service.GetMemberWithQuestionnaire(memberID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) IsMemberIdentifierAvailable(questionnaireID uuid.UUID, uniqueIdentifier string, ctx context.Context) (bool, error) {
+	ctx, span := t.start(ctx, "IsMemberIdentifierAvailable", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.IsMemberIdentifierAvailable(questionnaireID, uniqueIdentifier, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateNewQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ctx context.Context) (*ent.Question, error) {
+	ctx, span := t.start(ctx, "CreateNewQuestion", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.CreateNewQuestion(questionnaireID, text, sectionID, required, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) UpdateQuestionnaire(questionnaireID uuid.UUID, title, description string, lockAfterSubmit bool, ifVersion int, ctx context.Context) (*ent.Questionnaire, error) {
+	ctx, span := t.start(ctx, "UpdateQuestionnaire", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.UpdateQuestionnaire(questionnaireID, title, description, lockAfterSubmit, ifVersion, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) PublishQuestionnaire(questionnaireID, userID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	ctx, span := t.start(ctx, "PublishQuestionnaire", attribute.String("radgifa.questionnaire_id", questionnaireID.String()), attribute.String("radgifa.user_id", userID.String()))
+	defer span.End()
+	res, err := t.Service.PublishQuestionnaire(questionnaireID, userID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) UnpublishQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	ctx, span := t.start(ctx, "UnpublishQuestionnaire", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.UnpublishQuestionnaire(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CloseQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	ctx, span := t.start(ctx, "CloseQuestionnaire", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.CloseQuestionnaire(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) DeleteQuestionnaire(questionnaireID uuid.UUID, ctx context.Context) error {
+	ctx, span := t.start(ctx, "DeleteQuestionnaire", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	return record(span, t.Service.DeleteQuestionnaire(questionnaireID, ctx))
+}
+
+func (t *tracedService) UpdateQuestion(questionID uuid.UUID, text string, sectionID *uuid.UUID, required bool, ifVersion int, ctx context.Context) (*ent.Question, error) {
+	ctx, span := t.start(ctx, "UpdateQuestion", attribute.String("radgifa.question_id", questionID.String()))
+	defer span.End()
+	res, err := t.Service.UpdateQuestion(questionID, text, sectionID, required, ifVersion, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) DeleteQuestion(questionID uuid.UUID, ctx context.Context) error {
+	ctx, span := t.start(ctx, "DeleteQuestion", attribute.String("radgifa.question_id", questionID.String()))
+	defer span.End()
+	return record(span, t.Service.DeleteQuestion(questionID, ctx))
+}
+
+func (t *tracedService) GetQuestionWithQuestionnaire(questionID uuid.UUID, ctx context.Context) (*ent.Question, error) {
+	ctx, span := t.start(ctx, "GetQuestionWithQuestionnaire", attribute.String("radgifa.question_id", questionID.String()))
+	defer span.End()
+	res, err := t.Service.GetQuestionWithQuestionnaire(questionID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetMemberByUserAndQuestionnaire(userID, questionnaireID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	ctx, span := t.start(ctx, "GetMemberByUserAndQuestionnaire", attribute.String("radgifa.user_id", userID.String()), attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.GetMemberByUserAndQuestionnaire(userID, questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateAnswer(memberID, questionID uuid.UUID, answerValue string, ctx context.Context) (*ent.Answer, error) {
+	ctx, span := t.start(ctx, "CreateAnswer", attribute.String("radgifa.member_id", memberID.String()), attribute.String("radgifa.question_id", questionID.String()))
+	defer span.End()
+	res, err := t.Service.CreateAnswer(memberID, questionID, answerValue, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateAnswers(memberID uuid.UUID, answers []NewAnswer, ctx context.Context) ([]*ent.Answer, error) {
+	ctx, span := t.start(ctx, "CreateAnswers", attribute.String("radgifa.member_id", memberID.String()), attribute.Int("radgifa.answers", len(answers)))
+	defer span.End()
+	res, err := t.Service.CreateAnswers(memberID, answers, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetQuestionnaireWithDetails(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	ctx, span := t.start(ctx, "GetQuestionnaireWithDetails", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.GetQuestionnaireWithDetails(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetQuestionnaireQuestions(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	ctx, span := t.start(ctx, "GetQuestionnaireQuestions", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.GetQuestionnaireQuestions(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ListUserQuestionnaires(userID uuid.UUID, filter QuestionnaireFilter, req PageRequest, ctx context.Context) (Page[*ent.Questionnaire], error) {
+	ctx, span := t.start(ctx, "ListUserQuestionnaires", attribute.String("radgifa.user_id", userID.String()))
+	defer span.End()
+	res, err := t.Service.ListUserQuestionnaires(userID, filter, req, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ListQuestionnaireQuestions(questionnaireID uuid.UUID, filter QuestionFilter, req PageRequest, ctx context.Context) (Page[*ent.Question], error) {
+	ctx, span := t.start(ctx, "ListQuestionnaireQuestions", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.ListQuestionnaireQuestions(questionnaireID, filter, req, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ListQuestionnaireMembers(questionnaireID uuid.UUID, filter MemberFilter, req PageRequest, ctx context.Context) (Page[*ent.Member], error) {
+	ctx, span := t.start(ctx, "ListQuestionnaireMembers", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.ListQuestionnaireMembers(questionnaireID, filter, req, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ListMemberAnswers(memberID, questionnaireID uuid.UUID, filter AnswerFilter, req PageRequest, ctx context.Context) (Page[*ent.Answer], error) {
+	ctx, span := t.start(ctx, "ListMemberAnswers", attribute.String("radgifa.member_id", memberID.String()), attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.ListMemberAnswers(memberID, questionnaireID, filter, req, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ListUserMemberships(userID uuid.UUID, filter MembershipFilter, req PageRequest, ctx context.Context) (Page[Membership], error) {
+	ctx, span := t.start(ctx, "ListUserMemberships", attribute.String("radgifa.user_id", userID.String()))
+	defer span.End()
+	res, err := t.Service.ListUserMemberships(userID, filter, req, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) InsertQuestion(questionnaireID uuid.UUID, text string, sectionID *uuid.UUID, required bool, index int, ctx context.Context) (*ent.Question, error) {
+	ctx, span := t.start(ctx, "InsertQuestion", attribute.String("radgifa.questionnaire_id", questionnaireID.String()), attribute.Int("radgifa.index", index))
+	defer span.End()
+	res, err := t.Service.InsertQuestion(questionnaireID, text, sectionID, required, index, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ReorderQuestions(questionnaireID uuid.UUID, questionIDs []uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	ctx, span := t.start(ctx, "ReorderQuestions", attribute.String("radgifa.questionnaire_id", questionnaireID.String()), attribute.Int("radgifa.questions", len(questionIDs)))
+	defer span.End()
+	res, err := t.Service.ReorderQuestions(questionnaireID, questionIDs, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateSection(questionnaireID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error) {
+	ctx, span := t.start(ctx, "CreateSection", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.CreateSection(questionnaireID, title, description, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetSectionWithQuestionnaire(sectionID uuid.UUID, ctx context.Context) (*ent.Section, error) {
+	ctx, span := t.start(ctx, "GetSectionWithQuestionnaire", attribute.String("radgifa.section_id", sectionID.String()))
+	defer span.End()
+	res, err := t.Service.GetSectionWithQuestionnaire(sectionID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetQuestionnaireSections(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Section, error) {
+	ctx, span := t.start(ctx, "GetQuestionnaireSections", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.GetQuestionnaireSections(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) UpdateSection(sectionID uuid.UUID, title, description string, ctx context.Context) (*ent.Section, error) {
+	ctx, span := t.start(ctx, "UpdateSection", attribute.String("radgifa.section_id", sectionID.String()))
+	defer span.End()
+	res, err := t.Service.UpdateSection(sectionID, title, description, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) DeleteSection(sectionID uuid.UUID, ctx context.Context) error {
+	ctx, span := t.start(ctx, "DeleteSection", attribute.String("radgifa.section_id", sectionID.String()))
+	defer span.End()
+	return record(span, t.Service.DeleteSection(sectionID, ctx))
+}
+
+func (t *tracedService) ReorderSections(questionnaireID uuid.UUID, sectionIDs []uuid.UUID, ctx context.Context) ([]*ent.Section, error) {
+	ctx, span := t.start(ctx, "ReorderSections", attribute.String("radgifa.questionnaire_id", questionnaireID.String()), attribute.Int("radgifa.sections", len(sectionIDs)))
+	defer span.End()
+	res, err := t.Service.ReorderSections(questionnaireID, sectionIDs, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetQuestionnaireCompletion(questionnaireID uuid.UUID, ctx context.Context) (Completion, error) {
+	ctx, span := t.start(ctx, "GetQuestionnaireCompletion", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.GetQuestionnaireCompletion(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateRule(questionID, sourceID uuid.UUID, answerValues []string, ctx context.Context) (*ent.Rule, error) {
+	ctx, span := t.start(ctx, "CreateRule", attribute.String("radgifa.question_id", questionID.String()), attribute.String("radgifa.source_id", sourceID.String()))
+	defer span.End()
+	res, err := t.Service.CreateRule(questionID, sourceID, answerValues, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetRuleWithQuestion(ruleID uuid.UUID, ctx context.Context) (*ent.Rule, error) {
+	ctx, span := t.start(ctx, "GetRuleWithQuestion", attribute.String("radgifa.rule_id", ruleID.String()))
+	defer span.End()
+	res, err := t.Service.GetRuleWithQuestion(ruleID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetQuestionRules(questionID uuid.UUID, ctx context.Context) ([]*ent.Rule, error) {
+	ctx, span := t.start(ctx, "GetQuestionRules", attribute.String("radgifa.question_id", questionID.String()))
+	defer span.End()
+	res, err := t.Service.GetQuestionRules(questionID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) DeleteRule(ruleID uuid.UUID, ctx context.Context) error {
+	ctx, span := t.start(ctx, "DeleteRule", attribute.String("radgifa.rule_id", ruleID.String()))
+	defer span.End()
+	return record(span, t.Service.DeleteRule(ruleID, ctx))
+}
+
+func (t *tracedService) GetVisibleQuestions(memberID, questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Question, error) {
+	ctx, span := t.start(ctx, "GetVisibleQuestions", attribute.String("radgifa.member_id", memberID.String()), attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.GetVisibleQuestions(memberID, questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) SubmitQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	ctx, span := t.start(ctx, "SubmitQuestionnaire", attribute.String("radgifa.member_id", memberID.String()))
+	defer span.End()
+	res, err := t.Service.SubmitQuestionnaire(memberID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateAccessToken(userID uuid.UUID, name string, scopes []string, expiresAt *int64, ctx context.Context) (*ent.AccessToken, string, error) {
+	ctx, span := t.start(ctx, "CreateAccessToken", attribute.String("radgifa.user_id", userID.String()))
+	defer span.End()
+	res, token, err := t.Service.CreateAccessToken(userID, name, scopes, expiresAt, ctx)
+	return res, token, record(span, err)
+}
+
+func (t *tracedService) ListAccessTokens(userID uuid.UUID, ctx context.Context) ([]*ent.AccessToken, error) {
+	ctx, span := t.start(ctx, "ListAccessTokens", attribute.String("radgifa.user_id", userID.String()))
+	defer span.End()
+	res, err := t.Service.ListAccessTokens(userID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) RevokeAccessToken(userID, tokenID uuid.UUID, ctx context.Context) error {
+	ctx, span := t.start(ctx, "RevokeAccessToken", attribute.String("radgifa.user_id", userID.String()), attribute.String("radgifa.access_token_id", tokenID.String()))
+	defer span.End()
+	return record(span, t.Service.RevokeAccessToken(userID, tokenID, ctx))
+}
+
+// AuthenticateAccessToken records the ID of the token found, never the
+// token itself
+func (t *tracedService) AuthenticateAccessToken(token string, ctx context.Context) (*ent.AccessToken, error) {
+	ctx, span := t.start(ctx, "AuthenticateAccessToken")
+	defer span.End()
+	res, err := t.Service.AuthenticateAccessToken(token, ctx)
+	if res != nil {
+		span.SetAttributes(attribute.String("radgifa.access_token_id", res.ID.String()))
+	}
+	return res, record(span, err)
+}
+
+func (t *tracedService) CreateWebhook(questionnaireID uuid.UUID, url string, events []string, ctx context.Context) (*ent.Webhook, error) {
+	ctx, span := t.start(ctx, "CreateWebhook", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.CreateWebhook(questionnaireID, url, events, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) ListWebhooks(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Webhook, error) {
+	ctx, span := t.start(ctx, "ListWebhooks", attribute.String("radgifa.questionnaire_id", questionnaireID.String()))
+	defer span.End()
+	res, err := t.Service.ListWebhooks(questionnaireID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) GetWebhookWithQuestionnaire(webhookID uuid.UUID, ctx context.Context) (*ent.Webhook, error) {
+	ctx, span := t.start(ctx, "GetWebhookWithQuestionnaire", attribute.String("radgifa.webhook_id", webhookID.String()))
+	defer span.End()
+	res, err := t.Service.GetWebhookWithQuestionnaire(webhookID, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) DeleteWebhook(webhookID uuid.UUID, ctx context.Context) error {
+	ctx, span := t.start(ctx, "DeleteWebhook", attribute.String("radgifa.webhook_id", webhookID.String()))
+	defer span.End()
+	return record(span, t.Service.DeleteWebhook(webhookID, ctx))
+}
+
+func (t *tracedService) ListWebhookDeliveries(webhookID uuid.UUID, req PageRequest, ctx context.Context) (Page[*ent.WebhookDelivery], error) {
+	ctx, span := t.start(ctx, "ListWebhookDeliveries", attribute.String("radgifa.webhook_id", webhookID.String()))
+	defer span.End()
+	res, err := t.Service.ListWebhookDeliveries(webhookID, req, ctx)
+	return res, record(span, err)
+}
+
+func (t *tracedService) RedeliverWebhookDelivery(webhookID, deliveryID uuid.UUID, ctx context.Context) (*ent.WebhookDelivery, error) {
+	ctx, span := t.start(ctx, "RedeliverWebhookDelivery", attribute.String("radgifa.webhook_id", webhookID.String()), attribute.String("radgifa.webhook_delivery_id", deliveryID.String()))
+	defer span.End()
+	res, err := t.Service.RedeliverWebhookDelivery(webhookID, deliveryID, ctx)
+	return res, record(span, err)
+}
+
+// ClaimWebhookDeliveries records how many deliveries were claimed, the
+// dispatcher calls it on every poll
+func (t *tracedService) ClaimWebhookDeliveries(limit int, lease time.Duration, ctx context.Context) ([]*ent.WebhookDelivery, error) {
+	ctx, span := t.start(ctx, "ClaimWebhookDeliveries")
+	defer span.End()
+	res, err := t.Service.ClaimWebhookDeliveries(limit, lease, ctx)
+	span.SetAttributes(attribute.Int("radgifa.webhook_deliveries", len(res)))
+	return res, record(span, err)
+}
+
+func (t *tracedService) RecordWebhookAttempt(deliveryID uuid.UUID, attempt WebhookAttempt, ctx context.Context) error {
+	ctx, span := t.start(ctx, "RecordWebhookAttempt", attribute.String("radgifa.webhook_delivery_id", deliveryID.String()))
+	defer span.End()
+	return record(span, t.Service.RecordWebhookAttempt(deliveryID, attempt, ctx))
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+
+	"github.com/google/uuid"
+)
+
+func TestConcurrentAnswers(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Hammer")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	pizza := f.question(q.ID, "Pizza?", nil, false)
+	alice := f.member(owner.ID, q.ID, "Alice")
+
+	values := []string{"Yes", "No", "Pass"}
+	var wg sync.WaitGroup
+	errs := make(chan error, 60)
+	for i := 0; i < 60; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			value := values[i%len(values)]
+			// Mix single answers and batches, as both endpoints share the upsert
+			if i%2 == 0 {
+				_, err := srv.CreateAnswer(alice.ID, pizza.ID, value, ctx)
+				errs <- err
+				return
+			}
+			_, err := srv.CreateAnswers(alice.ID, []NewAnswer{{QuestionID: pizza.ID, AnswerValue: value}}, ctx)
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("concurrent answer error = %v", err)
+		}
+	}
+
+	n, err := srv.Client().Answer.Query().
+		Where(answer.HasMemberWith(member.ID(alice.ID))).
+		Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("%d answers after concurrent submissions, want 1", n)
+	}
+}
+
+func TestDedupeAnswers(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	db, err := sql.Open("pgx", testConfig.Database.DSN())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	owner := f.user("Dedupe")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	pizza := f.question(q.ID, "Pizza?", nil, false)
+	alice := f.member(owner.ID, q.ID, "Alice")
+
+	// Duplicates as saved by the releases without the unique index
+	if _, err := db.ExecContext(ctx, `DROP INDEX answer_member_answers_question_answers`); err != nil {
+		t.Fatal(err)
+	}
+	for i, value := range []string{"No", "Yes", "Pass"} {
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO answers (id, answer_value, created_at, updated_at, member_answers, question_answers)
+			VALUES ($1, $2, $3, $3, $4, $5)`,
+			uuid.New(), value, 1000+i, alice.ID, pizza.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := migrate(ctx, srv.Client(), db); err != nil {
+		t.Fatalf("migrate() error = %v", err)
+	}
+
+	answers, err := srv.Client().Answer.Query().
+		Where(answer.HasMemberWith(member.ID(alice.ID))).
+		All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(answers) != 1 || answers[0].AnswerValue != answer.AnswerValuePass {
+		t.Fatalf("answers after dedupe = %v, want only the latest", answers)
+	}
+
+	// The index is back, so answering again updates the kept row
+	if _, err := srv.CreateAnswer(alice.ID, pizza.ID, "Yes", ctx); err != nil {
+		t.Fatalf("CreateAnswer() error = %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO answers (id, answer_value, created_at, updated_at, member_answers, question_answers)
+		VALUES ($1, 'No', 0, 0, $2, $3)`, uuid.New(), alice.ID, pizza.ID); err == nil {
+		t.Error("inserting a duplicate answer succeeded after the migration")
+	}
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestOptimisticUpdates(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Coeditor")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	if q.Version != 1 {
+		t.Fatalf("new questionnaire version = %d, want 1", q.Version)
+	}
+
+	updated, err := srv.UpdateQuestionnaire(q.ID, "Pizza party", "", false, q.Version, ctx)
+	if err != nil {
+		t.Fatalf("UpdateQuestionnaire() error = %v", err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("version after an update = %d, want 2", updated.Version)
+	}
+	// A second editor still holding version 1 is turned down
+	if _, err := srv.UpdateQuestionnaire(q.ID, "Pizza evening", "", false, q.Version, ctx); !errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("UpdateQuestionnaire() with a stale version error = %v, want ErrVersionMismatch", err)
+	}
+	if _, err := srv.UpdateQuestionnaire(uuid.New(), "Ghost", "", false, 1, ctx); err == nil || errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("UpdateQuestionnaire() of a missing questionnaire error = %v, want not found", err)
+	}
+	if _, err := srv.UpdateQuestionnaire(q.ID, "Pizza evening", "", false, 0, ctx); err != nil {
+		t.Errorf("UpdateQuestionnaire() without a version error = %v", err)
+	}
+
+	// Publishing and unpublishing are changes too
+	published, err := srv.PublishQuestionnaire(q.ID, owner.ID, ctx)
+	if err != nil {
+		t.Fatalf("PublishQuestionnaire() error = %v", err)
+	}
+	unpublished, err := srv.UnpublishQuestionnaire(q.ID, ctx)
+	if err != nil {
+		t.Fatalf("UnpublishQuestionnaire() error = %v", err)
+	}
+	if unpublished.Version != published.Version+1 {
+		t.Errorf("version after unpublishing = %d, want %d", unpublished.Version, published.Version+1)
+	}
+	if _, err := srv.UpdateQuestionnaire(q.ID, "Pizza evening", "", false, published.Version, ctx); !errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("UpdateQuestionnaire() with the version before unpublishing error = %v, want ErrVersionMismatch", err)
+	}
+
+	question := f.question(q.ID, "Pizza?", nil, false)
+	if _, err := srv.UpdateQuestion(question.ID, "Pizza tonight?", nil, false, question.Version, ctx); err != nil {
+		t.Fatalf("UpdateQuestion() error = %v", err)
+	}
+	if _, err := srv.UpdateQuestion(question.ID, "Pizza tomorrow?", nil, false, question.Version, ctx); !errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("UpdateQuestion() with a stale version error = %v, want ErrVersionMismatch", err)
+	}
+}
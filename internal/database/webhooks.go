@@ -0,0 +1,347 @@
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/ent/questionnaire"
+	"radgifa/ent/webhook"
+	"radgifa/ent/webhookdelivery"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Events of a questionnaire a webhook can subscribe to
+const (
+	// EventMemberJoined is sent when someone joins the questionnaire
+	EventMemberJoined = "member.joined"
+	// EventAnswersSubmitted is sent when a member submits their answers
+	EventAnswersSubmitted = "answers.submitted"
+	// EventQuestionnairePublished is sent when the owner publishes the
+	// questionnaire
+	EventQuestionnairePublished = "questionnaire.published"
+	// EventQuestionnaireClosed is sent when the owner closes the
+	// questionnaire
+	EventQuestionnaireClosed = "questionnaire.closed"
+)
+
+// Events lists every event a webhook can subscribe to
+var Events = []string{EventMemberJoined, EventAnswersSubmitted, EventQuestionnairePublished, EventQuestionnaireClosed}
+
+// WebhookSecretPrefix starts the secret of every webhook
+const WebhookSecretPrefix = "whsec_"
+
+var (
+	// ErrWebhookNotFound is returned for a webhook that does not exist
+	ErrWebhookNotFound = newError(ErrNotFound, "not_found", "webhook not found")
+	// ErrWebhookDeliveryNotFound is returned when redelivering a delivery
+	// the webhook does not have
+	ErrWebhookDeliveryNotFound = newError(ErrNotFound, "not_found", "webhook delivery not found")
+)
+
+// Event is the body of a webhook delivery. ID is shared by the deliveries of
+// the event to every webhook and by its redeliveries, so receivers can
+// ignore the events they already handled.
+type Event struct {
+	ID              uuid.UUID `json:"id"`
+	Type            string    `json:"type"`
+	CreatedAt       int64     `json:"created_at"`
+	QuestionnaireID uuid.UUID `json:"questionnaire_id"`
+	Data            EventData `json:"data"`
+}
+
+// EventData is what an event is about: the member for the member events,
+// the questionnaire otherwise
+type EventData struct {
+	Member        *EventMember        `json:"member,omitempty"`
+	Questionnaire *EventQuestionnaire `json:"questionnaire,omitempty"`
+}
+
+// EventMember is the member of an event
+type EventMember struct {
+	ID          uuid.UUID `json:"id"`
+	DisplayName string    `json:"display_name"`
+	JoinedAt    int64     `json:"joined_at"`
+	CompletedAt *int64    `json:"completed_at,omitempty"`
+}
+
+// EventQuestionnaire is the questionnaire of an event
+type EventQuestionnaire struct {
+	ID          uuid.UUID `json:"id"`
+	Title       string    `json:"title"`
+	IsPublished bool      `json:"is_published"`
+	ClosedAt    *int64    `json:"closed_at,omitempty"`
+}
+
+func memberEvent(m *ent.Member) EventData {
+	return EventData{Member: &EventMember{
+		ID:          m.ID,
+		DisplayName: m.DisplayName,
+		JoinedAt:    m.CreatedAt,
+		CompletedAt: m.CompletedAt,
+	}}
+}
+
+func questionnaireEvent(q *ent.Questionnaire) EventData {
+	return EventData{Questionnaire: &EventQuestionnaire{
+		ID:          q.ID,
+		Title:       q.Title,
+		IsPublished: q.IsPublished,
+		ClosedAt:    q.ClosedAt,
+	}}
+}
+
+// enqueueEvent queues a delivery of the event to every webhook of the
+// questionnaire subscribed to it. It is called with the client of the
+// transaction making the change, so the event is sent if and only if the
+// change is committed.
+func enqueueEvent(client *ent.Client, questionnaireID uuid.UUID, eventType string, data EventData, ctx context.Context) error {
+	hooks, err := client.Webhook.Query().
+		Where(webhook.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	hooks = slices.DeleteFunc(hooks, func(h *ent.Webhook) bool {
+		return !slices.Contains(h.Events, eventType)
+	})
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(Event{
+		ID:              uuid.New(),
+		Type:            eventType,
+		CreatedAt:       time.Now().UnixMilli(),
+		QuestionnaireID: questionnaireID,
+		Data:            data,
+	})
+	if err != nil {
+		return err
+	}
+	builders := make([]*ent.WebhookDeliveryCreate, len(hooks))
+	for i, h := range hooks {
+		builders[i] = client.WebhookDelivery.Create().
+			SetWebhookID(h.ID).
+			SetEvent(eventType).
+			SetPayload(payload)
+	}
+	return client.WebhookDelivery.CreateBulk(builders...).Exec(ctx)
+}
+
+// CreateWebhook subscribes url to the given events of the questionnaire. The
+// webhook is returned with its secret, the key of the signatures, which the
+// caller shows once.
+func (s *service) CreateWebhook(questionnaireID uuid.UUID, url string, events []string, ctx context.Context) (*ent.Webhook, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	events = slices.Clone(events)
+	slices.Sort(events)
+	return s.client.Webhook.Create().
+		SetQuestionnaireID(questionnaireID).
+		SetURL(url).
+		SetSecret(WebhookSecretPrefix + hex.EncodeToString(secret)).
+		SetEvents(slices.Compact(events)).
+		Save(ctx)
+}
+
+// ListWebhooks returns the webhooks of the questionnaire, oldest first
+func (s *service) ListWebhooks(questionnaireID uuid.UUID, ctx context.Context) ([]*ent.Webhook, error) {
+	return s.client.Webhook.Query().
+		Where(webhook.HasQuestionnaireWith(questionnaire.ID(questionnaireID))).
+		Order(ent.Asc(webhook.FieldCreatedAt), ent.Asc(webhook.FieldID)).
+		All(ctx)
+}
+
+// GetWebhookWithQuestionnaire returns the webhook with its questionnaire and
+// the owner of the questionnaire loaded
+func (s *service) GetWebhookWithQuestionnaire(webhookID uuid.UUID, ctx context.Context) (*ent.Webhook, error) {
+	found, err := s.client.Webhook.Query().
+		Where(webhook.ID(webhookID)).
+		WithQuestionnaire(func(q *ent.QuestionnaireQuery) { q.WithOwner() }).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrWebhookNotFound
+	}
+	return found, err
+}
+
+// DeleteWebhook deletes the webhook and its deliveries, the pending ones are
+// not sent
+func (s *service) DeleteWebhook(webhookID uuid.UUID, ctx context.Context) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	_, err = tx.WebhookDelivery.Delete().
+		Where(webhookdelivery.HasWebhookWith(webhook.ID(webhookID))).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	err = tx.Webhook.DeleteOneID(webhookID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return rollback(tx, ErrWebhookNotFound)
+	}
+	if err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+var webhookDeliverySortFields = map[string]sortField[*ent.WebhookDelivery]{
+	"created_at": createdAtField(func(d *ent.WebhookDelivery) int64 { return d.CreatedAt }),
+}
+
+// ListWebhookDeliveries returns a page of the deliveries of the webhook,
+// newest first by default
+func (s *service) ListWebhookDeliveries(webhookID uuid.UUID, req PageRequest, ctx context.Context) (Page[*ent.WebhookDelivery], error) {
+	p, err := newPageQuery(req, webhookDeliverySortFields, "created_at", "desc")
+	if err != nil {
+		return Page[*ent.WebhookDelivery]{}, err
+	}
+
+	query := s.client.WebhookDelivery.Query().
+		Where(webhookdelivery.HasWebhookWith(webhook.ID(webhookID)))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return Page[*ent.WebhookDelivery]{}, err
+	}
+	items, err := query.
+		Where(p.where).
+		Order(p.orderBy).
+		Limit(p.limit + 1).
+		All(ctx)
+	if err != nil {
+		return Page[*ent.WebhookDelivery]{}, err
+	}
+	return p.page(items, total, func(d *ent.WebhookDelivery) uuid.UUID { return d.ID }), nil
+}
+
+// RedeliverWebhookDelivery queues a new delivery of the payload of a
+// delivery of the webhook, whatever became of it. The original stays in
+// the log untouched.
+func (s *service) RedeliverWebhookDelivery(webhookID, deliveryID uuid.UUID, ctx context.Context) (*ent.WebhookDelivery, error) {
+	original, err := s.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.ID(deliveryID),
+			webhookdelivery.HasWebhookWith(webhook.ID(webhookID)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.client.WebhookDelivery.Create().
+		SetWebhookID(webhookID).
+		SetEvent(original.Event).
+		SetPayload(original.Payload).
+		Save(ctx)
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries that are
+// due, with their webhook loaded, and pushes them back by lease so no other
+// dispatcher sends them meanwhile. A delivery whose attempt is not recorded
+// within the lease, because its dispatcher died, is claimed again.
+func (s *service) ClaimWebhookDeliveries(limit int, lease time.Duration, ctx context.Context) ([]*ent.WebhookDelivery, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	now := time.Now()
+	// Skipping the rows locked by another dispatcher, which claims them
+	ids, err := tx.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now.UnixMilli()),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if len(ids) == 0 {
+		return nil, tx.Commit()
+	}
+	err = tx.WebhookDelivery.Update().
+		Where(webhookdelivery.IDIn(ids...)).
+		SetNextAttemptAt(now.Add(lease).UnixMilli()).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.client.WebhookDelivery.Query().
+		Where(webhookdelivery.IDIn(ids...)).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldCreatedAt)).
+		All(ctx)
+}
+
+// WebhookAttempt is the outcome of sending a delivery once
+type WebhookAttempt struct {
+	// At is when the attempt was made, in Unix milliseconds
+	At int64
+	// StatusCode is the status of the response, 0 when none came
+	StatusCode int
+	// Error tells why the attempt failed
+	Error string
+	// Succeeded is set when the receiver accepted the delivery
+	Succeeded bool
+	// RetryAt is when to try a failed delivery again, in Unix
+	// milliseconds. Zero gives up on it.
+	RetryAt int64
+}
+
+// RecordWebhookAttempt records an attempt to send the delivery. It stays
+// pending until RetryAt when the attempt failed and is retried.
+func (s *service) RecordWebhookAttempt(deliveryID uuid.UUID, attempt WebhookAttempt, ctx context.Context) error {
+	update := s.client.WebhookDelivery.UpdateOneID(deliveryID).
+		AddAttempts(1).
+		SetLastAttemptAt(attempt.At).
+		SetError(attempt.Error)
+	if attempt.StatusCode != 0 {
+		update.SetResponseStatus(attempt.StatusCode)
+	} else {
+		update.ClearResponseStatus()
+	}
+	switch {
+	case attempt.Succeeded:
+		update.SetStatus(webhookdelivery.StatusSucceeded)
+	case attempt.RetryAt != 0:
+		update.SetNextAttemptAt(attempt.RetryAt)
+	default:
+		update.SetStatus(webhookdelivery.StatusFailed)
+	}
+	return update.Exec(ctx)
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/ent/webhookdelivery"
+
+	"github.com/google/uuid"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	srv := mustOpen(t)
+	ctx := context.Background()
+	f := newFixture(t, srv)
+
+	owner := f.user("Hooked")
+	q := f.questionnaire(owner.ID, "Pizza night", false)
+	hook, err := srv.CreateWebhook(q.ID, "https://example.com/hook", []string{EventMemberJoined, EventQuestionnaireClosed, EventMemberJoined}, ctx)
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+	if len(hook.Events) != 2 || len(hook.Secret) < len(WebhookSecretPrefix)+32 {
+		t.Errorf("CreateWebhook() = %+v, want the events deduplicated and a secret", hook)
+	}
+
+	// Published is not subscribed to
+	if _, err := srv.PublishQuestionnaire(q.ID, owner.ID, ctx); err != nil {
+		t.Fatalf("PublishQuestionnaire() error = %v", err)
+	}
+	alice := f.member(owner.ID, q.ID, "Alice")
+	if _, err := srv.CloseQuestionnaire(q.ID, ctx); err != nil {
+		t.Fatalf("CloseQuestionnaire() error = %v", err)
+	}
+	if _, err := srv.CloseQuestionnaire(q.ID, ctx); !errors.Is(err, ErrQuestionnaireClosed) {
+		t.Errorf("CloseQuestionnaire() twice error = %v, want ErrQuestionnaireClosed", err)
+	}
+	if _, _, err := srv.CreateAnonymousMember(q.ID, uniqueName("Bob"), "Bob", ctx); !errors.Is(err, ErrQuestionnaireClosed) {
+		t.Errorf("CreateAnonymousMember() once closed error = %v, want ErrQuestionnaireClosed", err)
+	}
+
+	page, err := srv.ListWebhookDeliveries(hook.ID, PageRequest{Order: "asc"}, ctx)
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveries() error = %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Event != EventMemberJoined || page.Items[1].Event != EventQuestionnaireClosed {
+		t.Fatalf("deliveries = %v, want member.joined then questionnaire.closed", page.Items)
+	}
+	var event Event
+	if err := json.Unmarshal(page.Items[0].Payload, &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.QuestionnaireID != q.ID || event.Data.Member == nil || event.Data.Member.ID != alice.ID {
+		t.Errorf("payload = %s, want Alice joining the questionnaire", page.Items[0].Payload)
+	}
+
+	// Claimed deliveries are leased, a second claim skips them
+	claimed := claimOf(t, srv, hook.ID, time.Minute)
+	if len(claimed) != 2 || claimed[0].Edges.Webhook == nil {
+		t.Fatalf("claimed = %v, want both deliveries with their webhook", claimed)
+	}
+	if again := claimOf(t, srv, hook.ID, time.Minute); len(again) != 0 {
+		t.Errorf("claimed again = %v, want none while leased", again)
+	}
+
+	joined := page.Items[0]
+	err = srv.RecordWebhookAttempt(joined.ID, WebhookAttempt{At: time.Now().UnixMilli(), StatusCode: 503, Error: "try later", RetryAt: time.Now().UnixMilli()}, ctx)
+	if err != nil {
+		t.Fatalf("RecordWebhookAttempt() error = %v", err)
+	}
+	if retried := claimOf(t, srv, hook.ID, time.Minute); len(retried) != 1 || retried[0].ID != joined.ID || retried[0].Attempts != 1 {
+		t.Errorf("claimed after a retry = %v, want the failed delivery", retried)
+	}
+	if err := srv.RecordWebhookAttempt(joined.ID, WebhookAttempt{At: time.Now().UnixMilli(), StatusCode: 200, Succeeded: true}, ctx); err != nil {
+		t.Fatalf("RecordWebhookAttempt() error = %v", err)
+	}
+
+	redelivered, err := srv.RedeliverWebhookDelivery(hook.ID, joined.ID, ctx)
+	if err != nil {
+		t.Fatalf("RedeliverWebhookDelivery() error = %v", err)
+	}
+	if redelivered.ID == joined.ID || redelivered.Status != webhookdelivery.StatusPending || string(redelivered.Payload) != string(joined.Payload) {
+		t.Errorf("RedeliverWebhookDelivery() = %+v, want a new pending delivery of the same payload", redelivered)
+	}
+	if _, err := srv.RedeliverWebhookDelivery(uuid.New(), joined.ID, ctx); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RedeliverWebhookDelivery() of another webhook error = %v, want ErrNotFound", err)
+	}
+
+	if err := srv.DeleteQuestionnaire(q.ID, ctx); err != nil {
+		t.Fatalf("DeleteQuestionnaire() error = %v", err)
+	}
+	if _, err := srv.GetWebhookWithQuestionnaire(hook.ID, ctx); !errors.Is(err, ErrWebhookNotFound) {
+		t.Errorf("GetWebhookWithQuestionnaire() after deleting the questionnaire error = %v, want ErrWebhookNotFound", err)
+	}
+}
+
+// claimOf claims the due deliveries and keeps those of the webhook, the
+// other tests may queue some too
+func claimOf(t *testing.T, srv Service, webhookID uuid.UUID, lease time.Duration) []*ent.WebhookDelivery {
+	t.Helper()
+	claimed, err := srv.ClaimWebhookDeliveries(100, lease, context.Background())
+	if err != nil {
+		t.Fatalf("ClaimWebhookDeliveries() error = %v", err)
+	}
+	var own []*ent.WebhookDelivery
+	for _, d := range claimed {
+		if d.Edges.Webhook.ID == webhookID {
+			own = append(own, d)
+		}
+	}
+	return own
+}
@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/intercept"
+	"radgifa/ent/member"
+	"radgifa/ent/predicate"
+	"radgifa/ent/question"
+	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
+	"radgifa/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// Viewer is whom a GraphQL request is made for: a user, or an anonymous
+// member of a questionnaire. Exactly one of the IDs is set.
+type Viewer struct {
+	UserID   uuid.UUID
+	MemberID uuid.UUID
+	// Scopes limits the mutations of a personal access token, see
+	// database.Scopes. It is nil for a session, which may do anything.
+	Scopes []string
+}
+
+// allows tells whether the token of v has scope
+func (v *Viewer) allows(scope string) bool {
+	return v.Scopes == nil || slices.Contains(v.Scopes, scope)
+}
+
+type viewerKey struct{}
+
+// restricted holds the clients restrict installed its interceptors on
+var restricted sync.Map
+
+// NewContext returns a context the queries made with are restricted to
+// what v may see
+func NewContext(ctx context.Context, v Viewer) context.Context {
+	return context.WithValue(ctx, viewerKey{}, &v)
+}
+
+// viewerFrom returns the viewer of ctx, nil when its queries are not
+// restricted
+func viewerFrom(ctx context.Context) *Viewer {
+	v, _ := ctx.Value(viewerKey{}).(*Viewer)
+	return v
+}
+
+// unrestricted lifts the restrictions of the viewer, for the service calls
+// of the mutations, which check the permissions themselves like the REST
+// handlers, and for the permission checks
+func unrestricted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, viewerKey{}, (*Viewer)(nil))
+}
+
+// restrict makes every query of client made with a viewer in its context
+// only return the rows the REST API shows them: the questionnaires they own
+// or joined with their content, the members and answers of the
+// questionnaires they own, and otherwise only their own membership and
+// answers. The predicates apply to the queries traversing an edge and to
+// the eager loading too. Queries without a viewer, those of the REST API,
+// are left alone. The interceptors are only installed once per client,
+// however many handlers share it.
+func restrict(client *ent.Client) {
+	if _, done := restricted.LoadOrStore(client, true); done {
+		return
+	}
+	client.Questionnaire.Intercept(intercept.TraverseQuestionnaire(func(ctx context.Context, q *ent.QuestionnaireQuery) error {
+		if v := viewerFrom(ctx); v != nil {
+			q.Where(visibleQuestionnaire(v))
+		}
+		return nil
+	}))
+	client.Question.Intercept(intercept.TraverseQuestion(func(ctx context.Context, q *ent.QuestionQuery) error {
+		if v := viewerFrom(ctx); v != nil {
+			q.Where(question.HasQuestionnaireWith(visibleQuestionnaire(v)))
+		}
+		return nil
+	}))
+	client.Section.Intercept(intercept.TraverseSection(func(ctx context.Context, q *ent.SectionQuery) error {
+		if v := viewerFrom(ctx); v != nil {
+			q.Where(section.HasQuestionnaireWith(visibleQuestionnaire(v)))
+		}
+		return nil
+	}))
+	client.Rule.Intercept(intercept.TraverseRule(func(ctx context.Context, q *ent.RuleQuery) error {
+		if v := viewerFrom(ctx); v != nil {
+			q.Where(rule.HasQuestionWith(question.HasQuestionnaireWith(visibleQuestionnaire(v))))
+		}
+		return nil
+	}))
+	client.Member.Intercept(intercept.TraverseMember(func(ctx context.Context, q *ent.MemberQuery) error {
+		if v := viewerFrom(ctx); v != nil {
+			q.Where(visibleMember(v))
+		}
+		return nil
+	}))
+	client.Answer.Intercept(intercept.TraverseAnswer(func(ctx context.Context, q *ent.AnswerQuery) error {
+		if v := viewerFrom(ctx); v != nil {
+			q.Where(answer.HasMemberWith(visibleMember(v)))
+		}
+		return nil
+	}))
+	client.User.Intercept(intercept.TraverseUser(func(ctx context.Context, q *ent.UserQuery) error {
+		if v := viewerFrom(ctx); v != nil {
+			q.Where(visibleUser(v))
+		}
+		return nil
+	}))
+}
+
+// visibleQuestionnaire matches the questionnaires v owns or is a member of
+func visibleQuestionnaire(v *Viewer) predicate.Questionnaire {
+	if v.MemberID != uuid.Nil {
+		return questionnaire.HasMembersWith(member.ID(v.MemberID))
+	}
+	return questionnaire.Or(
+		questionnaire.HasOwnerWith(user.ID(v.UserID)),
+		questionnaire.HasMembersWith(member.HasUserWith(user.ID(v.UserID))),
+	)
+}
+
+// visibleMember matches v as a member and the members of the questionnaires
+// v owns
+func visibleMember(v *Viewer) predicate.Member {
+	if v.MemberID != uuid.Nil {
+		return member.ID(v.MemberID)
+	}
+	return member.Or(
+		member.HasUserWith(user.ID(v.UserID)),
+		member.HasQuestionnaireWith(questionnaire.HasOwnerWith(user.ID(v.UserID))),
+	)
+}
+
+// visibleUser matches v, the owners of the questionnaires v sees and the
+// users behind the members v sees
+func visibleUser(v *Viewer) predicate.User {
+	return user.Or(
+		user.ID(v.UserID),
+		user.HasQuestionnairesWith(visibleQuestionnaire(v)),
+		user.HasMembershipsWith(visibleMember(v)),
+	)
+}
+
+// ownsQuestionnaireOf tells whether v owns a questionnaire matching ps
+func ownsQuestionnaireOf(ctx context.Context, client *ent.Client, v *Viewer, ps ...predicate.Questionnaire) (bool, error) {
+	if v.UserID == uuid.Nil {
+		return false, nil
+	}
+	return client.Questionnaire.Query().
+		Where(questionnaire.HasOwnerWith(user.ID(v.UserID))).
+		Where(ps...).
+		Exist(unrestricted(ctx))
+}
@@ -0,0 +1,152 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/ent/questionnaire"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// recorder is a driver recording the queries it is given and returning no
+// rows, enough to see the predicates restrict adds
+type recorder struct {
+	queries []string
+	args    [][]any
+}
+
+func (r *recorder) Exec(ctx context.Context, query string, args, v any) error {
+	return errors.New("recorder: no writes")
+}
+
+func (r *recorder) Query(ctx context.Context, query string, args, v any) error {
+	r.queries = append(r.queries, query)
+	r.args = append(r.args, args.([]any))
+	*v.(*entsql.Rows) = entsql.Rows{ColumnScanner: noRows{}}
+	return nil
+}
+
+func (r *recorder) Tx(context.Context) (dialect.Tx, error) {
+	return nil, errors.New("recorder: no transactions")
+}
+func (r *recorder) Close() error    { return nil }
+func (r *recorder) Dialect() string { return dialect.Postgres }
+
+type noRows struct{}
+
+func (noRows) Close() error                            { return nil }
+func (noRows) ColumnTypes() ([]*sql.ColumnType, error) { return nil, nil }
+func (noRows) Columns() ([]string, error)              { return nil, nil }
+func (noRows) Err() error                              { return nil }
+func (noRows) Next() bool                              { return false }
+func (noRows) NextResultSet() bool                     { return false }
+func (noRows) Scan(...any) error                       { return nil }
+
+func newRestrictedClient(t *testing.T) (*ent.Client, *recorder) {
+	t.Helper()
+	rec := new(recorder)
+	client := ent.NewClient(ent.Driver(rec))
+	restrict(client)
+	return client, rec
+}
+
+func TestRestrictQuestionnaires(t *testing.T) {
+	userID, memberID := uuid.New(), uuid.New()
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantArgs []any
+	}{
+		{"user", NewContext(context.Background(), Viewer{UserID: userID}), []any{userID, userID}},
+		{"member", NewContext(context.Background(), Viewer{MemberID: memberID}), []any{memberID}},
+		{"no viewer", context.Background(), nil},
+		{"unrestricted", unrestricted(NewContext(context.Background(), Viewer{UserID: userID})), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, rec := newRestrictedClient(t)
+			if _, err := client.Questionnaire.Query().All(tt.ctx); err != nil {
+				t.Fatal(err)
+			}
+			if len(rec.queries) != 1 {
+				t.Fatalf("%d queries, want 1", len(rec.queries))
+			}
+			if !slices.Equal(rec.args[0], tt.wantArgs) {
+				t.Errorf("query %q args = %v, want %v", rec.queries[0], rec.args[0], tt.wantArgs)
+			}
+			if tt.wantArgs == nil && strings.Contains(rec.queries[0], "WHERE") {
+				t.Errorf("query %q is restricted", rec.queries[0])
+			}
+		})
+	}
+}
+
+func TestRestrictOnce(t *testing.T) {
+	client, rec := newRestrictedClient(t)
+	// A second handler over the same client
+	restrict(client)
+
+	memberID := uuid.New()
+	if _, err := client.Questionnaire.Query().All(NewContext(context.Background(), Viewer{MemberID: memberID})); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(rec.args[0], []any{memberID}) {
+		t.Errorf("query %q args = %v, want the restriction once", rec.queries[0], rec.args[0])
+	}
+}
+
+func TestRestrictTraversal(t *testing.T) {
+	client, rec := newRestrictedClient(t)
+	memberID, questionnaireID := uuid.New(), uuid.New()
+	ctx := NewContext(context.Background(), Viewer{MemberID: memberID})
+
+	// Both the questionnaire traversed and the members reached are
+	// restricted: a member only sees themselves
+	_, err := client.Questionnaire.Query().
+		Where(questionnaire.ID(questionnaireID)).
+		QueryMembers().
+		All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.queries) != 1 {
+		t.Fatalf("%d queries, want 1", len(rec.queries))
+	}
+	var n int
+	for _, arg := range rec.args[0] {
+		if arg == memberID {
+			n++
+		}
+	}
+	if n != 2 {
+		t.Errorf("query %q args = %v, want the member twice", rec.queries[0], rec.args[0])
+	}
+}
+
+func TestNodeNotVisibleIsNotFound(t *testing.T) {
+	client, rec := newRestrictedClient(t)
+	r := &Resolver{client: client}
+	ctx := NewContext(context.Background(), Viewer{UserID: uuid.New()})
+
+	_, err := client.Noder(ctx, uuid.New(), ent.WithNodeType(r.nodeType))
+	if !ent.IsNotFound(err) {
+		t.Fatalf("Noder() error = %v, want not found", err)
+	}
+	// One lookup per type, each restricted to the viewer
+	if len(rec.queries) != 7 {
+		t.Errorf("%d lookups, want 7", len(rec.queries))
+	}
+	for i, q := range rec.queries {
+		if len(rec.args[i]) < 2 {
+			t.Errorf("lookup %q args = %v, want the ID and the viewer", q, rec.args[i])
+		}
+	}
+}
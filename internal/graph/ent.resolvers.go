@@ -0,0 +1,37 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.68
+
+import (
+	"context"
+	"radgifa/ent"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+)
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
+	return r.client.Noder(ctx, id, ent.WithNodeType(r.nodeType))
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []uuid.UUID) ([]ent.Noder, error) {
+	return r.client.Noders(ctx, ids, ent.WithNodeType(r.nodeType))
+}
+
+// Questionnaires is the resolver for the questionnaires field.
+func (r *queryResolver) Questionnaires(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.QuestionnaireOrder, where *ent.QuestionnaireWhereInput) (*ent.QuestionnaireConnection, error) {
+	return r.client.Questionnaire.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithQuestionnaireOrder(orderBy),
+			ent.WithQuestionnaireFilter(where.Filter),
+		)
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
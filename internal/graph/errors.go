@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+)
+
+// The codes of the errors, under extensions.code, are those of the problems
+// of the REST API
+const (
+	codeUnauthorized           = "unauthorized"
+	codeForbidden              = "forbidden"
+	codeNotFound               = "not_found"
+	codeConflict               = "conflict"
+	codeInvalid                = "bad_request"
+	codeQuestionnairePublished = "questionnaire_published"
+	codeInsufficientScope      = "insufficient_scope"
+	codeInternal               = "internal_error"
+)
+
+// newError returns an error reported to the client with code
+func newError(code, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message:    message,
+		Extensions: map[string]any{"code": code},
+	}
+}
+
+// errorPresenter reports the domain errors with their code and hides the
+// others, which are logged
+func errorPresenter(logger *zap.Logger) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		var (
+			gqlErr  *gqlerror.Error
+			missing *database.MissingAnswersError
+			domain  *database.Error
+		)
+		switch {
+		case errors.As(err, &missing):
+			ids := make([]string, len(missing.QuestionIDs))
+			for i, id := range missing.QuestionIDs {
+				ids[i] = id.String()
+			}
+			gqlErr = newError(database.ErrMissingRequiredAnswers.Code, err.Error())
+			gqlErr.Extensions["missingQuestionIDs"] = ids
+		case errors.As(err, &domain):
+			gqlErr = newError(domain.Code, err.Error())
+		case ent.IsNotFound(err):
+			gqlErr = newError(codeNotFound, "not found")
+		case errors.As(err, &gqlErr):
+			// Raised by a resolver, or by gqlgen for an invalid argument
+			cp := *gqlErr
+			gqlErr = &cp
+		default:
+			logger.Error("graphql resolver failed", zap.Error(err))
+			gqlErr = newError(codeInternal, "internal server error")
+		}
+		if gqlErr.Path == nil {
+			gqlErr.Path = graphql.GetPath(ctx)
+		}
+		return gqlErr
+	}
+}
@@ -0,0 +1,35 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateQuestionInput struct {
+	QuestionnaireID uuid.UUID  `json:"questionnaireID"`
+	SectionID       *uuid.UUID `json:"sectionID,omitempty"`
+	Text            string     `json:"text"`
+	Required        *bool      `json:"required,omitempty"`
+	// Inserts the question at this position, appends it when null
+	Position *int `json:"position,omitempty"`
+}
+
+type CreateQuestionnaireInput struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+	// Members cannot change their answers once they submitted
+	LockAfterSubmit *bool `json:"lockAfterSubmit,omitempty"`
+}
+
+type UpdateQuestionInput struct {
+	SectionID *uuid.UUID `json:"sectionID,omitempty"`
+	Text      string     `json:"text"`
+	Required  *bool      `json:"required,omitempty"`
+}
+
+type UpdateQuestionnaireInput struct {
+	Title           string  `json:"title"`
+	Description     *string `json:"description,omitempty"`
+	LockAfterSubmit *bool   `json:"lockAfterSubmit,omitempty"`
+}
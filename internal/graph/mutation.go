@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+// The mutations check what the REST handlers check, in the same order, and
+// call the service with the restrictions of the viewer lifted: they would
+// otherwise hide the rows the service reads on its own behalf.
+
+// userOf returns the ID of the viewer when they are a user who may edit
+// questionnaires
+func userOf(ctx context.Context) (uuid.UUID, error) {
+	v := viewerFrom(ctx)
+	if v == nil || v.UserID == uuid.Nil {
+		return uuid.Nil, newError(codeUnauthorized, "only users can edit questionnaires")
+	}
+	if !v.allows(database.ScopeQuestionnaires) {
+		return uuid.Nil, newError(codeInsufficientScope, "the access token lacks the scope "+database.ScopeQuestionnaires)
+	}
+	return v.UserID, nil
+}
+
+// ownedQuestionnaire returns the questionnaire of the given ID when userID
+// owns it. Unless published is set, it must not be published yet.
+func (r *Resolver) ownedQuestionnaire(ctx context.Context, id, userID uuid.UUID, published bool) (*ent.Questionnaire, error) {
+	ctx = unrestricted(ctx)
+	q, err := r.service.GetQuestionnaire(id, ctx)
+	if err != nil {
+		return nil, newError(codeNotFound, "questionnaire not found")
+	}
+	owner, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if owner.ID != userID {
+		return nil, newError(codeForbidden, "not authorized to edit this questionnaire")
+	}
+	if q.IsPublished && !published {
+		return nil, newError(codeQuestionnairePublished, "cannot edit a published questionnaire")
+	}
+	return q, nil
+}
+
+// ownedQuestion returns the question of the given ID when userID owns its
+// questionnaire, which is not published yet
+func (r *Resolver) ownedQuestion(ctx context.Context, id, userID uuid.UUID) (*ent.Question, error) {
+	question, err := r.service.GetQuestionWithQuestionnaire(id, unrestricted(ctx))
+	if err != nil {
+		return nil, newError(codeNotFound, "question not found")
+	}
+	if _, err := r.ownedQuestionnaire(ctx, question.Edges.Questionnaire.ID, userID, false); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// membershipOf returns the ID of the member the viewer is in the
+// questionnaire of the given ID: themselves for a member, their membership
+// for a user
+func (r *Resolver) membershipOf(ctx context.Context, questionnaireID uuid.UUID) (uuid.UUID, error) {
+	v := viewerFrom(ctx)
+	if !v.allows(database.ScopeAnswers) {
+		return uuid.Nil, newError(codeInsufficientScope, "the access token lacks the scope "+database.ScopeAnswers)
+	}
+	ctx = unrestricted(ctx)
+	if v.MemberID != uuid.Nil {
+		m, err := r.service.GetMemberWithQuestionnaire(v.MemberID, ctx)
+		if err != nil {
+			return uuid.Nil, newError(codeNotFound, "member not found")
+		}
+		if m.Edges.Questionnaire.ID != questionnaireID {
+			return uuid.Nil, newError(codeForbidden, "not a member of this questionnaire")
+		}
+		return m.ID, nil
+	}
+	m, err := r.service.GetMemberByUserAndQuestionnaire(v.UserID, questionnaireID, ctx)
+	if err != nil {
+		return uuid.Nil, newError(codeForbidden, "not a member of this questionnaire")
+	}
+	return m.ID, nil
+}
+
+// questionnaireFields sanitizes and validates the editable fields of a
+// questionnaire with the validator of the REST requests
+func (r *Resolver) questionnaireFields(title string, description *string) (string, string, error) {
+	title, desc, err := r.validator.Questionnaire(title, deref(description))
+	if err != nil {
+		return "", "", newError(codeInvalid, err.Error())
+	}
+	return title, desc, nil
+}
+
+// questionFields sanitizes and validates the text and position of a question
+func (r *Resolver) questionFields(text string, position *int) (string, error) {
+	text, err := r.validator.Question(text, position)
+	if err != nil {
+		return "", newError(codeInvalid, err.Error())
+	}
+	return text, nil
+}
+
+// versionMismatch reports the conflicting updates like the REST API, which
+// answers them with a 412
+func versionMismatch(err error) error {
+	if errors.Is(err, database.ErrVersionMismatch) {
+		return newError(database.ErrVersionMismatch.Code, "modified since it was read")
+	}
+	return err
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
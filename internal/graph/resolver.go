@@ -0,0 +1,189 @@
+// Package graph serves the GraphQL API. Its types, their connections and
+// filters are generated from the Ent schema into ent.graphql, see
+// ent/entc.go; schema.graphql adds the mutations, which are those of the
+// REST API. Every query runs with the Viewer of the request in its context,
+// which restricts the rows it returns to those the REST API shows the
+// viewer, and the @private fields are checked one by one.
+package graph
+
+import (
+	"context"
+	"net/http"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/ent/member"
+	"radgifa/ent/question"
+	"radgifa/ent/questionnaire"
+	"radgifa/ent/rule"
+	"radgifa/ent/section"
+	"radgifa/ent/user"
+	"radgifa/internal/database"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// ComplexityLimit bounds the cost of a query, every field counting for
+	// one and a connection for the size of its page times its fields, so a
+	// nested query cannot fetch the whole database at once
+	ComplexityLimit = 5000
+	// unboundedPage is the size counted for a connection asked without
+	// first nor last
+	unboundedPage = 100
+)
+
+// Validator checks and sanitizes the inputs of the mutations. The REST API
+// passes the one of its requests, so that both accept the same texts.
+type Validator interface {
+	// Questionnaire returns the title and description to save
+	Questionnaire(title, description string) (string, string, error)
+	// Question returns the text to save, position is nil when appending
+	Question(text string, position *int) (string, error)
+}
+
+// Resolver resolves the queries with the ent client of the service and the
+// mutations with the service itself
+type Resolver struct {
+	service   database.Service
+	client    *ent.Client
+	validator Validator
+}
+
+// NewHandler returns the handler of the GraphQL endpoint. It restricts the
+// queries of the client of service made on behalf of a viewer, see
+// NewContext, and expects every request to carry one. The interceptors are
+// installed on the client here, so the handler must be built before the
+// client serves any query.
+func NewHandler(service database.Service, logger *zap.Logger, validator Validator) http.Handler {
+	client := service.Client()
+	restrict(client)
+
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  &Resolver{service: service, client: client, validator: validator},
+		Directives: DirectiveRoot{Private: private(client)},
+		Complexity: complexity(),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(ComplexityLimit))
+	srv.SetErrorPresenter(errorPresenter(logger))
+	srv.SetRecoverFunc(func(ctx context.Context, err any) error {
+		logger.Error("graphql resolver panicked", zap.Any("panic", err))
+		return newError(codeInternal, "internal server error")
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if viewerFrom(r.Context()) == nil {
+			http.Error(w, "no viewer", http.StatusUnauthorized)
+			return
+		}
+		srv.ServeHTTP(w, r)
+	})
+}
+
+// complexity counts the connections for the size of their page
+func complexity() ComplexityRoot {
+	var c ComplexityRoot
+	c.Query.Questionnaires = func(child int, _ *entgql.Cursor[uuid.UUID], first *int, _ *entgql.Cursor[uuid.UUID], last *int, _ *ent.QuestionnaireOrder, _ *ent.QuestionnaireWhereInput) int {
+		return pageComplexity(child, first, last)
+	}
+	c.User.Questionnaires = func(child int, _ *entgql.Cursor[uuid.UUID], first *int, _ *entgql.Cursor[uuid.UUID], last *int, _ *ent.QuestionnaireOrder) int {
+		return pageComplexity(child, first, last)
+	}
+	c.User.Memberships = func(child int, _ *entgql.Cursor[uuid.UUID], first *int, _ *entgql.Cursor[uuid.UUID], last *int, _ *ent.MemberOrder) int {
+		return pageComplexity(child, first, last)
+	}
+	c.Questionnaire.Members = func(child int, _ *entgql.Cursor[uuid.UUID], first *int, _ *entgql.Cursor[uuid.UUID], last *int, _ *ent.MemberOrder) int {
+		return pageComplexity(child, first, last)
+	}
+	c.Questionnaire.Questions = func(child int, _ *entgql.Cursor[uuid.UUID], first *int, _ *entgql.Cursor[uuid.UUID], last *int, _ *ent.QuestionOrder, _ *ent.QuestionWhereInput) int {
+		return pageComplexity(child, first, last)
+	}
+	c.Question.Answers = func(child int, _ *entgql.Cursor[uuid.UUID], first *int, _ *entgql.Cursor[uuid.UUID], last *int, _ *ent.AnswerOrder) int {
+		return pageComplexity(child, first, last)
+	}
+	c.Member.Answers = func(child int, _ *entgql.Cursor[uuid.UUID], first *int, _ *entgql.Cursor[uuid.UUID], last *int, _ *ent.AnswerOrder, _ *ent.AnswerWhereInput) int {
+		return pageComplexity(child, first, last)
+	}
+	return c
+}
+
+func pageComplexity(child int, first, last *int) int {
+	size := unboundedPage
+	switch {
+	case first != nil:
+		size = *first
+	case last != nil:
+		size = *last
+	}
+	return 1 + child*max(size, 1)
+}
+
+// private lets a viewer read a @private field only when it concerns them:
+// the name of a user is for themselves and the owners of the questionnaires
+// they joined, the identifier of a member for the owner of its questionnaire
+func private(client *ent.Client) func(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	return func(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+		v := viewerFrom(ctx)
+		if v == nil {
+			return next(ctx)
+		}
+		var (
+			allowed bool
+			err     error
+		)
+		switch obj := obj.(type) {
+		case *ent.User:
+			allowed = obj.ID == v.UserID
+			if !allowed {
+				allowed, err = ownsQuestionnaireOf(ctx, client, v,
+					questionnaire.HasMembersWith(member.HasUserWith(user.ID(obj.ID))))
+			}
+		case *ent.Member:
+			allowed, err = ownsQuestionnaireOf(ctx, client, v,
+				questionnaire.HasMembersWith(member.ID(obj.ID)))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, newError(codeForbidden, "not authorized to read this field")
+		}
+		return next(ctx)
+	}
+}
+
+// nodeType finds the table of the node with the given ID. The IDs are UUIDs
+// that do not tell, and the lookups go through the restrictions of the
+// viewer, so a node they may not see is not found.
+func (r *Resolver) nodeType(ctx context.Context, id uuid.UUID) (string, error) {
+	lookups := []struct {
+		table string
+		exist func(context.Context) (bool, error)
+	}{
+		{questionnaire.Table, r.client.Questionnaire.Query().Where(questionnaire.ID(id)).Exist},
+		{question.Table, r.client.Question.Query().Where(question.ID(id)).Exist},
+		{section.Table, r.client.Section.Query().Where(section.ID(id)).Exist},
+		{rule.Table, r.client.Rule.Query().Where(rule.ID(id)).Exist},
+		{member.Table, r.client.Member.Query().Where(member.ID(id)).Exist},
+		{answer.Table, r.client.Answer.Query().Where(answer.ID(id)).Exist},
+		{user.Table, r.client.User.Query().Where(user.ID(id)).Exist},
+	}
+	for _, l := range lookups {
+		ok, err := l.exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return l.table, nil
+		}
+	}
+	return "", &ent.NotFoundError{}
+}
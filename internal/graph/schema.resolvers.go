@@ -0,0 +1,161 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.68
+
+import (
+	"context"
+	"radgifa/ent"
+	"radgifa/ent/answer"
+
+	"github.com/google/uuid"
+)
+
+// CreateQuestionnaire is the resolver for the createQuestionnaire field.
+func (r *mutationResolver) CreateQuestionnaire(ctx context.Context, input CreateQuestionnaireInput) (*ent.Questionnaire, error) {
+	userID, err := userOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	title, description, err := r.questionnaireFields(input.Title, input.Description)
+	if err != nil {
+		return nil, err
+	}
+	return r.service.CreateQuestionnaire(userID, title, description, deref(input.LockAfterSubmit), unrestricted(ctx))
+}
+
+// UpdateQuestionnaire is the resolver for the updateQuestionnaire field.
+func (r *mutationResolver) UpdateQuestionnaire(ctx context.Context, id uuid.UUID, input UpdateQuestionnaireInput, version *int) (*ent.Questionnaire, error) {
+	userID, err := userOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	title, description, err := r.questionnaireFields(input.Title, input.Description)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.ownedQuestionnaire(ctx, id, userID, false); err != nil {
+		return nil, err
+	}
+	q, err := r.service.UpdateQuestionnaire(id, title, description, deref(input.LockAfterSubmit), deref(version), unrestricted(ctx))
+	return q, versionMismatch(err)
+}
+
+// PublishQuestionnaire is the resolver for the publishQuestionnaire field.
+func (r *mutationResolver) PublishQuestionnaire(ctx context.Context, id uuid.UUID) (*ent.Questionnaire, error) {
+	userID, err := userOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q, err := r.ownedQuestionnaire(ctx, id, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	if q.IsPublished {
+		return nil, newError(codeConflict, "questionnaire is already published")
+	}
+	return r.service.PublishQuestionnaire(id, userID, unrestricted(ctx))
+}
+
+// DeleteQuestionnaire is the resolver for the deleteQuestionnaire field.
+func (r *mutationResolver) DeleteQuestionnaire(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	userID, err := userOf(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := r.ownedQuestionnaire(ctx, id, userID, false); err != nil {
+		return uuid.Nil, err
+	}
+	if err := r.service.DeleteQuestionnaire(id, unrestricted(ctx)); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// CreateQuestion is the resolver for the createQuestion field.
+func (r *mutationResolver) CreateQuestion(ctx context.Context, input CreateQuestionInput) (*ent.Question, error) {
+	userID, err := userOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	text, err := r.questionFields(input.Text, input.Position)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.ownedQuestionnaire(ctx, input.QuestionnaireID, userID, false); err != nil {
+		return nil, err
+	}
+	if input.Position != nil {
+		return r.service.InsertQuestion(input.QuestionnaireID, text, input.SectionID, deref(input.Required), *input.Position, unrestricted(ctx))
+	}
+	return r.service.CreateNewQuestion(input.QuestionnaireID, text, input.SectionID, deref(input.Required), unrestricted(ctx))
+}
+
+// UpdateQuestion is the resolver for the updateQuestion field.
+func (r *mutationResolver) UpdateQuestion(ctx context.Context, id uuid.UUID, input UpdateQuestionInput, version *int) (*ent.Question, error) {
+	userID, err := userOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	text, err := r.questionFields(input.Text, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.ownedQuestion(ctx, id, userID); err != nil {
+		return nil, err
+	}
+	q, err := r.service.UpdateQuestion(id, text, input.SectionID, deref(input.Required), deref(version), unrestricted(ctx))
+	return q, versionMismatch(err)
+}
+
+// DeleteQuestion is the resolver for the deleteQuestion field.
+func (r *mutationResolver) DeleteQuestion(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	userID, err := userOf(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := r.ownedQuestion(ctx, id, userID); err != nil {
+		return uuid.Nil, err
+	}
+	if err := r.service.DeleteQuestion(id, unrestricted(ctx)); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// AnswerQuestion is the resolver for the answerQuestion field.
+func (r *mutationResolver) AnswerQuestion(ctx context.Context, questionID uuid.UUID, value answer.AnswerValue) (*ent.Answer, error) {
+	question, err := r.service.GetQuestionWithQuestionnaire(questionID, unrestricted(ctx))
+	if err != nil {
+		return nil, newError(codeNotFound, "question not found")
+	}
+	memberID, err := r.membershipOf(ctx, question.Edges.Questionnaire.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.service.CreateAnswer(memberID, questionID, value.String(), unrestricted(ctx))
+}
+
+// SubmitQuestionnaire is the resolver for the submitQuestionnaire field.
+func (r *mutationResolver) SubmitQuestionnaire(ctx context.Context, questionnaireID uuid.UUID) (*ent.Member, error) {
+	memberID, err := r.membershipOf(ctx, questionnaireID)
+	if err != nil {
+		return nil, err
+	}
+	return r.service.SubmitQuestionnaire(memberID, unrestricted(ctx))
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*ent.User, error) {
+	v := viewerFrom(ctx)
+	if v == nil || v.UserID == uuid.Nil {
+		return nil, nil
+	}
+	return r.client.User.Get(ctx, v.UserID)
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
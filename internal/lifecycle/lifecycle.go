@@ -0,0 +1,109 @@
+// Package lifecycle stops the components of the application in dependency
+// order when it shuts down.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// StopFunc stops a component. It must return once ctx is done, reporting
+// ctx.Err() if the component could not stop in time.
+type StopFunc func(ctx context.Context) error
+
+type component struct {
+	name string
+	stop StopFunc
+}
+
+// Manager keeps the components of the application. Components are
+// registered once their dependencies are, and are stopped in reverse order,
+// so the HTTP server stops before the stores it uses.
+type Manager struct {
+	mu         sync.Mutex
+	components []component
+}
+
+// New returns an empty Manager
+func New() *Manager {
+	return &Manager{}
+}
+
+// Register adds a component stopped by stop
+func (m *Manager) Register(name string, stop StopFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.components = append(m.components, component{name: name, stop: stop})
+}
+
+// RegisterCloser adds a component whose Close method does not take a
+// context, such as a connection pool
+func (m *Manager) RegisterCloser(name string, close func() error) {
+	m.Register(name, func(context.Context) error {
+		return close()
+	})
+}
+
+// Go runs a background worker until shutdown. The worker must return once
+// its context is cancelled; shutdown waits for it until the deadline.
+func (m *Manager) Go(name string, run func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run(ctx)
+	}()
+
+	m.Register(name, func(stopCtx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+		case <-stopCtx.Done():
+			return stopCtx.Err()
+		}
+	})
+}
+
+// Result is the outcome of stopping one component
+type Result struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+// Report lists the components in the order they were stopped
+type Report []Result
+
+// Err joins the errors of the components that did not stop cleanly
+func (r Report) Err() error {
+	var errs []error
+	for _, result := range r {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Name, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Shutdown stops every component in reverse registration order. Components
+// are all given a chance to stop even when an earlier one fails or the
+// deadline of ctx passes. Calling Shutdown again does nothing.
+func (m *Manager) Shutdown(ctx context.Context) Report {
+	m.mu.Lock()
+	components := m.components
+	m.components = nil
+	m.mu.Unlock()
+
+	report := make(Report, 0, len(components))
+	for i := len(components) - 1; i >= 0; i-- {
+		c := components[i]
+		start := time.Now()
+		err := c.stop(ctx)
+		report = append(report, Result{Name: c.name, Duration: time.Since(start), Err: err})
+	}
+	return report
+}
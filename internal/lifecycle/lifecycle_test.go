@@ -0,0 +1,75 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestShutdownOrder(t *testing.T) {
+	m := New()
+	var stopped []string
+	for _, name := range []string{"database", "kv", "http"} {
+		m.Register(name, func(context.Context) error {
+			stopped = append(stopped, name)
+			return nil
+		})
+	}
+
+	report := m.Shutdown(context.Background())
+	if err := report.Err(); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if got := strings.Join(stopped, ","); got != "http,kv,database" {
+		t.Errorf("stop order = %s, want http,kv,database", got)
+	}
+
+	if report := m.Shutdown(context.Background()); len(report) != 0 {
+		t.Errorf("second Shutdown() stopped %d components", len(report))
+	}
+}
+
+func TestShutdownReportsFailures(t *testing.T) {
+	m := New()
+	closed := false
+	m.RegisterCloser("database", func() error {
+		closed = true
+		return nil
+	})
+	m.RegisterCloser("kv", func() error {
+		return errors.New("sync failed")
+	})
+
+	err := m.Shutdown(context.Background()).Err()
+	if err == nil || !strings.Contains(err.Error(), "kv: sync failed") {
+		t.Errorf("Shutdown() error = %v, want the kv failure", err)
+	}
+	if !closed {
+		t.Error("database was not closed after kv failed")
+	}
+}
+
+func TestShutdownDeadline(t *testing.T) {
+	m := New()
+	m.Go("stuck-worker", func(ctx context.Context) {
+		time.Sleep(time.Second)
+	})
+	stopped := false
+	m.Go("worker", func(ctx context.Context) {
+		<-ctx.Done()
+		stopped = true
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := m.Shutdown(ctx).Err()
+	if !stopped {
+		t.Error("worker was not stopped")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "stuck-worker") {
+		t.Errorf("Shutdown() error = %v, want stuck-worker to miss the deadline", err)
+	}
+}
@@ -0,0 +1,73 @@
+// Package logging builds the application logger from the configuration:
+// level, encoding, sinks with file rotation, and redaction of personal
+// fields before they reach any sink.
+package logging
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/natefinch/lumberjack"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"radgifa/internal/config"
+)
+
+// New returns the logger described by cfg and the level controlling it,
+// which can be changed at runtime
+func New(cfg config.LogConfig) (*zap.Logger, zap.AtomicLevel, error) {
+	level, err := zap.ParseAtomicLevel(cfg.Level)
+	if err != nil {
+		return nil, level, fmt.Errorf("log level: %w", err)
+	}
+
+	encoder, err := newEncoder(cfg.Format)
+	if err != nil {
+		return nil, level, err
+	}
+
+	var sinks []zapcore.WriteSyncer
+	for _, sink := range cfg.Sinks {
+		switch sink {
+		case "stdout":
+			sinks = append(sinks, zapcore.Lock(os.Stdout))
+		case "stderr":
+			sinks = append(sinks, zapcore.Lock(os.Stderr))
+		case "file":
+			sinks = append(sinks, zapcore.AddSync(&lumberjack.Logger{
+				Filename:   cfg.File.Path,
+				MaxSize:    cfg.File.MaxSizeMB,
+				MaxBackups: cfg.File.MaxBackups,
+				MaxAge:     cfg.File.MaxAgeDays,
+				Compress:   cfg.File.Compress,
+			}))
+		default:
+			return nil, level, fmt.Errorf("unknown log sink %q", sink)
+		}
+	}
+
+	redactor, err := NewRedactor(cfg.Redaction, []byte(cfg.RedactionKey))
+	if err != nil {
+		return nil, level, err
+	}
+
+	core := zapcore.NewCore(encoder, zapcore.NewMultiWriteSyncer(sinks...), level)
+	return zap.New(redactor.Wrap(core)), level, nil
+}
+
+func newEncoder(format string) (zapcore.Encoder, error) {
+	encCfg := zap.NewProductionEncoderConfig()
+	encCfg.TimeKey = "ts"
+	encCfg.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	switch format {
+	case "json":
+		return zapcore.NewJSONEncoder(encCfg), nil
+	case "console":
+		encCfg.EncodeLevel = zapcore.CapitalLevelEncoder
+		return zapcore.NewConsoleEncoder(encCfg), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+}
@@ -0,0 +1,135 @@
+package logging
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// identifierFields name people. They are replaced by a keyed hash, so the
+// same person can still be followed across log lines.
+var identifierFields = map[string]bool{
+	"username":          true,
+	"name":              true,
+	"display_name":      true,
+	"unique_identifier": true,
+	"remote_ip":         true,
+}
+
+// contentFields hold free text typed by users. Only their length is kept.
+var contentFields = map[string]bool{
+	"title":        true,
+	"description":  true,
+	"text":         true,
+	"answer_value": true,
+}
+
+const masked = "******"
+
+// Redactor rewrites personal fields of log entries
+type Redactor struct {
+	mode string
+	key  []byte
+}
+
+// NewRedactor returns a Redactor for mode "hash", "mask" or "off". In hash
+// mode an empty key is replaced by a random one, which keeps hashes stable
+// for the lifetime of the process only.
+func NewRedactor(mode string, key []byte) (*Redactor, error) {
+	switch mode {
+	case "hash":
+		if len(key) == 0 {
+			key = make([]byte, 32)
+			if _, err := rand.Read(key); err != nil {
+				return nil, err
+			}
+		}
+	case "mask", "off":
+	default:
+		return nil, fmt.Errorf("unknown log redaction mode %q", mode)
+	}
+	return &Redactor{mode: mode, key: key}, nil
+}
+
+// Wrap returns a core that redacts fields before handing them to core
+func (r *Redactor) Wrap(core zapcore.Core) zapcore.Core {
+	if r.mode == "off" {
+		return core
+	}
+	return &redactingCore{Core: core, redactor: r}
+}
+
+// Field returns f with its value hashed or masked when its key names a
+// personal field
+func (r *Redactor) Field(f zapcore.Field) zapcore.Field {
+	if r.mode == "off" || !(identifierFields[f.Key] || contentFields[f.Key]) {
+		return f
+	}
+
+	value := fieldString(f)
+	switch {
+	case value == "":
+		return f
+	case identifierFields[f.Key] && r.mode == "hash":
+		return zap.String(f.Key, r.hash(value))
+	case contentFields[f.Key]:
+		return zap.String(f.Key, fmt.Sprintf("%s(%d)", masked, len(value)))
+	default:
+		return zap.String(f.Key, masked)
+	}
+}
+
+func (r *Redactor) hash(value string) string {
+	mac := hmac.New(sha256.New, r.key)
+	mac.Write([]byte(value))
+	return "h:" + hex.EncodeToString(mac.Sum(nil))[:16]
+}
+
+func (r *Redactor) fields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		redacted[i] = r.Field(f)
+	}
+	return redacted
+}
+
+// fieldString renders the value of a string-like field
+func fieldString(f zapcore.Field) string {
+	switch f.Type {
+	case zapcore.StringType:
+		return f.String
+	case zapcore.StringerType:
+		return fmt.Sprint(f.Interface)
+	case zapcore.SkipType:
+		return ""
+	default:
+		enc := zapcore.NewMapObjectEncoder()
+		f.AddTo(enc)
+		return fmt.Sprint(enc.Fields[f.Key])
+	}
+}
+
+type redactingCore struct {
+	zapcore.Core
+	redactor *Redactor
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(c.redactor.fields(fields)), redactor: c.redactor}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, c.redactor.fields(fields))
+}
@@ -0,0 +1,68 @@
+package logging
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newObservedLogger(t *testing.T, mode string) (*zap.Logger, *observer.ObservedLogs) {
+	t.Helper()
+	redactor, err := NewRedactor(mode, []byte("test-key"))
+	if err != nil {
+		t.Fatalf("NewRedactor() error = %v", err)
+	}
+	core, logs := observer.New(zapcore.DebugLevel)
+	return zap.New(redactor.Wrap(core)), logs
+}
+
+func TestRedactorHash(t *testing.T) {
+	logger, logs := newObservedLogger(t, "hash")
+
+	logger.With(zap.String("username", "alice")).Info("login",
+		zap.String("display_name", "Alice Liddell"),
+		zap.String("answer_value", "I hate pineapple"),
+		zap.String("questionnaire_id", "0190c5a8-4e2b-7000-8000-000000000000"),
+	)
+	logger.Info("again", zap.String("username", "alice"))
+
+	entries := logs.All()
+	first := entries[0].ContextMap()
+	second := entries[1].ContextMap()
+
+	if username := first["username"].(string); !strings.HasPrefix(username, "h:") || username != second["username"] {
+		t.Errorf("username = %q and %q, want the same hash", username, second["username"])
+	}
+	if strings.Contains(first["display_name"].(string), "Alice") {
+		t.Errorf("display_name leaked: %q", first["display_name"])
+	}
+	if first["answer_value"] != "******(16)" {
+		t.Errorf("answer_value = %q, want it masked with its length", first["answer_value"])
+	}
+	if first["questionnaire_id"] != "0190c5a8-4e2b-7000-8000-000000000000" {
+		t.Errorf("questionnaire_id = %q, want it untouched", first["questionnaire_id"])
+	}
+}
+
+func TestRedactorMask(t *testing.T) {
+	logger, logs := newObservedLogger(t, "mask")
+
+	logger.Info("join", zap.String("unique_identifier", "alice@example.com"))
+
+	if got := logs.All()[0].ContextMap()["unique_identifier"]; got != masked {
+		t.Errorf("unique_identifier = %q, want %q", got, masked)
+	}
+}
+
+func TestRedactorOff(t *testing.T) {
+	logger, logs := newObservedLogger(t, "off")
+
+	logger.Info("join", zap.String("username", "alice"))
+
+	if got := logs.All()[0].ContextMap()["username"]; got != "alice" {
+		t.Errorf("username = %q, want it untouched", got)
+	}
+}
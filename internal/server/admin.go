@@ -0,0 +1,73 @@
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// LogLevelRequest is the body of PUT /admin/log-level
+type LogLevelRequest struct {
+	Level string `json:"level" validate:"required" example:"debug"`
+}
+
+// requireAdminToken only lets requests bearing the admin token through
+func (s *Server) requireAdminToken(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1 {
+			return problem(http.StatusUnauthorized, "Invalid admin token")
+		}
+		return next(c)
+	}
+}
+
+// getLogLevel returns the current log level
+// @Summary Get log level
+// @Description Get the level of the server logger
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]string "Current level"
+// @Failure 401 {object} Problem "Invalid admin token"
+// @Router /admin/log-level [get]
+func (s *Server) getLogLevel(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"level": s.logLevel.String()})
+}
+
+// setLogLevel changes the log level without restarting
+// @Summary Set log level
+// @Description Change the level of the server logger at runtime
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body LogLevelRequest true "New level: debug, info, warn or error"
+// @Success 200 {object} map[string]string "New level"
+// @Failure 400 {object} Problem "Invalid level"
+// @Failure 401 {object} Problem "Invalid admin token"
+// @Router /admin/log-level [put]
+func (s *Server) setLogLevel(c echo.Context) error {
+	var req LogLevelRequest
+	if err := c.Bind(&req); err != nil {
+		return problem(http.StatusBadRequest, "Invalid request format")
+	}
+
+	level, err := zapcore.ParseLevel(req.Level)
+	if err != nil || req.Level == "" {
+		return problem(http.StatusBadRequest, "Level must be one of debug, info, warn or error")
+	}
+
+	previous := s.logLevel.Level()
+	s.logLevel.SetLevel(level)
+	GetLogger(c).Warn("log level changed",
+		zap.Stringer("from", previous),
+		zap.Stringer("to", level),
+	)
+
+	return c.JSON(http.StatusOK, map[string]string{"level": level.String()})
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestAdminLogLevel(t *testing.T) {
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	s := newTestServer(t, WithLogLevel(level), WithAdminToken("admin-secret"))
+
+	do := func(method, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/admin/log-level", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		return resp
+	}
+
+	if resp := do(http.MethodGet, "wrong", ""); resp.Code != http.StatusUnauthorized {
+		t.Errorf("GET with a wrong token = %d, want 401", resp.Code)
+	}
+	if resp := do(http.MethodPut, "admin-secret", `{"level":"verbose"}`); resp.Code != http.StatusBadRequest {
+		t.Errorf("PUT with an unknown level = %d, want 400", resp.Code)
+	}
+	if resp := do(http.MethodPut, "admin-secret", `{"level":"debug"}`); resp.Code != http.StatusOK {
+		t.Fatalf("PUT debug = %d %s, want 200", resp.Code, resp.Body)
+	}
+	if level.Level() != zapcore.DebugLevel {
+		t.Errorf("level = %s after PUT, want debug", level.Level())
+	}
+	if resp := do(http.MethodGet, "admin-secret", ""); !strings.Contains(resp.Body.String(), `"debug"`) {
+		t.Errorf("GET = %s, want the debug level", resp.Body)
+	}
+}
+
+func TestAdminDisabledWithoutToken(t *testing.T) {
+	s := newTestServer(t, WithLogLevel(zap.NewAtomicLevel()))
+
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/admin/log-level", nil))
+	if resp.Code == http.StatusOK {
+		t.Errorf("GET /admin/log-level without an admin token configured = %d", resp.Code)
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type batchAnswerService struct {
+	stubService
+	questionnaireID uuid.UUID
+	err             error
+}
+
+func (s *batchAnswerService) GetMemberWithQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	m := &ent.Member{ID: memberID}
+	m.Edges.Questionnaire = &ent.Questionnaire{ID: s.questionnaireID}
+	return m, nil
+}
+
+func (s *batchAnswerService) CreateAnswers(memberID uuid.UUID, answers []database.NewAnswer, ctx context.Context) ([]*ent.Answer, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	saved := make([]*ent.Answer, len(answers))
+	for i := range answers {
+		saved[i] = &ent.Answer{ID: uuid.New()}
+	}
+	return saved, nil
+}
+
+func TestNewQuestionnaireAnswers(t *testing.T) {
+	valid := `{"answers":[{"question_id":"` + uuid.NewString() + `","answer_value":"Yes"},{"question_id":"` + uuid.NewString() + `","answer_value":"Pass"}]}`
+	tests := []struct {
+		name string
+		body string
+		err  error
+		want int
+	}{
+		{"saved", valid, nil, http.StatusCreated},
+		{"empty batch", `{"answers":[]}`, nil, http.StatusBadRequest},
+		{"unknown value", `{"answers":[{"question_id":"` + uuid.NewString() + `","answer_value":"Maybe"}]}`, nil, http.StatusBadRequest},
+		{"foreign question", valid, fmt.Errorf("%w: question is not in the questionnaire", database.ErrInvalidAnswers), http.StatusBadRequest},
+		{"hidden question", valid, database.ErrQuestionHidden, http.StatusConflict},
+		{"locked", valid, database.ErrAnswersLocked, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qID := uuid.New()
+			s := newTestServer(t, WithService(&batchAnswerService{questionnaireID: qID, err: tt.err}))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/questionnaires/"+qID.String()+"/answers", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "member"))
+			resp := httptest.NewRecorder()
+			s.Handler().ServeHTTP(resp, req)
+			if resp.Code != tt.want {
+				t.Fatalf("POST answers = %d %s, want %d", resp.Code, resp.Body, tt.want)
+			}
+		})
+	}
+}
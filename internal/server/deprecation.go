@@ -0,0 +1,198 @@
+package server
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// legacyContextKey marks the requests made to the unversioned aliases
+const legacyContextKey = "legacy_api"
+
+// legacyDeprecatedAt is when /api/v1 replaced the unversioned routes
+var legacyDeprecatedAt = time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+// deprecated marks the responses of the unversioned aliases of /api/v1 with
+// the Deprecation (RFC 9745) and Sunset (RFC 8594) headers, and links to the
+// route replacing them
+func (s *Server) deprecated(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		h := c.Response().Header()
+		h.Set("Deprecation", "@"+strconv.FormatInt(legacyDeprecatedAt.Unix(), 10))
+		if !s.legacySunset.IsZero() {
+			h.Set("Sunset", s.legacySunset.UTC().Format(http.TimeFormat))
+		}
+		h.Set("Link", "<"+s.successorPath(c.Request().URL.Path)+`>; rel="successor-version"`)
+		legacyRequestsTotal.WithLabelValues(c.Path()).Inc()
+		c.Set(legacyContextKey, true)
+		return next(c)
+	}
+}
+
+// successorPath is the /api/v1 path of an unversioned route
+func (s *Server) successorPath(path string) string {
+	path = strings.TrimPrefix(path, s.routePrefix)
+	path = strings.TrimPrefix(path, "/api")
+	return s.routePrefix + "/api/v1" + path
+}
+
+// isLegacy tells whether the request came through an unversioned alias
+func isLegacy(c echo.Context) bool {
+	legacy, _ := c.Get(legacyContextKey).(bool)
+	return legacy
+}
+
+// legacyEntity is implemented by the response types that replaced an
+// entity rendered as is
+type legacyEntity interface {
+	legacyEntity()
+}
+
+func (QuestionnaireResponse) legacyEntity()       {}
+func (UserResponse) legacyEntity()                {}
+func (MemberResponse) legacyEntity()              {}
+func (QuestionResponse) legacyEntity()            {}
+func (SectionResponse) legacyEntity()             {}
+func (AnswerResponse) legacyEntity()              {}
+func (RuleResponse) legacyEntity()                {}
+func (MemberQuestionnaireResponse) legacyEntity() {}
+func (MemberQuestionResponse) legacyEntity()      {}
+func (ParticipantResponse) legacyEntity()         {}
+func (PublicUserResponse) legacyEntity()          {}
+
+// legacySerializer renders the responses of the unversioned aliases in the
+// layout they had before the response types, until the sunset
+type legacySerializer struct {
+	echo.DefaultJSONSerializer
+}
+
+func (s legacySerializer) Serialize(c echo.Context, i interface{}, indent string) error {
+	return s.DefaultJSONSerializer.Serialize(c, responseView(c, i), indent)
+}
+
+// responseView is what is rendered for v: v itself on /api/v1, and its
+// legacy layout on the unversioned aliases
+func responseView(c echo.Context, v interface{}) interface{} {
+	if !isLegacy(c) {
+		return v
+	}
+	return legacyLayout(reflect.ValueOf(v))
+}
+
+var (
+	jsonMarshaler = reflect.TypeFor[json.Marshaler]()
+	textMarshaler = reflect.TypeFor[encoding.TextMarshaler]()
+	entityType    = reflect.TypeFor[legacyEntity]()
+)
+
+// legacyLayout renders v as the entities were: the empty fields of an
+// entity are left out, and its edges are always there, empty when none was
+// loaded. The other types keep their layout. The hashes are not part of the
+// response types, so they stay out.
+func legacyLayout(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type().Implements(jsonMarshaler) || v.Type().Implements(textMarshaler) {
+		return v.Interface()
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return legacyLayout(v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		fallthrough
+	case reflect.Array:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = legacyLayout(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		m := make(map[string]interface{}, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			m[iter.Key().String()] = legacyLayout(iter.Value())
+		}
+		return m
+	case reflect.Struct:
+		return legacyObject(v)
+	default:
+		return v.Interface()
+	}
+}
+
+func legacyObject(v reflect.Value) map[string]interface{} {
+	entity := v.Type().Implements(entityType)
+	m := make(map[string]interface{}, v.NumField())
+	// Fields of embedded structs are promoted as encoding/json does: they
+	// only fill the names the outer struct leaves free
+	var promoted []map[string]interface{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.Anonymous && name == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				promoted = append(promoted, legacyObject(embedded))
+				continue
+			}
+		}
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		value := v.Field(i)
+		omitEmpty := strings.Contains(","+opts+",", ",omitempty,") || (entity && name != "edges")
+		if omitEmpty && isEmptyValue(value) {
+			continue
+		}
+		m[name] = legacyLayout(value)
+	}
+	for _, fields := range promoted {
+		for name, value := range fields {
+			if _, ok := m[name]; !ok {
+				m[name] = value
+			}
+		}
+	}
+	if _, ok := m["edges"]; entity && !ok {
+		m["edges"] = map[string]interface{}{}
+	}
+	return m
+}
+
+// isEmptyValue is the emptiness of the omitempty option of encoding/json
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return v.IsZero()
+	}
+}
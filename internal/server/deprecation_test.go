@@ -0,0 +1,199 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+)
+
+func TestLegacyRoutes(t *testing.T) {
+	owner := uuid.New()
+	q := &ent.Questionnaire{ID: uuid.New(), Title: "Pizza night", Version: 1}
+	q.Edges.Owner = &ent.User{ID: owner, Username: "john", Password: []byte("$2a$10$hash")}
+	sunset := time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC)
+	s := newTestServer(t,
+		WithService(&detailsService{questionnaire: q}),
+		WithRoutePrefix("/radgifa"),
+		WithLegacySunset(sunset))
+	token := testToken(t, s, owner, "user")
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		body          string
+		want          int
+		wantSuccessor string
+	}{
+		{"v1", http.MethodGet, "/radgifa/api/v1/questionnaires/" + q.ID.String(), "", http.StatusOK, ""},
+		{"legacy api", http.MethodGet, "/radgifa/api/questionnaires/" + q.ID.String(), "", http.StatusOK, "/radgifa/api/v1/questionnaires/" + q.ID.String()},
+		{"v1 public", http.MethodPost, "/radgifa/api/v1/check/username", `{}`, http.StatusBadRequest, ""},
+		{"legacy public", http.MethodPost, "/radgifa/check/username", `{}`, http.StatusBadRequest, "/radgifa/api/v1/check/username"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+			resp := httptest.NewRecorder()
+			s.Handler().ServeHTTP(resp, req)
+			if resp.Code != tt.want {
+				t.Fatalf("%s %s = %d %s, want %d", tt.method, tt.path, resp.Code, resp.Body, tt.want)
+			}
+
+			h := resp.Header()
+			if tt.wantSuccessor == "" {
+				if h.Get("Deprecation") != "" || h.Get("Sunset") != "" {
+					t.Errorf("v1 route sent Deprecation %q and Sunset %q", h.Get("Deprecation"), h.Get("Sunset"))
+				}
+				return
+			}
+			if !strings.HasPrefix(h.Get("Deprecation"), "@") {
+				t.Errorf("Deprecation = %q, want an @<timestamp>", h.Get("Deprecation"))
+			}
+			if want := "Fri, 30 Apr 2027 00:00:00 GMT"; h.Get("Sunset") != want {
+				t.Errorf("Sunset = %q, want %q", h.Get("Sunset"), want)
+			}
+			if want := "<" + tt.wantSuccessor + `>; rel="successor-version"`; h.Get("Link") != want {
+				t.Errorf("Link = %q, want %q", h.Get("Link"), want)
+			}
+		})
+	}
+}
+
+func TestResponsesLeaveSecretsOut(t *testing.T) {
+	owner := uuid.New()
+	q := &ent.Questionnaire{ID: uuid.New(), Title: "Pizza night", Version: 1}
+	q.Edges.Owner = &ent.User{ID: owner, Username: "john", Password: []byte("$2a$10$hash")}
+	q.Edges.Members = []*ent.Member{{ID: uuid.New(), DisplayName: "John", PassCode: []byte("$2a$10$passcode")}}
+	s := newTestServer(t, WithService(&detailsService{questionnaire: q}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/questionnaires/"+q.ID.String(), nil)
+	req.Header.Set("Authorization", "Bearer "+testToken(t, s, owner, "user"))
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, req)
+	if resp.Code != http.StatusOK {
+		t.Fatalf("GET = %d %s, want 200", resp.Code, resp.Body)
+	}
+	for _, secret := range []string{"password", "pass_code", "$2a$"} {
+		if strings.Contains(resp.Body.String(), secret) {
+			t.Errorf("response %s contains %q", resp.Body, secret)
+		}
+	}
+}
+
+func TestLegacyRoutesKeepEntityLayout(t *testing.T) {
+	owner := uuid.New()
+	closedAt := int64(1735776000000)
+	q := &ent.Questionnaire{ID: uuid.New(), Title: "Pizza night", IsPublished: true, CreatedAt: 1735689600000, ClosedAt: &closedAt, Version: 2}
+	q.Edges.Owner = &ent.User{ID: owner, Name: "John Doe", Username: "john", Password: []byte("$2a$10$hash")}
+	q.Edges.Members = []*ent.Member{{ID: uuid.New(), DisplayName: "Alice", UniqueIdentifier: "alice", PassCode: []byte("$2a$10$passcode")}}
+	section := &ent.Section{ID: uuid.New(), Title: "Toppings", Position: 1}
+	question := &ent.Question{ID: uuid.New(), Text: "Pineapple?", Version: 1}
+	question.Edges.Section = section
+	question.Edges.Rules = []*ent.Rule{{ID: uuid.New(), AnswerValues: []string{"Yes"}}}
+	q.Edges.Questions = []*ent.Question{question}
+	q.Edges.Sections = []*ent.Section{section}
+	s := newTestServer(t, WithService(&detailsService{questionnaire: q}))
+	token := testToken(t, s, owner, "user")
+
+	get := func(path string) any {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		if resp.Code != http.StatusOK {
+			t.Fatalf("GET %s = %d %s, want 200", path, resp.Code, resp.Body)
+		}
+		var body any
+		if err := json.Unmarshal(resp.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		return body
+	}
+
+	// The layout before the response types: the entity as is, without the
+	// hashes
+	legacy := *q
+	ownerCopy, memberCopy := *q.Edges.Owner, *q.Edges.Members[0]
+	ownerCopy.Password, memberCopy.PassCode = nil, nil
+	legacy.Edges.Owner, legacy.Edges.Members = &ownerCopy, []*ent.Member{&memberCopy}
+	data, err := json.Marshal(&legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want any
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatal(err)
+	}
+
+	got := get("/api/questionnaires/" + q.ID.String())
+	if !reflect.DeepEqual(got, want) {
+		gotJSON, _ := json.Marshal(got)
+		t.Errorf("legacy body = %s\nwant %s", gotJSON, data)
+	}
+	// /api/v1 keeps the response types, with every field
+	if v1 := get("/api/v1/questionnaires/" + q.ID.String()); reflect.DeepEqual(v1, want) {
+		t.Error("v1 body has the legacy layout")
+	}
+}
+
+// TestLegacyLayoutPromotesEmbeddedFields checks the response types that embed
+// another one keep the layout of encoding/json on the legacy routes
+func TestLegacyLayoutPromotesEmbeddedFields(t *testing.T) {
+	sectionID := uuid.NewString()
+	type shadowing struct {
+		Answered string `json:"answered"`
+		MembershipProgress
+	}
+	type byPointer struct {
+		ID string `json:"id"`
+		*MembershipProgress
+	}
+
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"embedded DTO", SectionProgress{SectionID: &sectionID, MembershipProgress: MembershipProgress{Answered: 2, Total: 3}}},
+		{"completion", CompletionResponse{Members: []MemberCompletionResponse{{Sections: []SectionProgress{{MembershipProgress: MembershipProgress{Answered: 1, Total: 1, Complete: true}}}}}}},
+		{"outer field wins", shadowing{Answered: "all", MembershipProgress: MembershipProgress{Answered: 1, Total: 2}}},
+		{"embedded pointer", byPointer{ID: "a", MembershipProgress: &MembershipProgress{Total: 4}}},
+		{"nil embedded pointer", byPointer{ID: "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Compared decoded, the legacy layout is made of maps whose keys
+			// come out sorted
+			got, want := roundTrip(t, legacyLayout(reflect.ValueOf(tt.value))), roundTrip(t, tt.value)
+			if !reflect.DeepEqual(got, want) {
+				gotJSON, _ := json.Marshal(got)
+				wantJSON, _ := json.Marshal(want)
+				t.Errorf("legacy layout = %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+// roundTrip decodes the JSON encoding of v into generic values
+func roundTrip(t *testing.T, v any) any {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !darwin
+
+package server
+
+// freeDiskSpace is not implemented on this platform, the disk check is skipped
+func freeDiskSpace(string) (uint64, error) {
+	return 0, errDiskSpaceUnsupported
+}
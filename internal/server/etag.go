@@ -0,0 +1,83 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Entity tags have the form "<version>-<hash>" for a versioned resource and
+// "<hash>" otherwise, the hash covering the JSON body. If-None-Match compares
+// whole tags, so any change to the body gives a new tag. If-Match only looks
+// at the version, so answers coming in do not fail an edit of the
+// questionnaire; a bare "<version>" is accepted too, taken from the version
+// field of a listing.
+
+// etag builds the entity tag of a body, prefixed with version when not 0
+func etag(version int, body []byte) string {
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:8])
+	if version == 0 {
+		return `"` + hash + `"`
+	}
+	return `"` + strconv.Itoa(version) + "-" + hash + `"`
+}
+
+// conditionalJSON writes v as JSON with its ETag, or answers 304 Not
+// Modified when the request's If-None-Match lists that tag
+func conditionalJSON(c echo.Context, version int, v interface{}) error {
+	body, err := json.Marshal(responseView(c, v))
+	if err != nil {
+		return err
+	}
+	tag := etag(version, body)
+	c.Response().Header().Set("ETag", tag)
+	if noneMatch(c.Request().Header.Get("If-None-Match"), tag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.JSONBlob(http.StatusOK, body)
+}
+
+// noneMatch reports whether an If-None-Match header matches tag, using the
+// weak comparison RFC 9110 asks for
+func noneMatch(header, tag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion reads the version an update is conditioned on. It returns
+// 0 without an If-Match header or for "*", and ok false when the header
+// cannot match any version, like a weak or malformed tag.
+func ifMatchVersion(c echo.Context) (version int, ok bool) {
+	header := strings.TrimSpace(c.Request().Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return 0, true
+	}
+	// Only the first tag is honoured, clients send the one they read
+	tag, _, _ := strings.Cut(header, ",")
+	tag = strings.TrimSpace(tag)
+	if !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) || len(tag) < 2 {
+		return 0, false
+	}
+	prefix, _, _ := strings.Cut(strings.Trim(tag, `"`), "-")
+	version, err := strconv.Atoi(prefix)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
+
+// versionTag is the ETag returned by updates, enough for a later If-Match
+func versionTag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
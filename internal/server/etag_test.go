@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type detailsService struct {
+	stubService
+	questionnaire *ent.Questionnaire
+}
+
+func (s *detailsService) GetQuestionnaireWithDetails(questionnaireID uuid.UUID, ctx context.Context) (*ent.Questionnaire, error) {
+	return s.questionnaire, nil
+}
+
+func (s *detailsService) GetMemberByUserAndQuestionnaire(userID, questionnaireID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	return nil, &ent.NotFoundError{}
+}
+
+func TestQuestionnaireDetailsETag(t *testing.T) {
+	owner := uuid.New()
+	q := &ent.Questionnaire{ID: uuid.New(), Title: "Pizza night", Version: 3}
+	q.Edges.Owner = &ent.User{ID: owner}
+	s := newTestServer(t, WithService(&detailsService{questionnaire: q}))
+	token := testToken(t, s, owner, "user")
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/questionnaires/"+q.ID.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := get("")
+	tag := resp.Header().Get("ETag")
+	if resp.Code != http.StatusOK || !strings.HasPrefix(tag, `"3-`) {
+		t.Fatalf("GET = %d with ETag %q, want 200 and a tag of version 3", resp.Code, tag)
+	}
+	if resp = get(tag); resp.Code != http.StatusNotModified || resp.Body.Len() != 0 {
+		t.Errorf("GET with If-None-Match = %d %s, want an empty 304", resp.Code, resp.Body)
+	}
+	if resp = get("W/" + tag); resp.Code != http.StatusNotModified {
+		t.Errorf("GET with a weak If-None-Match = %d, want 304", resp.Code)
+	}
+
+	q.Title = "Pizza party"
+	if resp = get(tag); resp.Code != http.StatusOK || resp.Header().Get("ETag") == tag {
+		t.Errorf("GET after a change = %d with ETag %q, want 200 and a new tag", resp.Code, resp.Header().Get("ETag"))
+	}
+}
+
+func TestUpdateWithUnmatchableIfMatch(t *testing.T) {
+	s := newTestServer(t)
+	questionnaireID := uuid.NewString()
+
+	for _, path := range []string{
+		"/api/questionnaires/" + questionnaireID,
+		"/api/questionnaires/" + questionnaireID + "/questions/" + uuid.NewString(),
+	} {
+		for _, ifMatch := range []string{`W/"3"`, `3`, `"abc"`} {
+			req := httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"title":"Pizza","text":"Pizza?"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "user"))
+			req.Header.Set("If-Match", ifMatch)
+			resp := httptest.NewRecorder()
+			s.Handler().ServeHTTP(resp, req)
+			if resp.Code != http.StatusPreconditionFailed {
+				t.Errorf("PUT %s with If-Match %s = %d, want 412", path, ifMatch, resp.Code)
+			}
+		}
+	}
+}
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		header  string
+		version int
+		ok      bool
+	}{
+		{"", 0, true},
+		{"*", 0, true},
+		{`"4"`, 4, true},
+		{`"4-0123456789abcdef"`, 4, true},
+		{`"4-0123456789abcdef", "5"`, 4, true},
+		{`W/"4"`, 0, false},
+		{`"0"`, 0, false},
+		{`"x-4"`, 0, false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPut, "/", nil)
+		req.Header.Set("If-Match", tt.header)
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+		version, ok := ifMatchVersion(c)
+		if version != tt.version || ok != tt.ok {
+			t.Errorf("ifMatchVersion(%q) = %d, %v, want %d, %v", tt.header, version, ok, tt.version, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"errors"
+	"strings"
+
+	"radgifa/internal/graph"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// GraphQLRequest is a GraphQL query with its variables
+type GraphQLRequest struct {
+	Query         string         `json:"query" example:"{ questionnaires(first: 10) { edges { node { id title } } } }"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// graphqlHandler serves the GraphQL API on behalf of the caller
+// @Summary GraphQL API
+// @Description Runs a GraphQL query or mutation, see internal/graph/*.graphql for the schema. The
+// @Description rows and fields returned are those the REST API shows the caller, and the mutations
+// @Description are those of the REST API. Errors come in the errors of the response, with the code
+// @Description of the matching problem under extensions.code.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param query body GraphQLRequest true "GraphQL request"
+// @Success 200 {object} map[string]interface{} "GraphQL response"
+// @Failure 401 {object} Problem
+// @Router /api/v1/graphql [post]
+func (s *Server) graphqlHandler(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+	entityID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+	var viewer graph.Viewer
+	switch entityType {
+	case "user":
+		viewer.UserID = entityID
+	case "member":
+		viewer.MemberID = entityID
+	default:
+		return problem(401, "invalid token type")
+	}
+	if scopes, ok := tokenScopes(c); ok {
+		viewer.Scopes = scopes
+	}
+
+	r := c.Request()
+	s.graphql.ServeHTTP(c.Response(), r.WithContext(graph.NewContext(r.Context(), viewer)))
+	return nil
+}
+
+// graphValidator checks the inputs of the GraphQL mutations with the
+// requests of the REST API
+type graphValidator struct {
+	validator *CustomValidator
+}
+
+func (v graphValidator) Questionnaire(title, description string) (string, string, error) {
+	req := &NewQuestionnaireRequest{Title: title, Description: description}
+	req.Sanitize()
+	return req.Title, req.Description, fieldErrors(v.validator.Validate(req))
+}
+
+func (v graphValidator) Question(text string, position *int) (string, error) {
+	req := &NewQuestionRequest{Text: text, Position: position}
+	req.Sanitize()
+	return req.Text, fieldErrors(v.validator.Validate(req))
+}
+
+// fieldErrors turns a validation problem into an error holding the
+// messages of its fields
+func fieldErrors(err error) error {
+	var p *Problem
+	if !errors.As(err, &p) || len(p.Errors) == 0 {
+		return err
+	}
+	messages := make([]string, len(p.Errors))
+	for i, fe := range p.Errors {
+		messages[i] = fe.Message
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
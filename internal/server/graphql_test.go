@@ -0,0 +1,113 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type graphqlResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func postGraphQL(t *testing.T, s *Server, path, token, query string) (*httptest.ResponseRecorder, graphqlResponse) {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	s.handler.ServeHTTP(rec, req)
+	var resp graphqlResponse
+	if rec.Code == http.StatusOK || rec.Code == http.StatusUnprocessableEntity {
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decoding %s: %v", rec.Body, err)
+		}
+	}
+	return rec, resp
+}
+
+func TestGraphQLEndpoint(t *testing.T) {
+	s := newTestServer(t, WithService(stubService{}))
+	userToken := testToken(t, s, uuid.New(), "user")
+	memberToken := testToken(t, s, uuid.New(), "member")
+
+	t.Run("needs a token", func(t *testing.T) {
+		rec, _ := postGraphQL(t, s, "/api/v1/graphql", "", `{ me { id } }`)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("status = %d, want 401", rec.Code)
+		}
+	})
+
+	t.Run("only under v1", func(t *testing.T) {
+		rec, _ := postGraphQL(t, s, "/api/graphql", userToken, `{ me { id } }`)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("status = %d, want 404", rec.Code)
+		}
+	})
+
+	t.Run("anonymous member has no user", func(t *testing.T) {
+		rec, resp := postGraphQL(t, s, "/api/v1/graphql", memberToken, `{ me { id } }`)
+		if rec.Code != http.StatusOK || len(resp.Errors) != 0 || resp.Data["me"] != nil {
+			t.Errorf("status = %d, response = %+v, want a null user", rec.Code, resp)
+		}
+	})
+
+	t.Run("members cannot edit", func(t *testing.T) {
+		_, resp := postGraphQL(t, s, "/api/v1/graphql", memberToken,
+			`mutation { createQuestionnaire(input: {title: "Pizza night"}) { id } }`)
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != "unauthorized" {
+			t.Errorf("errors = %+v, want unauthorized", resp.Errors)
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		// The messages are those of the REST API, whose validator is used
+		tests := []struct {
+			mutation string
+			want     string
+		}{
+			{`createQuestionnaire(input: {title: "  "}) { id }`, "title is required"},
+			{`createQuestionnaire(input: {title: "` + strings.Repeat("a", 201) + `"}) { id }`, "title must be at most 200 characters"},
+			{`createQuestionnaire(input: {title: "Pizza", description: "` + strings.Repeat("a", 1001) + `"}) { id }`, "description must be at most 1000 characters"},
+			{`createQuestion(input: {questionnaireID: "` + uuid.NewString() + `", text: "<b></b>"}) { id }`, "text is required"},
+			{`createQuestion(input: {questionnaireID: "` + uuid.NewString() + `", text: "Coming?", position: -1}) { id }`, "position must be at least 0"},
+		}
+		for _, tt := range tests {
+			_, resp := postGraphQL(t, s, "/api/v1/graphql", userToken, "mutation { "+tt.mutation+" }")
+			if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != "bad_request" || resp.Errors[0].Message != tt.want {
+				t.Errorf("%s errors = %+v, want bad_request %q", tt.mutation[:20], resp.Errors, tt.want)
+			}
+		}
+	})
+
+	t.Run("no GET", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/graphql?query=%7B%20me%20%7B%20id%20%7D%20%7D", nil)
+		req.Header.Set("Authorization", "Bearer "+userToken)
+		rec := httptest.NewRecorder()
+		s.handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNotFound && rec.Code != http.StatusMethodNotAllowed {
+			t.Errorf("GET status = %d, want no route", rec.Code)
+		}
+	})
+
+	t.Run("complexity limit", func(t *testing.T) {
+		query := `{ questionnaires(first: 100) { edges { node {
+			questions(first: 100) { edges { node { answers(first: 100) { edges { node { id } } } } } }
+		} } } }`
+		_, resp := postGraphQL(t, s, "/api/v1/graphql", userToken, query)
+		if len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, "complexity") {
+			t.Errorf("errors = %+v, want the complexity limit", resp.Errors)
+		}
+	})
+}
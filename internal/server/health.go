@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// checkTimeout bounds every readiness check, so a hung dependency turns into
+// a failing probe instead of a hung one
+const checkTimeout = 2 * time.Second
+
+var errDiskSpaceUnsupported = errors.New("disk space check is not supported on this platform")
+
+// healthCheck is a single readiness dependency
+type healthCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of one readiness check
+type CheckResult struct {
+	Status     string `json:"status"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// HealthReport is the body of /livez and /readyz
+type HealthReport struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+func (s *Server) readinessChecks() []healthCheck {
+	checks := []healthCheck{
+		{name: "postgres", check: s.service.Ping},
+	}
+	if kv, ok := s.kvmanager.(kvPinger); ok {
+		checks = append(checks, healthCheck{name: "kv", check: func(context.Context) error { return kv.Ping() }})
+	}
+	if s.diskPath != "" {
+		checks = append(checks, healthCheck{name: "disk", check: s.checkDiskSpace})
+	}
+	return checks
+}
+
+func (s *Server) checkDiskSpace(context.Context) error {
+	free, err := freeDiskSpace(s.diskPath)
+	if errors.Is(err, errDiskSpaceUnsupported) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if free < s.minFreeDisk {
+		return fmt.Errorf("%d MB free at %s, want at least %d MB", free>>20, s.diskPath, s.minFreeDisk>>20)
+	}
+	return nil
+}
+
+// runChecks runs every check concurrently, each under checkTimeout
+func runChecks(ctx context.Context, checks []healthCheck) HealthReport {
+	report := HealthReport{Status: "ok", Checks: make(map[string]CheckResult, len(checks))}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, hc := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := runCheck(ctx, hc)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[hc.name] = result
+			if result.Status != "ok" {
+				report.Status = "fail"
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
+
+func runCheck(ctx context.Context, hc healthCheck) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	errc := make(chan error, 1)
+	go func() {
+		errc <- hc.check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errc:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := CheckResult{Status: "ok", DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = "fail"
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// livezHandler reports whether the process is alive
+// @Summary Liveness probe
+// @Description Succeeds as long as the process can serve HTTP. It does not check dependencies, so a database outage does not get the process restarted.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthReport "Alive"
+// @Router /livez [get]
+func (s *Server) livezHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, HealthReport{Status: "ok"})
+}
+
+// readyzHandler reports whether the server can take traffic
+// @Summary Readiness probe
+// @Description Checks Postgres, the KV store and the free disk space at the KV storage path. Fails while the server is shutting down.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthReport "Ready"
+// @Failure 503 {object} HealthReport "Not ready"
+// @Router /readyz [get]
+func (s *Server) readyzHandler(c echo.Context) error {
+	if s.shuttingDown.Load() {
+		return c.JSON(http.StatusServiceUnavailable, HealthReport{Status: "shutting_down"})
+	}
+
+	report := runChecks(c.Request().Context(), s.readinessChecks())
+	if report.Status != "ok" {
+		GetLogger(c).Warn("readiness check failed", zap.Any("checks", report.Checks))
+		return c.JSON(http.StatusServiceUnavailable, report)
+	}
+	return c.JSON(http.StatusOK, report)
+}
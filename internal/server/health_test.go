@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func getHealth(t *testing.T, s *Server, path string) (int, HealthReport) {
+	t.Helper()
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, path, nil))
+
+	var report HealthReport
+	if err := json.Unmarshal(resp.Body.Bytes(), &report); err != nil {
+		t.Fatalf("GET %s: decoding body %q: %v", path, resp.Body.String(), err)
+	}
+	return resp.Code, report
+}
+
+func TestReadyz(t *testing.T) {
+	s := newTestServer(t, WithDiskCheck(t.TempDir(), 0))
+
+	code, report := getHealth(t, s, "/readyz")
+	if code != http.StatusOK || report.Status != "ok" {
+		t.Fatalf("GET /readyz = %d %+v, want 200 ok", code, report)
+	}
+	for _, name := range []string{"postgres", "kv", "disk"} {
+		if report.Checks[name].Status != "ok" {
+			t.Errorf("check %s = %+v, want ok", name, report.Checks[name])
+		}
+	}
+}
+
+// plainKV is a store without the KVAdmin methods, like an embedder's
+type plainKV struct {
+	KVManager
+}
+
+func TestReadyzWithoutKVPing(t *testing.T) {
+	s := newTestServer(t, WithKVManager(plainKV{}))
+
+	code, report := getHealth(t, s, "/readyz")
+	if code != http.StatusOK || report.Status != "ok" {
+		t.Fatalf("GET /readyz = %d %+v, want 200 ok", code, report)
+	}
+	if _, ok := report.Checks["kv"]; ok {
+		t.Errorf("checks = %+v, want no kv check for a store without Ping", report.Checks)
+	}
+
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if resp.Code != http.StatusOK || strings.Contains(resp.Body.String(), "radgifa_kv_lsm_size_bytes") {
+		t.Errorf("GET /metrics = %d, want no KV size for a store without Size", resp.Code)
+	}
+}
+
+func TestReadyzDatabaseDown(t *testing.T) {
+	s := newTestServer(t, WithService(stubService{pingErr: errors.New("connection refused")}))
+
+	code, report := getHealth(t, s, "/readyz")
+	if code != http.StatusServiceUnavailable || report.Status != "fail" {
+		t.Fatalf("GET /readyz = %d %+v, want 503 fail", code, report)
+	}
+	if got := report.Checks["postgres"]; got.Status != "fail" || got.Error != "connection refused" {
+		t.Errorf("postgres check = %+v", got)
+	}
+	if got := report.Checks["kv"]; got.Status != "ok" {
+		t.Errorf("kv check = %+v, want ok", got)
+	}
+
+	// Liveness does not depend on the database
+	if code, _ := getHealth(t, s, "/livez"); code != http.StatusOK {
+		t.Errorf("GET /livez = %d, want 200", code)
+	}
+}
+
+func TestReadyzDiskFull(t *testing.T) {
+	if _, err := freeDiskSpace(t.TempDir()); errors.Is(err, errDiskSpaceUnsupported) {
+		t.Skip(err)
+	}
+	s := newTestServer(t, WithDiskCheck(t.TempDir(), 1<<62))
+
+	code, report := getHealth(t, s, "/readyz")
+	if code != http.StatusServiceUnavailable || report.Checks["disk"].Status != "fail" {
+		t.Fatalf("GET /readyz = %d %+v, want a failing disk check", code, report)
+	}
+}
+
+func TestReadyzDuringShutdown(t *testing.T) {
+	s := newTestServer(t)
+	if err := s.ShutdownHTTP(context.Background()); err != nil {
+		t.Fatalf("ShutdownHTTP() error = %v", err)
+	}
+
+	code, report := getHealth(t, s, "/readyz")
+	if code != http.StatusServiceUnavailable || report.Status != "shutting_down" {
+		t.Fatalf("GET /readyz = %d %+v, want 503 shutting_down", code, report)
+	}
+}
+
+func TestReadyzDuringDrain(t *testing.T) {
+	const drain = 300 * time.Millisecond
+	s := newTestServer(t, WithDrainDelay(drain))
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.httpServer.Serve(ln)
+	readyz := "http://" + ln.Addr().String() + "/readyz"
+
+	started := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- s.ShutdownHTTP(context.Background()) }()
+
+	// Still served, and refusing traffic, while draining
+	for !s.shuttingDown.Load() {
+		time.Sleep(time.Millisecond)
+	}
+	resp, err := http.Get(readyz)
+	if err != nil {
+		t.Fatalf("GET /readyz while draining: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("GET /readyz while draining = %d, want 503", resp.StatusCode)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatalf("ShutdownHTTP() error = %v", err)
+	}
+	if elapsed := time.Since(started); elapsed < drain {
+		t.Errorf("ShutdownHTTP() returned after %s, before the %s drain", elapsed, drain)
+	}
+	if _, err := http.Get(readyz); err == nil {
+		t.Error("GET /readyz after the shutdown succeeded, want the listener closed")
+	}
+}
+
+func TestDrainBoundedByDeadline(t *testing.T) {
+	s := newTestServer(t, WithDrainDelay(time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	s.ShutdownHTTP(ctx)
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Errorf("ShutdownHTTP() took %s, want it cut short by the deadline", elapsed)
+	}
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	badger "github.com/dgraph-io/badger/v4"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultIdempotencyTTL = 24 * time.Hour
+
+	// idempotencyPendingTTL bounds how long a key stays reserved by a request
+	// that never completed, a crash for instance
+	idempotencyPendingTTL   = 60 * time.Second
+	maxIdempotencyKeyLength = 255
+	idempotencyKeyPrefix    = "idempotency:"
+)
+
+// idempotencyRecord is what is stored under a key: a reservation while the
+// first request runs, then its response. Body is sealed with a key only the
+// client holding the Idempotency-Key can derive, so the store never keeps a
+// readable response, such as the member token and passcode a join returns.
+type idempotencyRecord struct {
+	Pending     bool   `json:"pending,omitempty"`
+	Fingerprint string `json:"fingerprint"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// idempotent replays the stored response of a request bearing the same
+// Idempotency-Key header, so that a client retrying after a lost response
+// does not create a second resource. Keys are scoped to the caller and the
+// route; reusing one with a different body is refused with 422, and a retry
+// arriving while the first request runs gets 409. Server errors are not
+// stored, the request can be retried. Requests without the header go through.
+func (s *Server) idempotent(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get("Idempotency-Key")
+		if key == "" {
+			return next(c)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return problem(http.StatusBadRequest, "Idempotency-Key is too long")
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return problem(http.StatusBadRequest, "Invalid request body")
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+		sum := sha256.Sum256(body)
+		fingerprint := hex.EncodeToString(sum[:])
+
+		storeKey, sealKey := idempotencyKeys(c, key)
+		record, found, err := s.reserveIdempotencyKey(storeKey, fingerprint)
+		if err != nil {
+			return err
+		}
+		if found {
+			switch {
+			case record.Fingerprint != fingerprint:
+				return problem(http.StatusUnprocessableEntity, "Idempotency-Key was used with a different request").withCode(CodeIdempotencyMismatch)
+			case record.Pending:
+				return problem(http.StatusConflict, "A request with this Idempotency-Key is in progress").withCode(CodeIdempotencyPending)
+			}
+			body, err := openResponse(sealKey, record.Body)
+			if err != nil {
+				return err
+			}
+			c.Response().Header().Set("Idempotent-Replayed", "true")
+			return c.Blob(record.Status, record.ContentType, body)
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+		if err := next(c); err != nil {
+			// Render the problem here so that it is recorded too
+			c.Error(err)
+		}
+		c.Response().Writer = recorder.ResponseWriter
+
+		status := c.Response().Status
+		if !c.Response().Committed || status >= http.StatusInternalServerError {
+			s.kvmanager.Delete(storeKey)
+			return nil
+		}
+		sealed, err := sealResponse(sealKey, recorder.body.Bytes())
+		var value []byte
+		if err == nil {
+			value, err = json.Marshal(idempotencyRecord{
+				Fingerprint: fingerprint,
+				Status:      status,
+				ContentType: c.Response().Header().Get(echo.HeaderContentType),
+				Body:        sealed,
+			})
+		}
+		if err == nil {
+			err = s.kvmanager.InsertWithTTL(storeKey, value, int64(s.idempotencyTTL/time.Second))
+		}
+		if err != nil {
+			// The response is already sent, a retry will run the request again
+			s.logger.Sugar().Warnw("failed to store idempotent response", "error", err)
+			s.kvmanager.Delete(storeKey)
+		}
+		return nil
+	}
+}
+
+// reserveIdempotencyKey returns the record stored under key, or stores a
+// pending one when there is none yet and reports found false
+func (s *Server) reserveIdempotencyKey(key []byte, fingerprint string) (idempotencyRecord, bool, error) {
+	s.idempotencyMu.Lock()
+	defer s.idempotencyMu.Unlock()
+
+	var record idempotencyRecord
+	value, err := s.kvmanager.Get(key)
+	if err != nil && !errors.Is(err, badger.ErrKeyNotFound) {
+		return record, false, err
+	}
+	if err == nil && value != nil {
+		if err := json.Unmarshal(value, &record); err != nil {
+			return record, false, err
+		}
+		return record, true, nil
+	}
+
+	value, err = json.Marshal(idempotencyRecord{Pending: true, Fingerprint: fingerprint})
+	if err != nil {
+		return record, false, err
+	}
+	return record, false, s.kvmanager.InsertWithTTL(key, value, int64(idempotencyPendingTTL/time.Second))
+}
+
+// idempotencyKeys scopes an Idempotency-Key to the caller, the method and the
+// path, so two users or two routes never share a response. It returns the
+// key the record is stored under and the key its response is sealed with;
+// neither can be told from the other.
+func idempotencyKeys(c echo.Context, key string) (storeKey, sealKey []byte) {
+	scope := "anonymous"
+	if id, entityType, err := GetValuesFromToken(c); err == nil {
+		scope = entityType + ":" + id
+	}
+	scoped := scope + "\x00" + c.Request().Method + "\x00" + c.Request().URL.Path + "\x00" + key
+	stored := sha256.Sum256([]byte(scoped))
+	sealed := sha256.Sum256([]byte("response\x00" + scoped))
+	return []byte(idempotencyKeyPrefix + hex.EncodeToString(stored[:])), sealed[:]
+}
+
+// sealResponse encrypts a response body with AES-GCM, the nonce first
+func sealResponse(key, body []byte) ([]byte, error) {
+	gcm, err := responseCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(body)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, body, nil), nil
+}
+
+// openResponse decrypts a body sealed by sealResponse
+func openResponse(key, sealed []byte) ([]byte, error) {
+	gcm, err := responseCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("idempotency record is too short")
+	}
+	nonce, body := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, body, nil)
+}
+
+func responseCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// responseRecorder keeps a copy of the body written through it
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,220 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"radgifa/ent"
+
+	badger "github.com/dgraph-io/badger/v4"
+	"github.com/google/uuid"
+)
+
+// memKV keeps values in memory and ignores TTLs
+type memKV struct {
+	stubKV
+	mu     sync.Mutex
+	values map[string][]byte
+	// nilWhenMissing reports a missing key with a nil value instead of
+	// badger.ErrKeyNotFound, as stores of embedders may
+	nilWhenMissing bool
+}
+
+func newMemKV() *memKV {
+	return &memKV{values: make(map[string][]byte)}
+}
+
+func (kv *memKV) InsertWithTTL(key, value []byte, ttlSeconds int64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	kv.values[string(key)] = value
+	return nil
+}
+
+func (kv *memKV) Get(key []byte) ([]byte, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	value, ok := kv.values[string(key)]
+	if !ok && !kv.nilWhenMissing {
+		return nil, badger.ErrKeyNotFound
+	}
+	return value, nil
+}
+
+func (kv *memKV) Delete(key []byte) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	delete(kv.values, string(key))
+	return nil
+}
+
+type createService struct {
+	stubService
+	mu      sync.Mutex
+	created int
+}
+
+func (s *createService) CreateQuestionnaire(userID uuid.UUID, title, description string, lockAfterSubmit bool, ctx context.Context) (*ent.Questionnaire, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.created++
+	return &ent.Questionnaire{ID: uuid.New(), Title: title}, nil
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	svc := &createService{}
+	s := newTestServer(t, WithService(svc), WithKVManager(newMemKV()))
+	token := testToken(t, s, uuid.New(), "user")
+
+	post := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/questionnaires", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		return resp
+	}
+
+	first := post("retry-1", `{"title":"Lunch"}`)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first POST = %d %s, want %d", first.Code, first.Body, http.StatusCreated)
+	}
+	retry := post("retry-1", `{"title":"Lunch"}`)
+	if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+		t.Errorf("retry = %d %s, want %d %s", retry.Code, retry.Body, first.Code, first.Body)
+	}
+	if retry.Header().Get("Idempotent-Replayed") != "true" {
+		t.Error("retry is missing the Idempotent-Replayed header")
+	}
+	if svc.created != 1 {
+		t.Errorf("questionnaires created = %d, want 1", svc.created)
+	}
+
+	if resp := post("retry-1", `{"title":"Dinner"}`); resp.Code != http.StatusUnprocessableEntity {
+		t.Errorf("same key, other body = %d, want %d", resp.Code, http.StatusUnprocessableEntity)
+	}
+	if resp := post(strings.Repeat("k", 256), `{"title":"Lunch"}`); resp.Code != http.StatusBadRequest {
+		t.Errorf("long key = %d, want %d", resp.Code, http.StatusBadRequest)
+	}
+
+	post("retry-2", `{"title":"Lunch"}`)
+	post("", `{"title":"Lunch"}`)
+	post("", `{"title":"Lunch"}`)
+	if svc.created != 4 {
+		t.Errorf("questionnaires created = %d, want 4", svc.created)
+	}
+}
+
+func TestIdempotencyKeyNilWhenMissing(t *testing.T) {
+	svc := &createService{}
+	kv := newMemKV()
+	kv.nilWhenMissing = true
+	s := newTestServer(t, WithService(svc), WithKVManager(kv))
+	token := testToken(t, s, uuid.New(), "user")
+
+	for i := range 2 {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/questionnaires", strings.NewReader(`{"title":"Lunch"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Idempotency-Key", "retry-1")
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		if resp.Code != http.StatusCreated {
+			t.Fatalf("POST %d = %d %s, want %d", i+1, resp.Code, resp.Body, http.StatusCreated)
+		}
+	}
+	if svc.created != 1 {
+		t.Errorf("questionnaires created = %d, want 1", svc.created)
+	}
+}
+
+func TestIdempotencyKeyScopedToCaller(t *testing.T) {
+	svc := &createService{}
+	s := newTestServer(t, WithService(svc), WithKVManager(newMemKV()))
+
+	for range 2 {
+		req := httptest.NewRequest(http.MethodPost, "/api/questionnaires", strings.NewReader(`{"title":"Lunch"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "user"))
+		req.Header.Set("Idempotency-Key", "shared")
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		if resp.Code != http.StatusCreated || resp.Header().Get("Idempotent-Replayed") != "" {
+			t.Fatalf("POST = %d, replayed %q, want a fresh %d", resp.Code, resp.Header().Get("Idempotent-Replayed"), http.StatusCreated)
+		}
+	}
+	if svc.created != 2 {
+		t.Errorf("questionnaires created = %d, want 2", svc.created)
+	}
+}
+
+type joinService struct {
+	stubService
+	questionnaireID uuid.UUID
+	joins           atomic.Int32
+}
+
+func (s *joinService) IsMemberIdentifierAvailable(questionnaireID uuid.UUID, uniqueIdentifier string, ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+func (s *joinService) CreateAnonymousMember(questionnaireID uuid.UUID, uniqueIdentifier, displayName string, ctx context.Context) (*ent.Member, string, error) {
+	s.joins.Add(1)
+	return &ent.Member{ID: uuid.New(), UniqueIdentifier: uniqueIdentifier}, "123456", nil
+}
+
+func TestJoinReplayedSealed(t *testing.T) {
+	svc := &joinService{questionnaireID: uuid.New()}
+	kv := newMemKV()
+	kv.values["invite"] = []byte(svc.questionnaireID.String())
+	s := newTestServer(t, WithService(svc), WithKVManager(kv))
+
+	join := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/join/invite", strings.NewReader(`{"action":"register","unique_identifier":"alice","display_name":"Alice"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", "shared")
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		return resp
+	}
+
+	first := join()
+	if first.Code != http.StatusCreated || !strings.Contains(first.Body.String(), "123456") {
+		t.Fatalf("first POST join = %d %s, want %d with the passcode", first.Code, first.Body, http.StatusCreated)
+	}
+	second := join()
+	if second.Code != http.StatusCreated || second.Header().Get("Idempotent-Replayed") != "true" {
+		t.Fatalf("second POST join = %d %s, want a replayed %d", second.Code, second.Body, http.StatusCreated)
+	}
+	if second.Body.String() != first.Body.String() {
+		t.Errorf("replayed body = %s, want %s", second.Body, first.Body)
+	}
+	if n := svc.joins.Load(); n != 1 {
+		t.Errorf("CreateAnonymousMember called %d times, want 1", n)
+	}
+
+	var body struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(first.Body.Bytes(), &body); err != nil || body.Token == "" {
+		t.Fatalf("join response %s has no token: %v", first.Body, err)
+	}
+	for key, value := range kv.values {
+		if !strings.HasPrefix(key, idempotencyKeyPrefix) {
+			continue
+		}
+		if bytes.Contains(value, []byte("123456")) || bytes.Contains(value, []byte(body.Token)) {
+			t.Errorf("KV store holds the passcode or the member token in clear under %q", key)
+		}
+	}
+}
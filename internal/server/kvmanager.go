@@ -1,30 +1,29 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"sync"
 	"time"
 
 	badger "github.com/dgraph-io/badger/v4"
 )
 
-const (
-	defaultPath = "./tmp"
-)
-
-var (
-	kvstoragePath = getKVStoragePath()
-)
-
 type kvmanager struct {
-	db     *badger.DB
-	ticker *time.Ticker
-	done   chan struct{}
+	db       *badger.DB
+	ticker   *time.Ticker
+	done     chan struct{}
+	gcExited chan struct{}
+	stopGC   sync.Once
 }
 
+// KVManager is the key-value store the handlers keep invitation tokens and
+// idempotency records in. Get reports a missing key with a nil value or with
+// badger.ErrKeyNotFound; any other error is a failure of the store.
 type KVManager interface {
-	// Define methods for the KVManager interface here
 	Close() error
 	InsertWithTTL(key, value []byte, ttlSeconds int64) error
 	InsertPersistent(key, value []byte) error
@@ -32,14 +31,58 @@ type KVManager interface {
 	Delete(key []byte) error
 }
 
-func NewKVManager() KVManager {
-	tmpStat, err := os.Stat(kvstoragePath)
+// KVAdmin adds the maintenance and inspection of the store OpenKVManager
+// opens. A KVManager given to the server may implement part of it: /readyz
+// checks the stores with a Ping method, /metrics reports the size of those
+// with a Size method, the shutdown stops the collection of those with a
+// StopGC method, and the rest is for radgifactl.
+type KVAdmin interface {
+	KVManager
+
+	// StopGC stops the garbage collection routine, waiting for a running
+	// collection to finish. Close calls it too.
+	StopGC(ctx context.Context) error
+
+	Ping() error
+	Size() (lsm, vlog int64)
+	Stats() (KVStats, error)
+	List(prefix []byte, limit int) ([]KVEntry, error)
+	Purge() error
+}
+
+// The parts of KVAdmin the server uses when the store has them
+type (
+	kvPinger    interface{ Ping() error }
+	kvSizer     interface{ Size() (lsm, vlog int64) }
+	kvCollector interface {
+		StopGC(ctx context.Context) error
+	}
+)
+
+// KVStats summarises the on-disk state of the store
+type KVStats struct {
+	LSMSize  int64 `json:"lsm_size_bytes"`
+	VLogSize int64 `json:"vlog_size_bytes"`
+	Keys     int   `json:"keys"`
+}
+
+// KVEntry describes a stored key without its value
+type KVEntry struct {
+	Key       string `json:"key"`
+	Size      int64  `json:"value_size_bytes"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+}
+
+// OpenKVManager opens (or creates) a Badger store under path and starts its
+// garbage collection routine
+func OpenKVManager(path string) (KVAdmin, error) {
+	tmpStat, err := os.Stat(path)
 	if err != nil || (tmpStat != nil && !tmpStat.IsDir()) {
-		if err := os.MkdirAll(kvstoragePath, 0755); err != nil {
-			log.Fatalf("Failed to create KV storage directory at %s: %v", kvstoragePath, err)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create KV storage directory at %s: %w", path, err)
 		}
 	}
-	badgerkvstoragePath := fmt.Sprintf("%s/badger", kvstoragePath)
+	badgerkvstoragePath := fmt.Sprintf("%s/badger", path)
 
 	db, err := badger.Open(badger.
 		DefaultOptions(badgerkvstoragePath).
@@ -48,45 +91,58 @@ func NewKVManager() KVManager {
 		WithNumMemtables(3).
 		WithSyncWrites(false))
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	kvm := &kvmanager{
-		db:     db,
-		ticker: time.NewTicker(5 * time.Minute),
-		done:   make(chan struct{}),
+		db:       db,
+		ticker:   time.NewTicker(5 * time.Minute),
+		done:     make(chan struct{}),
+		gcExited: make(chan struct{}),
 	}
 
 	// Start garbage collection routine
 	go kvm.runGC()
 
-	return kvm
+	return kvm, nil
 }
 
-func getKVStoragePath() string {
-	path := os.Getenv("KV_STORAGE_PATH")
-	if path == "" {
-		return defaultPath
+func (kvm *kvmanager) Close() error {
+	if err := kvm.StopGC(context.Background()); err != nil {
+		return err
 	}
-	return path
+	return kvm.db.Close()
 }
 
-func (kvm *kvmanager) Close() error {
-	// Stop the GC ticker and goroutine
-	kvm.ticker.Stop()
-	close(kvm.done)
+func (kvm *kvmanager) StopGC(ctx context.Context) error {
+	kvm.stopGC.Do(func() {
+		kvm.ticker.Stop()
+		close(kvm.done)
+	})
 
-	return kvm.db.Close()
+	select {
+	case <-kvm.gcExited:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // runGC runs the BadgerDB garbage collector periodically
 func (kvm *kvmanager) runGC() {
+	defer close(kvm.gcExited)
 	for {
 		select {
 		case <-kvm.ticker.C:
 			// Run GC with 0.5 discard ratio (collect if 50% or more can be reclaimed)
 			err := kvm.db.RunValueLogGC(0.5)
-			if err != nil && err != badger.ErrNoRewrite {
+			switch {
+			case err == nil:
+				kvGCRunsTotal.WithLabelValues("rewritten").Inc()
+			case err == badger.ErrNoRewrite:
+				kvGCRunsTotal.WithLabelValues("nothing").Inc()
+			default:
+				kvGCRunsTotal.WithLabelValues("error").Inc()
 				log.Printf("BadgerDB GC error: %v", err)
 			}
 		case <-kvm.done:
@@ -137,3 +193,80 @@ func (kvm *kvmanager) Delete(key []byte) error {
 	})
 	return err
 }
+
+// Ping checks that the store is open and can serve a read
+func (kvm *kvmanager) Ping() error {
+	if kvm.db.IsClosed() {
+		return errors.New("kv store is closed")
+	}
+	return kvm.db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get([]byte("readyz"))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		return err
+	})
+}
+
+// Size returns the LSM tree and value log sizes in bytes
+func (kvm *kvmanager) Size() (lsm, vlog int64) {
+	return kvm.db.Size()
+}
+
+func (kvm *kvmanager) Stats() (KVStats, error) {
+	lsm, vlog := kvm.db.Size()
+	stats := KVStats{LSMSize: lsm, VLogSize: vlog}
+	err := kvm.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			stats.Keys++
+		}
+		return nil
+	})
+	return stats, err
+}
+
+// List returns up to limit live keys starting with prefix (0 means no limit)
+func (kvm *kvmanager) List(prefix []byte, limit int) ([]KVEntry, error) {
+	entries := []KVEntry{}
+	err := kvm.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = prefix
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			entries = append(entries, KVEntry{
+				Key:       string(item.KeyCopy(nil)),
+				Size:      item.ValueSize(),
+				ExpiresAt: int64(item.ExpiresAt()),
+			})
+			if limit > 0 && len(entries) >= limit {
+				break
+			}
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// Purge compacts the LSM tree, dropping expired and deleted keys, and then
+// rewrites value log files until there is nothing left to reclaim
+func (kvm *kvmanager) Purge() error {
+	if err := kvm.db.Flatten(1); err != nil {
+		return fmt.Errorf("flattening LSM tree: %w", err)
+	}
+	for {
+		err := kvm.db.RunValueLogGC(0.5)
+		if err == badger.ErrNoRewrite {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("running value log GC: %w", err)
+		}
+	}
+}
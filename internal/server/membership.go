@@ -0,0 +1,112 @@
+package server
+
+import (
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// MembershipResponse is a questionnaire the user joined, with their progress
+type MembershipResponse struct {
+	MemberID      string                  `json:"member_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DisplayName   string                  `json:"display_name" example:"John"`
+	JoinedAt      int64                   `json:"joined_at" example:"1735689600000"`
+	Questionnaire MembershipQuestionnaire `json:"questionnaire"`
+	Progress      MembershipProgress      `json:"progress"`
+}
+
+// MembershipQuestionnaire summarises the questionnaire of a membership
+type MembershipQuestionnaire struct {
+	ID          string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string `json:"title" example:"Best Pizza Topping"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status" example:"published" enums:"draft,published"`
+}
+
+// MembershipProgress counts the questions the member answered
+type MembershipProgress struct {
+	Answered int  `json:"answered" example:"3"`
+	Total    int  `json:"total" example:"5"`
+	Complete bool `json:"complete" example:"false"`
+}
+
+func newMembershipResponse(m database.Membership) MembershipResponse {
+	q := m.Member.Edges.Questionnaire
+	status := "draft"
+	if q.IsPublished {
+		status = "published"
+	}
+	return MembershipResponse{
+		MemberID:    m.Member.ID.String(),
+		DisplayName: m.Member.DisplayName,
+		JoinedAt:    m.Member.CreatedAt,
+		Questionnaire: MembershipQuestionnaire{
+			ID:          q.ID.String(),
+			Title:       q.Title,
+			Description: q.Description,
+			Status:      status,
+		},
+		Progress: newProgress(m.AnsweredCount, m.QuestionCount),
+	}
+}
+
+func newProgress(answered, total int) MembershipProgress {
+	return MembershipProgress{
+		Answered: answered,
+		Total:    total,
+		Complete: total > 0 && answered >= total,
+	}
+}
+
+// getUserMemberships returns the questionnaires the authenticated user joined
+// @Summary Get user memberships
+// @Description Get the questionnaires the authenticated user joined as a member, most recent first, with how many questions they answered
+// @Tags memberships
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Page size, 1 to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param order query string false "Sort order of the join date" Enums(asc, desc) default(desc)
+// @Param published query bool false "Only published or draft questionnaires"
+// @Param q query string false "Case insensitive search in the questionnaire title"
+// @Success 200 {object} PageResponse[MembershipResponse] "Page of memberships"
+// @Failure 400 {object} Problem "Invalid query parameters"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/memberships [get]
+func (s *Server) getUserMemberships(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	pageReq, err := parsePageRequest(c)
+	if err != nil {
+		return err
+	}
+	filter := database.MembershipFilter{Title: c.QueryParam("q")}
+	if filter.Published, err = parseBoolParam(c, "published"); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	page, err := s.service.ListUserMemberships(userID, filter, pageReq, ctx)
+	if isPageRequestError(err) {
+		return err
+	}
+	if err != nil {
+		log := GetLogger(c)
+		log.Error("failed to get user memberships",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		return problem(500, "could not get memberships")
+	}
+
+	return c.JSON(200, mapPageResponse(page, newMembershipResponse))
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type membershipService struct {
+	stubService
+	userID uuid.UUID
+}
+
+func (s *membershipService) ListUserMemberships(userID uuid.UUID, filter database.MembershipFilter, req database.PageRequest, ctx context.Context) (database.Page[database.Membership], error) {
+	s.userID = userID
+	member := &ent.Member{ID: uuid.New(), DisplayName: "Alice", CreatedAt: 1735689600000}
+	member.Edges.Questionnaire = &ent.Questionnaire{ID: uuid.New(), Title: "Pizza night", IsPublished: true}
+	return database.Page[database.Membership]{
+		Items: []database.Membership{{Member: member, AnsweredCount: 2, QuestionCount: 2}},
+		Total: 1,
+		Limit: database.DefaultPageLimit,
+		Sort:  "created_at",
+		Order: "desc",
+	}, nil
+}
+
+func TestGetUserMemberships(t *testing.T) {
+	service := &membershipService{}
+	s := newTestServer(t, WithService(service))
+	userID := uuid.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/memberships", nil)
+	req.Header.Set("Authorization", "Bearer "+testToken(t, s, userID, "user"))
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, req)
+	if resp.Code != http.StatusOK {
+		t.Fatalf("GET /api/memberships = %d %s", resp.Code, resp.Body)
+	}
+	if service.userID != userID {
+		t.Errorf("listed memberships of %s, want %s", service.userID, userID)
+	}
+
+	var page PageResponse[MembershipResponse]
+	if err := json.Unmarshal(resp.Body.Bytes(), &page); err != nil {
+		t.Fatalf("decoding envelope: %v", err)
+	}
+	if len(page.Items) != 1 {
+		t.Fatalf("got %d memberships, want 1", len(page.Items))
+	}
+	got := page.Items[0]
+	if got.Questionnaire.Title != "Pizza night" || got.Questionnaire.Status != "published" {
+		t.Errorf("questionnaire = %+v", got.Questionnaire)
+	}
+	if got.Progress != (MembershipProgress{Answered: 2, Total: 2, Complete: true}) {
+		t.Errorf("progress = %+v", got.Progress)
+	}
+
+	// Anonymous members have no other questionnaires to list
+	req = httptest.NewRequest(http.MethodGet, "/api/memberships", nil)
+	req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "member"))
+	resp = httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, req)
+	if resp.Code != http.StatusUnauthorized {
+		t.Errorf("GET /api/memberships as a member = %d, want 401", resp.Code)
+	}
+}
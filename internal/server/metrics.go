@@ -0,0 +1,188 @@
+package server
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"radgifa/internal/webhook"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "radgifa"
+
+// Process wide collectors, registered in every Server's registry. They are
+// shared: with several embedded servers in one process, each /metrics
+// reports the counts of all of them, not its own.
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests handled, by route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency, by route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	kvGCRunsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "kv_gc_runs_total",
+		Help:      "Badger value log GC runs, by result (rewritten, nothing, error).",
+	}, []string{"result"})
+
+	questionnairesCreatedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "questionnaires_created_total",
+		Help:      "Questionnaires created.",
+	})
+
+	membersJoinedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "members_joined_total",
+		Help:      "Members that joined a questionnaire, by kind (user, anonymous).",
+	}, []string{"kind"})
+
+	answersSubmittedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "answers_submitted_total",
+		Help:      "Answers created or updated.",
+	})
+
+	loginFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "login_failures_total",
+		Help:      "Failed logins, by kind (user, member).",
+	}, []string{"kind"})
+
+	legacyRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "legacy_api_requests_total",
+		Help:      "Requests to the deprecated unversioned API routes, by route.",
+	}, []string{"route"})
+)
+
+// newMetricsRegistry builds the registry served on /metrics
+func (s *Server) newMetricsRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		kvGCRunsTotal,
+		questionnairesCreatedTotal,
+		membersJoinedTotal,
+		answersSubmittedTotal,
+		loginFailuresTotal,
+		legacyRequestsTotal,
+		webhook.AttemptsTotal,
+		newDBStatsCollector(s.service.Stats),
+	)
+	if kv, ok := s.kvmanager.(kvSizer); ok {
+		reg.MustRegister(
+			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "kv_lsm_size_bytes",
+				Help:      "Size of the Badger LSM tree.",
+			}, func() float64 {
+				lsm, _ := kv.Size()
+				return float64(lsm)
+			}),
+			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "kv_vlog_size_bytes",
+				Help:      "Size of the Badger value log.",
+			}, func() float64 {
+				_, vlog := kv.Size()
+				return float64(vlog)
+			}),
+		)
+	}
+	return reg
+}
+
+func (s *Server) metricsHandler() echo.HandlerFunc {
+	return echo.WrapHandler(promhttp.HandlerFor(s.newMetricsRegistry(), promhttp.HandlerOpts{}))
+}
+
+// prometheusMiddleware counts requests and measures their latency by route
+// template, so path parameters do not explode the label cardinality
+func prometheusMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			err := next(c)
+			if err != nil {
+				// Let the error handler write the response so the real status is recorded
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+			status := strconv.Itoa(c.Response().Status)
+			method := c.Request().Method
+
+			httpRequestsTotal.WithLabelValues(method, route, status).Inc()
+			httpRequestDuration.WithLabelValues(method, route, status).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
+
+// dbStatsCollector exports the connection pool statistics of the database
+type dbStatsCollector struct {
+	stats func() sql.DBStats
+
+	maxOpen      *prometheus.Desc
+	open         *prometheus.Desc
+	inUse        *prometheus.Desc
+	idle         *prometheus.Desc
+	waitCount    *prometheus.Desc
+	waitDuration *prometheus.Desc
+}
+
+func newDBStatsCollector(stats func() sql.DBStats) *dbStatsCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "db", name), help, nil, nil)
+	}
+	return &dbStatsCollector{
+		stats:        stats,
+		maxOpen:      desc("max_open_connections", "Maximum number of open connections to the database."),
+		open:         desc("open_connections", "Established connections, both in use and idle."),
+		inUse:        desc("in_use_connections", "Connections currently in use."),
+		idle:         desc("idle_connections", "Idle connections."),
+		waitCount:    desc("wait_count_total", "Connections waited for."),
+		waitDuration: desc("wait_duration_seconds_total", "Time blocked waiting for a new connection."),
+	}
+}
+
+func (c *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxOpen
+	ch <- c.open
+	ch <- c.inUse
+	ch <- c.idle
+	ch <- c.waitCount
+	ch <- c.waitDuration
+}
+
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.stats()
+	ch <- prometheus.MustNewConstMetric(c.maxOpen, prometheus.GaugeValue, float64(stats.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.inUse, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stats.Idle))
+	ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, stats.WaitDuration.Seconds())
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+)
+
+type stubService struct {
+	database.Service
+	pingErr error
+}
+
+func (s stubService) Ping(context.Context) error {
+	return s.pingErr
+}
+
+func (stubService) Health() map[string]string {
+	return map[string]string{"status": "up"}
+}
+
+// Client gives the GraphQL handler a client without a database to restrict
+func (stubService) Client() *ent.Client {
+	return ent.NewClient()
+}
+
+func (stubService) Stats() sql.DBStats {
+	return sql.DBStats{OpenConnections: 3}
+}
+
+type stubKV struct {
+	KVManager
+}
+
+func (stubKV) Size() (int64, int64) {
+	return 10, 20
+}
+
+func (stubKV) Ping() error {
+	return nil
+}
+
+func newTestServer(t *testing.T, opts ...Option) *Server {
+	t.Helper()
+	opts = append([]Option{
+		WithService(stubService{}),
+		WithKVManager(stubKV{}),
+		WithSigningKeys([]byte("test-signing-key")),
+	}, opts...)
+	s, err := New(opts...)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return s
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	s := newTestServer(t)
+
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	resp = httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if resp.Code != http.StatusOK {
+		t.Fatalf("GET /metrics status = %d, want %d", resp.Code, http.StatusOK)
+	}
+
+	body := resp.Body.String()
+	for _, want := range []string{
+		`radgifa_http_requests_total{method="GET",route="/health",status="200"}`,
+		`radgifa_db_open_connections 3`,
+		`radgifa_kv_vlog_size_bytes 20`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("/metrics output is missing %s", want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestReorderQuestionsValidation(t *testing.T) {
+	s := newTestServer(t)
+	path := "/api/questionnaires/" + uuid.NewString() + "/questions/order"
+
+	tests := []struct {
+		name       string
+		entityType string
+		body       string
+		want       int
+		wantError  string
+	}{
+		{"member", "member", `{"question_ids":["` + uuid.NewString() + `"]}`, http.StatusUnauthorized, "unauthorized"},
+		{"empty order", "user", `{"question_ids":[]}`, http.StatusBadRequest, "question_ids"},
+		{"invalid id", "user", `{"question_ids":["nope"]}`, http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPut, path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), tt.entityType))
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		if resp.Code != tt.want {
+			t.Errorf("%s: PUT %s = %d %s, want %d", tt.name, path, resp.Code, resp.Body, tt.want)
+		}
+		if !strings.Contains(strings.ToLower(resp.Body.String()), tt.wantError) {
+			t.Errorf("%s: body %s does not mention %q", tt.name, resp.Body, tt.wantError)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"radgifa/internal/database"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PageResponse is the envelope of every paginated listing. NextCursor is
+// passed back as the cursor query parameter to get the next page, and is
+// omitted on the last one.
+type PageResponse[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int    `json:"total" example:"42"`
+	Limit      int    `json:"limit" example:"20"`
+	Sort       string `json:"sort" example:"created_at"`
+	Order      string `json:"order" example:"desc"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoiY3JlYXRlZF9hdCJ9"`
+}
+
+// mapPageResponse builds the envelope of a page whose items are converted to
+// a response type
+func mapPageResponse[T, R any](page database.Page[T], convert func(T) R) PageResponse[R] {
+	items := make([]R, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = convert(item)
+	}
+	return PageResponse[R]{
+		Items:      items,
+		Total:      page.Total,
+		Limit:      page.Limit,
+		Sort:       page.Sort,
+		Order:      page.Order,
+		NextCursor: page.NextCursor,
+	}
+}
+
+// parsePageRequest reads the limit, cursor, sort and order query parameters
+func parsePageRequest(c echo.Context) (database.PageRequest, error) {
+	req := database.PageRequest{
+		Cursor: c.QueryParam("cursor"),
+		Sort:   c.QueryParam("sort"),
+		Order:  c.QueryParam("order"),
+	}
+	if raw := c.QueryParam("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > database.MaxPageLimit {
+			return req, invalidParam("limit", fmt.Sprintf("limit must be between 1 and %d", database.MaxPageLimit))
+		}
+		req.Limit = limit
+	}
+	return req, nil
+}
+
+// parseCreatedRange reads created_after and created_before, given as RFC 3339
+// timestamps or Unix milliseconds
+func parseCreatedRange(c echo.Context) (database.CreatedRange, error) {
+	var r database.CreatedRange
+	var err error
+	if r.After, err = parseTimeParam(c, "created_after"); err != nil {
+		return r, err
+	}
+	if r.Before, err = parseTimeParam(c, "created_before"); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+func parseTimeParam(c echo.Context, name string) (int64, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return 0, nil
+	}
+	if millis, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return millis, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return 0, invalidParam(name, name+" must be an RFC 3339 timestamp or Unix milliseconds")
+	}
+	return t.UnixMilli(), nil
+}
+
+// parseBoolParam reads an optional boolean query parameter
+func parseBoolParam(c echo.Context, name string) (*bool, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, invalidParam(name, name+" must be true or false")
+	}
+	return &b, nil
+}
+
+// isPageRequestError tells whether a listing failed because of the cursor or
+// the sort parameters rather than the database
+func isPageRequestError(err error) bool {
+	return errors.Is(err, database.ErrInvalidCursor) || errors.Is(err, database.ErrInvalidSort)
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type listingService struct {
+	stubService
+	filter database.QuestionnaireFilter
+	req    database.PageRequest
+}
+
+func (s *listingService) ListUserQuestionnaires(userID uuid.UUID, filter database.QuestionnaireFilter, req database.PageRequest, ctx context.Context) (database.Page[*ent.Questionnaire], error) {
+	s.filter, s.req = filter, req
+	if req.Sort == "description" {
+		return database.Page[*ent.Questionnaire]{}, database.ErrInvalidSort
+	}
+	return database.Page[*ent.Questionnaire]{
+		Items:      []*ent.Questionnaire{{Title: "Pizza night"}},
+		Total:      3,
+		Limit:      1,
+		Sort:       "created_at",
+		Order:      "desc",
+		NextCursor: "next",
+	}, nil
+}
+
+func TestListQuestionnairesQuery(t *testing.T) {
+	service := &listingService{}
+	s := newTestServer(t, WithService(service))
+	token := testToken(t, s, uuid.New(), "user")
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/questionnaires?"+query, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		return resp
+	}
+
+	resp := get("limit=1&cursor=abc&published=true&q=pizza&created_after=2025-01-01T00:00:00Z&created_before=1767225600000")
+	if resp.Code != http.StatusOK {
+		t.Fatalf("GET /api/questionnaires = %d %s", resp.Code, resp.Body)
+	}
+	if service.req.Limit != 1 || service.req.Cursor != "abc" {
+		t.Errorf("page request = %+v", service.req)
+	}
+	after := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	if f := service.filter; f.Published == nil || !*f.Published || f.Title != "pizza" || f.Created.After != after || f.Created.Before != 1767225600000 {
+		t.Errorf("filter = %+v", f)
+	}
+
+	var page PageResponse[ent.Questionnaire]
+	if err := json.Unmarshal(resp.Body.Bytes(), &page); err != nil {
+		t.Fatalf("decoding envelope: %v", err)
+	}
+	if page.Total != 3 || len(page.Items) != 1 || page.NextCursor != "next" || page.Order != "desc" {
+		t.Errorf("envelope = %+v", page)
+	}
+
+	for _, query := range []string{"limit=0", "limit=101", "published=maybe", "created_after=yesterday", "sort=description"} {
+		if resp := get(query); resp.Code != http.StatusBadRequest {
+			t.Errorf("GET ?%s = %d, want 400", query, resp.Code)
+		}
+	}
+}
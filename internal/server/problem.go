@@ -0,0 +1,208 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"radgifa/internal/database"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// Every error response is an RFC 7807 problem details object served as
+// application/problem+json. Handlers return a *Problem, or a domain error of
+// the database package, and httpErrorHandler renders it. Code is stable and
+// meant for programs; Detail is meant for people and may change.
+
+const problemContentType = "application/problem+json"
+
+// Stable error codes that are not domain errors of the database package
+const (
+	CodeBadRequest             = "bad_request"
+	CodeValidationFailed       = "validation_failed"
+	CodeInvalidParameter       = "invalid_parameter"
+	CodeUnauthorized           = "unauthorized"
+	CodeInvalidCredentials     = "invalid_credentials"
+	CodeForbidden              = "forbidden"
+	CodeQuestionnairePublished = "questionnaire_published"
+	CodeNotFound               = "not_found"
+	CodeMethodNotAllowed       = "method_not_allowed"
+	CodeConflict               = "conflict"
+	CodeAlreadyTaken           = "already_taken"
+	CodePreconditionFailed     = "precondition_failed"
+	CodeIdempotencyMismatch    = "idempotency_key_reused"
+	CodeIdempotencyPending     = "idempotency_key_in_progress"
+	CodeRateLimited            = "rate_limited"
+	CodeInternal               = "internal_error"
+	CodeUnavailable            = "unavailable"
+)
+
+// Problem is the body of every error response
+type Problem struct {
+	Type      string       `json:"type" example:"urn:radgifa:problem:validation_failed"`
+	Title     string       `json:"title" example:"Bad Request"`
+	Status    int          `json:"status" example:"400"`
+	Detail    string       `json:"detail,omitempty" example:"the request is not valid"`
+	Instance  string       `json:"instance,omitempty" example:"/api/questionnaires"`
+	Code      string       `json:"code" example:"validation_failed"`
+	RequestID string       `json:"request_id,omitempty" example:"6fd0c9a4e1b84a1f"`
+	Errors    []FieldError `json:"errors,omitempty"`
+	// MissingQuestionIDs lists the required questions left unanswered when
+	// a submission is refused
+	MissingQuestionIDs []string `json:"missing_question_ids,omitempty"`
+}
+
+// FieldError tells which field of the request is invalid and why
+type FieldError struct {
+	Field   string `json:"field" example:"title"`
+	Code    string `json:"code" example:"required"`
+	Message string `json:"message" example:"title is required"`
+}
+
+// problem returns a Problem with the default code of the status
+func problem(status int, detail string) *Problem {
+	return &Problem{Status: status, Code: statusCode(status), Detail: detail}
+}
+
+// withCode replaces the code of the problem
+func (p *Problem) withCode(code string) *Problem {
+	p.Code = code
+	return p
+}
+
+// validationProblem reports invalid fields of the request
+func validationProblem(status int, code string, errs ...FieldError) *Problem {
+	p := problem(status, "the request is not valid").withCode(code)
+	p.Errors = errs
+	return p
+}
+
+// invalidParam reports an invalid query parameter
+func invalidParam(name, message string) *Problem {
+	return validationProblem(http.StatusBadRequest, CodeInvalidParameter, FieldError{Field: name, Code: "invalid", Message: message})
+}
+
+func (p *Problem) Error() string {
+	return p.Code + ": " + p.Detail
+}
+
+// statusCode is the code of a problem that has nothing more specific to say
+// than its status
+func statusCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusPreconditionFailed:
+		return CodePreconditionFailed
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
+
+// domainStatus maps the domain errors to a status: by kind, except for the
+// few codes HTTP has a better status for
+func domainStatus(err *database.Error) int {
+	switch {
+	case err.Code == database.ErrVersionMismatch.Code:
+		return http.StatusPreconditionFailed
+	case errors.Is(err, database.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, database.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, database.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, database.ErrInvalid):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// toProblem turns any error a handler or a middleware returns into a Problem
+func toProblem(err error) *Problem {
+	var (
+		p       *Problem
+		missing *database.MissingAnswersError
+		domain  *database.Error
+		he      *echo.HTTPError
+	)
+	switch {
+	case errors.As(err, &p):
+		cp := *p
+		return &cp
+	case errors.As(err, &missing):
+		cp := problem(http.StatusBadRequest, err.Error()).withCode(database.ErrMissingRequiredAnswers.Code)
+		cp.MissingQuestionIDs = make([]string, len(missing.QuestionIDs))
+		for i, id := range missing.QuestionIDs {
+			cp.MissingQuestionIDs[i] = id.String()
+		}
+		return cp
+	case errors.As(err, &domain):
+		status := domainStatus(domain)
+		if status == http.StatusInternalServerError {
+			break
+		}
+		return problem(status, err.Error()).withCode(domain.Code)
+	case errors.As(err, &he):
+		if he.Code >= http.StatusInternalServerError {
+			break
+		}
+		detail, ok := he.Message.(string)
+		if !ok {
+			detail = http.StatusText(he.Code)
+		}
+		return problem(he.Code, detail)
+	}
+	return problem(http.StatusInternalServerError, "internal server error")
+}
+
+// httpErrorHandler renders the errors returned by handlers as problems.
+// Unexpected errors are logged and answered with a bare 500.
+func (s *Server) httpErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	p := toProblem(err)
+	if p.Status >= http.StatusInternalServerError {
+		GetLogger(c).Error("request failed",
+			zap.String("path", c.Path()),
+			zap.Int("status", p.Status),
+			zap.Error(err))
+	}
+	p.Type = "urn:radgifa:problem:" + p.Code
+	p.Title = http.StatusText(p.Status)
+	p.Instance = c.Request().URL.Path
+	p.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+
+	c.Response().Header().Set(echo.HeaderContentType, problemContentType)
+	if c.Request().Method == http.MethodHead {
+		c.Response().WriteHeader(p.Status)
+		return
+	}
+	body, merr := json.Marshal(p)
+	if merr != nil {
+		c.Response().WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	c.Response().WriteHeader(p.Status)
+	c.Response().Write(body)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+func decodeProblem(t *testing.T, resp *httptest.ResponseRecorder) Problem {
+	t.Helper()
+	if ct := resp.Header().Get("Content-Type"); ct != problemContentType {
+		t.Fatalf("Content-Type = %q, want %q", ct, problemContentType)
+	}
+	var p Problem
+	if err := json.Unmarshal(resp.Body.Bytes(), &p); err != nil {
+		t.Fatalf("decoding %s: %v", resp.Body, err)
+	}
+	if p.Status != resp.Code || p.Type != "urn:radgifa:problem:"+p.Code {
+		t.Errorf("problem %+v does not match status %d", p, resp.Code)
+	}
+	return p
+}
+
+func TestProblemResponses(t *testing.T) {
+	s := newTestServer(t)
+	token := testToken(t, s, uuid.New(), "user")
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		token     string
+		body      string
+		want      int
+		wantCode  string
+		wantField string
+	}{
+		{"validation", http.MethodPost, "/api/questionnaires", token, `{"title":""}`, http.StatusBadRequest, CodeValidationFailed, "title"},
+		{"answer validation", http.MethodPost, "/api/question/" + uuid.NewString(), token, `{"answer_value":"Maybe"}`, http.StatusBadRequest, CodeValidationFailed, "answer_value"},
+		{"nested validation", http.MethodPost, "/api/questionnaires/" + uuid.NewString() + "/answers", token, `{"answers":[{"answer_value":"Yes"}]}`, http.StatusBadRequest, CodeValidationFailed, "answers[0].question_id"},
+		{"query parameter", http.MethodGet, "/api/questionnaires?limit=0", token, ``, http.StatusBadRequest, CodeInvalidParameter, "limit"},
+		{"malformed body", http.MethodPost, "/api/questionnaires", token, `{"title":`, http.StatusBadRequest, CodeBadRequest, ""},
+		{"missing token", http.MethodGet, "/api/questionnaires", "", ``, http.StatusUnauthorized, CodeUnauthorized, ""},
+		{"unknown route", http.MethodGet, "/api/nope", token, ``, http.StatusNotFound, CodeNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			resp := httptest.NewRecorder()
+			s.Handler().ServeHTTP(resp, req)
+			if resp.Code != tt.want {
+				t.Fatalf("%s %s = %d %s, want %d", tt.method, tt.path, resp.Code, resp.Body, tt.want)
+			}
+
+			p := decodeProblem(t, resp)
+			if p.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", p.Code, tt.wantCode)
+			}
+			if tt.wantField != "" && (len(p.Errors) == 0 || p.Errors[0].Field != tt.wantField) {
+				t.Errorf("errors = %+v, want one on %q", p.Errors, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestToProblem(t *testing.T) {
+	missing := uuid.New()
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{"version mismatch", fmt.Errorf("saving: %w", database.ErrVersionMismatch), http.StatusPreconditionFailed, "version_mismatch"},
+		{"invalid", fmt.Errorf("%w: question answered twice", database.ErrInvalidAnswers), http.StatusBadRequest, "invalid_answers"},
+		{"conflict", database.ErrQuestionHidden, http.StatusConflict, "question_hidden"},
+		{"missing answers", &database.MissingAnswersError{QuestionIDs: []uuid.UUID{missing}}, http.StatusBadRequest, "missing_required_answers"},
+		{"problem", problem(http.StatusForbidden, "not yours"), http.StatusForbidden, CodeForbidden},
+		{"unexpected", errors.New("connection refused"), http.StatusInternalServerError, CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := toProblem(tt.err)
+			if p.Status != tt.wantStatus || p.Code != tt.wantCode {
+				t.Errorf("toProblem(%v) = %d %q, want %d %q", tt.err, p.Status, p.Code, tt.wantStatus, tt.wantCode)
+			}
+			if tt.wantStatus == http.StatusInternalServerError && strings.Contains(p.Detail, "refused") {
+				t.Errorf("detail %q leaks the error", p.Detail)
+			}
+			if tt.name == "missing answers" && (len(p.MissingQuestionIDs) != 1 || p.MissingQuestionIDs[0] != missing.String()) {
+				t.Errorf("missing_question_ids = %v, want [%s]", p.MissingQuestionIDs, missing)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package server
 
 import (
+	"errors"
 	"net/http"
 
+	"radgifa/internal/database"
+
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -12,6 +15,23 @@ type AnswerRequest struct {
 	AnswerValue string `json:"answer_value" validate:"required,oneof=Yes No Pass" example:"Yes"`
 }
 
+// AnswersRequest carries all the answers of a member at once
+type AnswersRequest struct {
+	Answers []QuestionAnswerRequest `json:"answers" validate:"required,min=1,max=500,dive"`
+}
+
+type QuestionAnswerRequest struct {
+	QuestionID  uuid.UUID `json:"question_id" validate:"required" swaggertype:"string" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	AnswerValue string    `json:"answer_value" validate:"required,oneof=Yes No Pass" example:"Yes"`
+}
+
+// SavedAnswer is one answer of a batch once saved
+type SavedAnswer struct {
+	AnswerID    string `json:"answer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	QuestionID  string `json:"question_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	AnswerValue string `json:"answer_value" example:"Yes"`
+}
+
 // newQuestionAnswer creates an answer for a specific question
 // @Summary Answer a question
 // @Description Create an answer for a specific question in a questionnaire
@@ -22,12 +42,13 @@ type AnswerRequest struct {
 // @Param id path string true "Question ID"
 // @Param answer body AnswerRequest true "Answer data"
 // @Success 201 {object} map[string]interface{} "Answer created successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Question not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/question/{id} [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Question not found"
+// @Failure 409 {object} Problem "Question hidden by the member's previous answers, or answers locked after submission"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/question/{id} [post]
 func (s *Server) newQuestionAnswer(c echo.Context) error {
 	log := GetLogger(c)
 
@@ -35,32 +56,19 @@ func (s *Server) newQuestionAnswer(c echo.Context) error {
 	questionID, err := uuid.Parse(questionIDStr)
 	if err != nil {
 		log.Error("Invalid question ID", zap.String("question_id", questionIDStr), zap.Error(err))
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid question ID format",
-		})
+		return problem(http.StatusBadRequest, "Invalid question ID format")
 	}
 
 	entityID, entityType, err := GetValuesFromToken(c)
 	if err != nil {
 		log.Error("Failed to get values from token", zap.Error(err))
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "Invalid or missing token",
-		})
+		return problem(http.StatusUnauthorized, "Invalid or missing token")
 	}
 
-	var req AnswerRequest
-	if err := c.Bind(&req); err != nil {
-		log.Error("Failed to bind request", zap.Error(err))
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request format",
-		})
-	}
-
-	if err := c.Validate(&req); err != nil {
-		log.Error("Request validation failed", zap.Error(err))
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Validation failed: " + err.Error(),
-		})
+	req := new(AnswerRequest)
+	if err := BindAndValidate(c, req); err != nil {
+		log.Info("Invalid answer request", zap.Error(err))
+		return err
 	}
 
 	ctx := c.Request().Context()
@@ -68,9 +76,7 @@ func (s *Server) newQuestionAnswer(c echo.Context) error {
 	question, err := s.service.GetQuestionWithQuestionnaire(questionID, ctx)
 	if err != nil {
 		log.Error("Failed to get question", zap.String("question_id", questionIDStr), zap.Error(err))
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "Question not found",
-		})
+		return problem(http.StatusNotFound, "Question not found")
 	}
 
 	var memberID uuid.UUID
@@ -80,9 +86,7 @@ func (s *Server) newQuestionAnswer(c echo.Context) error {
 		userID, err := uuid.Parse(entityID)
 		if err != nil {
 			log.Error("Invalid user ID from token", zap.String("entity_id", entityID), zap.Error(err))
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": "Invalid user ID",
-			})
+			return problem(http.StatusBadRequest, "Invalid user ID")
 		}
 
 		member, err := s.service.GetMemberByUserAndQuestionnaire(userID, question.Edges.Questionnaire.ID, ctx)
@@ -91,9 +95,7 @@ func (s *Server) newQuestionAnswer(c echo.Context) error {
 				zap.String("user_id", userID.String()),
 				zap.String("questionnaire_id", question.Edges.Questionnaire.ID.String()),
 				zap.Error(err))
-			return c.JSON(http.StatusForbidden, map[string]string{
-				"error": "You are not a member of this questionnaire",
-			})
+			return problem(http.StatusForbidden, "You are not a member of this questionnaire")
 		}
 		memberID = member.ID
 
@@ -101,48 +103,55 @@ func (s *Server) newQuestionAnswer(c echo.Context) error {
 		memberIDParsed, err := uuid.Parse(entityID)
 		if err != nil {
 			log.Error("Invalid member ID from token", zap.String("entity_id", entityID), zap.Error(err))
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": "Invalid member ID",
-			})
+			return problem(http.StatusBadRequest, "Invalid member ID")
 		}
 
 		member, err := s.service.GetMemberWithQuestionnaire(memberIDParsed, ctx)
 		if err != nil {
 			log.Error("Failed to get member", zap.String("member_id", entityID), zap.Error(err))
-			return c.JSON(http.StatusNotFound, map[string]string{
-				"error": "Member not found",
-			})
+			return problem(http.StatusNotFound, "Member not found")
 		}
 
 		if member.Edges.Questionnaire.ID != question.Edges.Questionnaire.ID {
 			log.Error("Member does not belong to question's questionnaire",
 				zap.String("member_questionnaire_id", member.Edges.Questionnaire.ID.String()),
 				zap.String("question_questionnaire_id", question.Edges.Questionnaire.ID.String()))
-			return c.JSON(http.StatusForbidden, map[string]string{
-				"error": "You are not authorized to answer this question",
-			})
+			return problem(http.StatusForbidden, "You are not authorized to answer this question")
 		}
 		memberID = member.ID
 
 	default:
 		log.Error("Invalid entity type", zap.String("entity_type", entityType))
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "Invalid token entity type",
-		})
+		return problem(http.StatusUnauthorized, "Invalid token entity type")
 	}
 
 	answer, err := s.service.CreateAnswer(memberID, questionID, req.AnswerValue, ctx)
+	if errors.Is(err, database.ErrQuestionHidden) {
+		log.Info("Answer to a hidden question rejected",
+			zap.String("member_id", memberID.String()),
+			zap.String("question_id", questionID.String()))
+		return problem(http.StatusConflict, "This question is hidden by your previous answers").withCode(database.ErrQuestionHidden.Code)
+	}
+	if errors.Is(err, database.ErrQuestionnaireClosed) {
+		return err
+	}
+	if errors.Is(err, database.ErrAnswersLocked) {
+		log.Info("Answer after a locked submission rejected",
+			zap.String("member_id", memberID.String()),
+			zap.String("question_id", questionID.String()))
+		return problem(http.StatusConflict, "Your answers are locked since you submitted the questionnaire").withCode(database.ErrAnswersLocked.Code)
+	}
 	if err != nil {
 		log.Error("Failed to create answer",
 			zap.String("member_id", memberID.String()),
 			zap.String("question_id", questionID.String()),
 			zap.String("answer_value", req.AnswerValue),
 			zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to create answer",
-		})
+		return problem(http.StatusInternalServerError, "Failed to create answer")
 	}
 
+	answersSubmittedTotal.Inc()
+
 	log.Info("Answer created successfully",
 		zap.String("answer_id", answer.ID.String()),
 		zap.String("member_id", memberID.String()),
@@ -158,3 +167,98 @@ func (s *Server) newQuestionAnswer(c echo.Context) error {
 		"created_at":   answer.CreatedAt,
 	})
 }
+
+// newQuestionnaireAnswers saves all the answers of a member at once
+// @Summary Answer several questions
+// @Description Save the answers of the authenticated member to several questions of a questionnaire in one transaction. Either every answer is saved or none is: a question of another questionnaire, a question answered twice or a question hidden by branching rules rejects the whole batch.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param answers body AnswersRequest true "Answers"
+// @Success 201 {object} map[string]interface{} "Answers saved successfully"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Not a member of this questionnaire"
+// @Failure 409 {object} Problem "Question hidden by the member's answers, or answers locked after submission"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/answers [post]
+func (s *Server) newQuestionnaireAnswers(c echo.Context) error {
+	qID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	req := new(AnswersRequest)
+	if err := BindAndValidate(c, req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	var memberID uuid.UUID
+
+	switch entityType {
+	case "user":
+		userID, _ := uuid.Parse(entityIDStr)
+		member, err := s.service.GetMemberByUserAndQuestionnaire(userID, qID, ctx)
+		if err != nil {
+			return problem(404, "not a member of this questionnaire")
+		}
+		memberID = member.ID
+	case "member":
+		memberID, _ = uuid.Parse(entityIDStr)
+		member, err := s.service.GetMemberWithQuestionnaire(memberID, ctx)
+		if err != nil || member.Edges.Questionnaire.ID != qID {
+			return problem(403, "forbidden")
+		}
+	default:
+		return problem(401, "invalid token type")
+	}
+
+	answers := make([]database.NewAnswer, len(req.Answers))
+	for i, a := range req.Answers {
+		answers[i] = database.NewAnswer{QuestionID: a.QuestionID, AnswerValue: a.AnswerValue}
+	}
+
+	saved, err := s.service.CreateAnswers(memberID, answers, ctx)
+	switch {
+	case errors.Is(err, database.ErrInvalidAnswers):
+		return err
+	case errors.Is(err, database.ErrQuestionHidden):
+		return err
+	case errors.Is(err, database.ErrQuestionnaireClosed):
+		return err
+	case errors.Is(err, database.ErrAnswersLocked):
+		return problem(409, "already submitted, answers are locked").withCode(database.ErrAnswersLocked.Code)
+	case err != nil:
+		GetLogger(c).Error("failed to save answers",
+			zap.String("member_id", memberID.String()),
+			zap.String("questionnaire_id", qID.String()),
+			zap.Int("answers", len(answers)),
+			zap.Error(err))
+		return problem(500, "could not save answers")
+	}
+
+	answersSubmittedTotal.Add(float64(len(saved)))
+
+	resp := make([]SavedAnswer, len(saved))
+	for i, a := range saved {
+		resp[i] = SavedAnswer{
+			AnswerID:    a.ID.String(),
+			QuestionID:  answers[i].QuestionID.String(),
+			AnswerValue: string(a.AnswerValue),
+		}
+	}
+	return c.JSON(201, map[string]interface{}{
+		"message":   "Answers saved successfully",
+		"member_id": memberID,
+		"answers":   resp,
+	})
+}
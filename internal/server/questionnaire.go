@@ -1,9 +1,13 @@
 package server
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"radgifa/ent"
+	"radgifa/ent/answer"
+	"radgifa/internal/database"
 	"strings"
 	"time"
 
@@ -15,8 +19,9 @@ import (
 )
 
 type NewQuestionnaireRequest struct {
-	Title       string `json:"title" validate:"required,min=1,max=200,no_whitespace_only" example:"Best Pizza Topping"`
-	Description string `json:"description" validate:"omitempty,max=1000" example:"Let's decide which pizza topping to order for the team lunch"`
+	Title           string `json:"title" validate:"required,min=1,max=200,no_whitespace_only" example:"Best Pizza Topping"`
+	Description     string `json:"description" validate:"omitempty,max=1000" example:"Let's decide which pizza topping to order for the team lunch"`
+	LockAfterSubmit bool   `json:"lock_after_submit" example:"false"`
 }
 
 type NewMemberRequest struct {
@@ -27,18 +32,26 @@ type NewMemberRequest struct {
 }
 
 type NewQuestionRequest struct {
-	Theme string `json:"theme" validate:"omitempty,max=255" example:"Food Preferences"`
-	Text  string `json:"text" validate:"required,min=1" example:"Do you like pepperoni pizza?"`
+	SectionID *uuid.UUID `json:"section_id,omitempty" swaggertype:"string" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Text      string     `json:"text" validate:"required,min=1" example:"Do you like pepperoni pizza?"`
+	Position  *int       `json:"position,omitempty" validate:"omitempty,min=0" example:"0"`
+	Required  bool       `json:"required" example:"false"`
+}
+
+type ReorderQuestionsRequest struct {
+	QuestionIDs []uuid.UUID `json:"question_ids" validate:"required,min=1" swaggertype:"array,string" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
 }
 
 type UpdateQuestionnaireRequest struct {
-	Title       string `json:"title" validate:"required,min=1,max=200,no_whitespace_only" example:"Updated Pizza Topping"`
-	Description string `json:"description" validate:"omitempty,max=1000" example:"Updated description for the questionnaire"`
+	Title           string `json:"title" validate:"required,min=1,max=200,no_whitespace_only" example:"Updated Pizza Topping"`
+	Description     string `json:"description" validate:"omitempty,max=1000" example:"Updated description for the questionnaire"`
+	LockAfterSubmit bool   `json:"lock_after_submit" example:"false"`
 }
 
 type UpdateQuestionRequest struct {
-	Theme string `json:"theme" validate:"omitempty,max=255" example:"Updated Food Preferences"`
-	Text  string `json:"text" validate:"required,min=1" example:"Do you still like pepperoni pizza?"`
+	SectionID *uuid.UUID `json:"section_id,omitempty" swaggertype:"string" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Text      string     `json:"text" validate:"required,min=1" example:"Do you still like pepperoni pizza?"`
+	Required  bool       `json:"required" example:"false"`
 }
 
 func (m *NewMemberRequest) Sanitize() {
@@ -57,7 +70,6 @@ func (q *NewQuestionnaireRequest) Sanitize() {
 func (nq *NewQuestionRequest) Sanitize() {
 	p := bluemonday.StrictPolicy()
 	nq.Text = strings.TrimSpace(p.Sanitize(nq.Text))
-	nq.Theme = strings.TrimSpace(p.Sanitize(nq.Theme))
 }
 
 func (uq *UpdateQuestionnaireRequest) Sanitize() {
@@ -69,7 +81,6 @@ func (uq *UpdateQuestionnaireRequest) Sanitize() {
 func (uq *UpdateQuestionRequest) Sanitize() {
 	p := bluemonday.StrictPolicy()
 	uq.Text = strings.TrimSpace(p.Sanitize(uq.Text))
-	uq.Theme = strings.TrimSpace(p.Sanitize(uq.Theme))
 }
 
 // createQuestionnaire creates a new questionnaire
@@ -80,19 +91,21 @@ func (uq *UpdateQuestionRequest) Sanitize() {
 // @Produce json
 // @Security BearerAuth
 // @Param questionnaire body NewQuestionnaireRequest true "Questionnaire data"
+// @Param Idempotency-Key header string false "Replays the first response to retries with the same key"
 // @Success 201 {object} map[string]interface{} "Questionnaire ID"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 422 {object} Problem "Idempotency-Key reused with a different request"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires [post]
 func (s *Server) createQuestionnaire(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	nq := new(NewQuestionnaireRequest)
 	if err := BindAndValidate(c, nq); err != nil {
@@ -103,15 +116,16 @@ func (s *Server) createQuestionnaire(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	questionnaire, err := s.service.CreateQuestionnaire(userID, nq.Title, nq.Description, ctx)
+	questionnaire, err := s.service.CreateQuestionnaire(userID, nq.Title, nq.Description, nq.LockAfterSubmit, ctx)
 	if err != nil {
 		log := GetLogger(c)
 		log.Error("failed to create questionnaire",
 			zap.String("user_id", userID.String()),
 			zap.String("title", nq.Title),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not create questionnaire"})
+		return problem(500, "could not create questionnaire")
 	}
+	questionnairesCreatedTotal.Inc()
 	return c.JSON(201, questionnaire.ID)
 }
 
@@ -123,18 +137,20 @@ func (s *Server) createQuestionnaire(c echo.Context) error {
 // @Produce json
 // @Param token path string true "Invitation token"
 // @Param member body NewMemberRequest true "Member data"
+// @Param Idempotency-Key header string false "Replays the first response to retries with the same key"
 // @Success 201 {object} map[string]interface{} "Member created successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Invalid token"
-// @Failure 409 {object} map[string]string "Identifier already taken"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /join/{token} [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Invalid token"
+// @Failure 409 {object} Problem "Identifier already taken"
+// @Failure 422 {object} Problem "Idempotency-Key reused with a different request"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/join/{token} [post]
 func (s *Server) createQuestionnaireMember(c echo.Context) error {
 	var userID uuid.UUID
 	if authHeader := c.Request().Header.Get("Authorization"); authHeader != "" {
 		if strings.HasPrefix(authHeader, "Bearer ") {
 			tokenString := authHeader[7:]
-			if claims, err := validateJWTToken(tokenString); err == nil {
+			if claims, err := s.validateJWTToken(c.Request().Context(), tokenString); err == nil {
 				if claims["type"] == "user" {
 					if entityIDStr, ok := claims["entity_id"].(string); ok {
 						userID, _ = uuid.Parse(entityIDStr)
@@ -152,11 +168,11 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 	token := c.Param("token")
 	val, err := s.kvmanager.Get([]byte(token))
 	if err != nil || val == nil {
-		return c.JSON(400, map[string]string{"error": "invalid or expired token"})
+		return problem(400, "invalid or expired token")
 	}
 	questionnaireID, err := uuid.Parse(string(val))
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid or expired token"})
+		return problem(400, "invalid or expired token")
 	}
 
 	ctx := c.Request().Context()
@@ -180,11 +196,10 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 
 	if memberReq.Action == "login" {
 		if memberReq.Passcode == "" {
-			return c.JSON(400, map[string]interface{}{
-				"error": "validation failed",
-				"details": map[string]string{
-					"Passcode": "Passcode is required for login",
-				},
+			return validationProblem(400, CodeValidationFailed, FieldError{
+				Field:   "passcode",
+				Code:    "required",
+				Message: "passcode is required for login",
 			})
 		}
 
@@ -194,7 +209,8 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 				zap.String("unique_identifier", memberReq.UniqueIdentifier),
 				zap.String("questionnaire_id", questionnaireID.String()),
 				zap.Error(err))
-			return c.JSON(401, map[string]string{"error": "invalid credentials"})
+			loginFailuresTotal.WithLabelValues("member").Inc()
+			return problem(401, "invalid credentials").withCode(CodeInvalidCredentials)
 		}
 
 		if member.Edges.Questionnaire == nil {
@@ -203,7 +219,7 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 				log.Error("failed to load member questionnaire",
 					zap.String("member_id", member.ID.String()),
 					zap.Error(err))
-				return c.JSON(500, map[string]string{"error": "internal server error"})
+				return problem(500, "internal server error")
 			}
 		}
 
@@ -217,9 +233,9 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 		}
 		jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-		t, err := jwtToken.SignedString(jwtSecret)
+		t, err := jwtToken.SignedString(s.signingKey())
 		if err != nil {
-			return c.JSON(500, map[string]string{"error": "could not generate token"})
+			return problem(500, "could not generate token")
 		}
 
 		return c.JSON(200, map[string]interface{}{
@@ -236,14 +252,13 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 				zap.String("unique_identifier", memberReq.UniqueIdentifier),
 				zap.String("questionnaire_id", questionnaireID.String()),
 				zap.Error(err))
-			return c.JSON(500, map[string]string{"error": "could not validate identifier"})
+			return problem(500, "could not validate identifier")
 		}
 		if !isAvailable {
-			return c.JSON(409, map[string]interface{}{
-				"error": "validation failed",
-				"details": map[string]string{
-					"UniqueIdentifier": "Identifier is already taken in this questionnaire",
-				},
+			return validationProblem(409, CodeAlreadyTaken, FieldError{
+				Field:   "unique_identifier",
+				Code:    "taken",
+				Message: "identifier is already taken in this questionnaire",
 			})
 		}
 
@@ -252,14 +267,18 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 		if userID != uuid.Nil {
 			member, err = s.service.CreateMember(userID, questionnaireID, memberReq.UniqueIdentifier, memberReq.DisplayName, ctx)
 			if err != nil {
+				if errors.Is(err, database.ErrQuestionnaireClosed) {
+					return err
+				}
 				log.Error("failed to create authenticated member",
 					zap.String("user_id", userID.String()),
 					zap.String("questionnaire_id", questionnaireID.String()),
 					zap.String("unique_identifier", memberReq.UniqueIdentifier),
 					zap.String("display_name", memberReq.DisplayName),
 					zap.Error(err))
-				return c.JSON(500, map[string]string{"error": "could not create member"})
+				return problem(500, "could not create member")
 			}
+			membersJoinedTotal.WithLabelValues("user").Inc()
 
 			// Generate JWT for authenticated member
 			claims := &JWTClaims{
@@ -272,9 +291,9 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 			}
 			jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-			t, err := jwtToken.SignedString(jwtSecret)
+			t, err := jwtToken.SignedString(s.signingKey())
 			if err != nil {
-				return c.JSON(500, map[string]string{"error": "could not generate token"})
+				return problem(500, "could not generate token")
 			}
 
 			return c.JSON(201, map[string]interface{}{
@@ -285,13 +304,17 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 		} else {
 			member, passcode, err = s.service.CreateAnonymousMember(questionnaireID, memberReq.UniqueIdentifier, memberReq.DisplayName, ctx)
 			if err != nil {
+				if errors.Is(err, database.ErrQuestionnaireClosed) {
+					return err
+				}
 				log.Error("failed to create anonymous member",
 					zap.String("questionnaire_id", questionnaireID.String()),
 					zap.String("unique_identifier", memberReq.UniqueIdentifier),
 					zap.String("display_name", memberReq.DisplayName),
 					zap.Error(err))
-				return c.JSON(500, map[string]string{"error": "could not create anonymous member"})
+				return problem(500, "could not create anonymous member")
 			}
+			membersJoinedTotal.WithLabelValues("anonymous").Inc()
 
 			// Generate JWT for anonymous member
 			claims := &JWTClaims{
@@ -304,9 +327,9 @@ func (s *Server) createQuestionnaireMember(c echo.Context) error {
 			}
 			jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-			t, err := jwtToken.SignedString(jwtSecret)
+			t, err := jwtToken.SignedString(s.signingKey())
 			if err != nil {
-				return c.JSON(500, map[string]string{"error": "could not generate token"})
+				return problem(500, "could not generate token")
 			}
 
 			return c.JSON(201, map[string]interface{}{
@@ -337,11 +360,11 @@ func generateInvitationToken() (string, error) {
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
 // @Success 200 {object} map[string]interface{} "Invitation token and URL"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can generate invitations"
-// @Failure 404 {object} map[string]string "Questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can generate invitations"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
 // generateQuestionnaireInvitation generates an invitation token for a questionnaire
 // @Summary Generate questionnaire invitation
 // @Description Generate an invitation token to allow others to join the questionnaire
@@ -350,28 +373,28 @@ func generateInvitationToken() (string, error) {
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
 // @Success 200 {object} map[string]interface{} "Invitation token and URL"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can generate invitations"
-// @Failure 404 {object} map[string]string "Questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires/{id}/invite [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can generate invitations"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/invite [post]
 func (s *Server) generateQuestionnaireInvitation(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	questionnaireID := c.Param("id")
 
 	qID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	ctx := c.Request().Context()
@@ -382,24 +405,24 @@ func (s *Server) generateQuestionnaireInvitation(c echo.Context) error {
 			zap.String("questionnaire_id", qID.String()),
 			zap.String("user_id", userID.String()),
 			zap.Error(err))
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
 	u, err := questionnaire.QueryOwner().Only(ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not verify questionnaire owner"})
+		return problem(500, "could not verify questionnaire owner")
 	}
 	if u.ID.String() != userID.String() {
-		return c.JSON(403, map[string]string{"error": "not authorized to invite to this questionnaire"})
+		return problem(403, "not authorized to invite to this questionnaire")
 	}
 
 	token, err := generateInvitationToken()
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not generate invitation token"})
+		return problem(500, "could not generate invitation token")
 	}
 
 	if err := s.kvmanager.InsertWithTTL([]byte(token), []byte(questionnaireID), 86400); err != nil {
-		return c.JSON(500, map[string]string{"error": "could not save invitation token"})
+		return problem(500, "could not save invitation token")
 	}
 
 	joinURL := c.Echo().Reverse("join-questionnaire", token)
@@ -420,10 +443,10 @@ func (s *Server) generateQuestionnaireInvitation(c echo.Context) error {
 // @Param token path string true "Questionnaire invitation token"
 // @Param availability body CheckAvailabilityRequest true "Identifier to check"
 // @Success 200 {object} map[string]interface{} "Identifier availability status"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Invalid token"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /check/member/{token} [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Invalid token"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/check/member/{token} [post]
 func (s *Server) checkMemberIdentifierAvailability(c echo.Context) error {
 	req := new(CheckAvailabilityRequest)
 	if err := BindAndValidate(c, req); err != nil {
@@ -433,11 +456,11 @@ func (s *Server) checkMemberIdentifierAvailability(c echo.Context) error {
 	token := c.Param("token")
 	val, err := s.kvmanager.Get([]byte(token))
 	if err != nil || val == nil {
-		return c.JSON(400, map[string]string{"error": "invalid or expired token"})
+		return problem(400, "invalid or expired token")
 	}
 	questionnaireID, err := uuid.Parse(string(val))
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid or expired token"})
+		return problem(400, "invalid or expired token")
 	}
 
 	ctx := c.Request().Context()
@@ -448,7 +471,7 @@ func (s *Server) checkMemberIdentifierAvailability(c echo.Context) error {
 			zap.String("unique_identifier", req.Value),
 			zap.String("questionnaire_id", questionnaireID.String()),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not check identifier availability"})
+		return problem(500, "could not check identifier availability")
 	}
 
 	return c.JSON(200, map[string]interface{}{
@@ -463,61 +486,58 @@ func (s *Server) checkMemberIdentifierAvailability(c echo.Context) error {
 // @Tags questionnaires
 // @Produce json
 // @Param token path string true "Questionnaire invitation token"
-// @Success 200 {object} map[string]interface{} "Questionnaire information"
-// @Failure 400 {object} map[string]string "Invalid or expired token"
-// @Failure 404 {object} map[string]string "Questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /join/{token}/info [get]
+// @Success 200 {object} PublicQuestionnaireResponse "Questionnaire information"
+// @Failure 400 {object} Problem "Invalid or expired token"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/join/{token}/info [get]
 func (s *Server) getQuestionnaireInfoFromToken(c echo.Context) error {
 	token := c.Param("token")
 	val, err := s.kvmanager.Get([]byte(token))
 	if err != nil || val == nil {
-		return c.JSON(400, map[string]string{"error": "invalid or expired token"})
+		return problem(400, "invalid or expired token")
 	}
 
 	questionnaireID, err := uuid.Parse(string(val))
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid or expired token"})
+		return problem(400, "invalid or expired token")
 	}
 
 	ctx := c.Request().Context()
 	questionnaire, err := s.service.GetQuestionnaire(questionnaireID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
-	return c.JSON(200, map[string]interface{}{
-		"questionnaire_id": questionnaire.ID,
-		"title":            questionnaire.Title,
-		"description":      questionnaire.Description,
-		"is_published":     questionnaire.IsPublished,
-	})
+	return c.JSON(200, newPublicQuestionnaireResponse(questionnaire))
 }
 
 // createNewQuestion creates a new question in a questionnaire
 // @Summary Create new question
-// @Description Create a new question in a specific questionnaire (only owner can do this)
+// @Description Create a new question in a specific questionnaire (only owner can do this), optionally in one of its sections. The question is appended unless a zero based position is given, in which case the following questions move down.
 // @Tags questionnaires
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
 // @Param question body NewQuestionRequest true "Question data"
+// @Param Idempotency-Key header string false "Replays the first response to retries with the same key"
 // @Success 201 {object} map[string]interface{} "Question created successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can create questions"
-// @Failure 404 {object} map[string]string "Questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires/{id}/question [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can create questions"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 422 {object} Problem "Idempotency-Key reused with a different request"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/question [post]
 func (s *Server) createNewQuestion(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	_, err = uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	questionnaireID := c.Param("id")
 	nq := new(NewQuestionRequest)
@@ -526,63 +546,147 @@ func (s *Server) createNewQuestion(c echo.Context) error {
 	}
 
 	logger := GetLogger(c)
-	logger.Info("Creating new question", zap.String("questionnaire_id", questionnaireID), zap.String("text", nq.Text), zap.String("theme", nq.Theme))
+	logger.Info("Creating new question", zap.String("questionnaire_id", questionnaireID), zap.String("text", nq.Text))
 	nq.Sanitize()
 
 	ctx := c.Request().Context()
 	questionnaireUUID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 	u, err := q.QueryOwner().Only(ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not verify questionnaire owner"})
+		return problem(500, "could not verify questionnaire owner")
 	}
 	if u.ID.String() != entityIDStr {
-		return c.JSON(403, map[string]string{"error": "not authorized to add questions to this questionnaire"})
+		return problem(403, "not authorized to add questions to this questionnaire")
+	}
+
+	var question *ent.Question
+	if nq.Position != nil {
+		question, err = s.service.InsertQuestion(questionnaireUUID, nq.Text, nq.SectionID, nq.Required, *nq.Position, ctx)
+	} else {
+		question, err = s.service.CreateNewQuestion(questionnaireUUID, nq.Text, nq.SectionID, nq.Required, ctx)
+	}
+	if errors.Is(err, database.ErrSectionNotInQuestionnaire) {
+		return err
+	}
+	if err != nil {
+		return problem(500, "could not create question")
+	}
+	return c.JSON(201, map[string]any{"question_id": question.ID, "position": question.Position})
+}
+
+// reorderQuestions rewrites the order of the questions of a questionnaire
+// @Summary Reorder questions
+// @Description Set the order of the questions of a questionnaire in one step (only owner and only if not published). question_ids must list every question of the questionnaire exactly once.
+// @Tags questionnaires
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param order body ReorderQuestionsRequest true "Question IDs in their new order"
+// @Success 200 {array} QuestionResponse "Questions in their new order"
+// @Failure 400 {object} Problem "Bad request or incomplete order"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can reorder or questionnaire is published"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/questions/order [put]
+func (s *Server) reorderQuestions(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	rq := new(ReorderQuestionsRequest)
+	if err := BindAndValidate(c, rq); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
 	}
 
-	question, err := s.service.CreateNewQuestion(questionnaireUUID, nq.Text, nq.Theme, ctx)
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to reorder questions in this questionnaire")
+	}
+
+	if q.IsPublished {
+		return problem(403, "cannot reorder questions in published questionnaire").withCode(CodeQuestionnairePublished)
+	}
+
+	questions, err := s.service.ReorderQuestions(questionnaireUUID, rq.QuestionIDs, ctx)
+	if errors.Is(err, database.ErrInvalidQuestionOrder) {
+		return err
+	}
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not create question"})
+		GetLogger(c).Error("Could not reorder questions", zap.String("questionnaire_id", questionnaireUUID.String()), zap.Error(err))
+		return problem(500, "could not reorder questions")
 	}
-	return c.JSON(201, map[string]any{"question_id": question.ID})
+
+	return c.JSON(200, mapAll(questions, newQuestionResponse))
 }
 
 // updateQuestionnaire updates an existing questionnaire (only if not published)
 // @Summary Update questionnaire
-// @Description Update a questionnaire's title and description (only owner and only if not published)
+// @Description Update a questionnaire's title and description (only owner and only if not published). With If-Match, the update only applies if the questionnaire was not modified since the tag was read.
 // @Tags questionnaires
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
+// @Param If-Match header string false "ETag of the questionnaire as read"
 // @Param questionnaire body UpdateQuestionnaireRequest true "Updated questionnaire data"
 // @Success 200 {object} map[string]interface{} "Questionnaire updated successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can update or questionnaire is published"
-// @Failure 404 {object} map[string]string "Questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires/{id} [put]
+// @Header 200 {string} ETag "Tag of the updated questionnaire"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can update or questionnaire is published"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 412 {object} Problem "Questionnaire modified since the tag was read"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id} [put]
 func (s *Server) updateQuestionnaire(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	questionnaireID := c.Param("id")
 	questionnaireUUID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	ifVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return problem(412, "If-Match does not match the questionnaire")
 	}
 
 	uq := new(UpdateQuestionnaireRequest)
@@ -596,28 +700,32 @@ func (s *Server) updateQuestionnaire(c echo.Context) error {
 
 	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
 	u, err := q.QueryOwner().Only(ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not verify questionnaire owner"})
+		return problem(500, "could not verify questionnaire owner")
 	}
 
 	if u.ID != userID {
-		return c.JSON(403, map[string]string{"error": "not authorized to update this questionnaire"})
+		return problem(403, "not authorized to update this questionnaire")
 	}
 
 	if q.IsPublished {
-		return c.JSON(403, map[string]string{"error": "cannot update published questionnaire"})
+		return problem(403, "cannot update published questionnaire").withCode(CodeQuestionnairePublished)
 	}
 
-	updatedQuestionnaire, err := s.service.UpdateQuestionnaire(questionnaireUUID, uq.Title, uq.Description, ctx)
+	updatedQuestionnaire, err := s.service.UpdateQuestionnaire(questionnaireUUID, uq.Title, uq.Description, uq.LockAfterSubmit, ifVersion, ctx)
+	if errors.Is(err, database.ErrVersionMismatch) {
+		return problem(412, "questionnaire was modified since it was read").withCode(database.ErrVersionMismatch.Code)
+	}
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not update questionnaire"})
+		return problem(500, "could not update questionnaire")
 	}
 
-	return c.JSON(200, updatedQuestionnaire)
+	c.Response().Header().Set("ETag", versionTag(updatedQuestionnaire.Version))
+	return c.JSON(200, newQuestionnaireResponse(updatedQuestionnaire))
 }
 
 // publishQuestionnaire publishes a questionnaire (makes it immutable)
@@ -629,47 +737,47 @@ func (s *Server) updateQuestionnaire(c echo.Context) error {
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
 // @Success 200 {object} map[string]interface{} "Questionnaire published successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can publish"
-// @Failure 404 {object} map[string]string "Questionnaire not found"
-// @Failure 409 {object} map[string]string "Questionnaire already published"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires/{id}/publish [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can publish"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 409 {object} Problem "Questionnaire already published"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/publish [post]
 func (s *Server) publishQuestionnaire(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	questionnaireID := c.Param("id")
 	questionnaireUUID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	ctx := c.Request().Context()
 
 	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
 	u, err := q.QueryOwner().Only(ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not verify questionnaire owner"})
+		return problem(500, "could not verify questionnaire owner")
 	}
 
 	if u.ID != userID {
-		return c.JSON(403, map[string]string{"error": "not authorized to publish this questionnaire"})
+		return problem(403, "not authorized to publish this questionnaire")
 	}
 
 	if q.IsPublished {
-		return c.JSON(409, map[string]string{"error": "questionnaire already published"})
+		return problem(409, "questionnaire already published").withCode(CodeQuestionnairePublished)
 	}
 
 	updatedQuestionnaire, err := s.service.PublishQuestionnaire(questionnaireUUID, userID, ctx)
@@ -679,10 +787,67 @@ func (s *Server) publishQuestionnaire(c echo.Context) error {
 			zap.String("questionnaire_id", questionnaireUUID.String()),
 			zap.String("user_id", userID.String()),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not publish questionnaire"})
+		return problem(500, "could not publish questionnaire")
 	}
 
-	return c.JSON(200, updatedQuestionnaire)
+	return c.JSON(200, newQuestionnaireResponse(updatedQuestionnaire))
+}
+
+// closeQuestionnaire closes a published questionnaire
+// @Summary Close questionnaire
+// @Description Close a published questionnaire: it takes no more members, answers or submissions, which then
+// @Description fail with the code questionnaire_closed. The answers given so far stay readable. Closing cannot be undone.
+// @Tags questionnaires
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Success 200 {object} QuestionnaireResponse "Questionnaire closed"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can close"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 409 {object} Problem "Questionnaire not published or already closed"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/close [post]
+func (s *Server) closeQuestionnaire(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	ctx := c.Request().Context()
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+	if q.Edges.Owner.ID != userID {
+		return problem(403, "not authorized to close this questionnaire")
+	}
+	if !q.IsPublished {
+		return problem(409, "only a published questionnaire can be closed").withCode(CodeConflict)
+	}
+
+	closed, err := s.service.CloseQuestionnaire(questionnaireUUID, ctx)
+	if errors.Is(err, database.ErrQuestionnaireClosed) {
+		return err
+	}
+	if err != nil {
+		GetLogger(c).Error("failed to close questionnaire",
+			zap.String("questionnaire_id", questionnaireUUID.String()),
+			zap.Error(err))
+		return problem(500, "could not close questionnaire")
+	}
+
+	return c.JSON(200, newQuestionnaireResponse(closed))
 }
 
 // deleteQuestionnaire deletes a questionnaire and all related data (only if not published)
@@ -694,51 +859,51 @@ func (s *Server) publishQuestionnaire(c echo.Context) error {
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
 // @Success 200 {object} map[string]string "Questionnaire deleted successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can delete or questionnaire is published"
-// @Failure 404 {object} map[string]string "Questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires/{id} [delete]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can delete or questionnaire is published"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id} [delete]
 func (s *Server) deleteQuestionnaire(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	questionnaireID := c.Param("id")
 	questionnaireUUID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	ctx := c.Request().Context()
 
 	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
 	u, err := q.QueryOwner().Only(ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not verify questionnaire owner"})
+		return problem(500, "could not verify questionnaire owner")
 	}
 
 	if u.ID != userID {
-		return c.JSON(403, map[string]string{"error": "not authorized to delete this questionnaire"})
+		return problem(403, "not authorized to delete this questionnaire")
 	}
 
 	if q.IsPublished {
-		return c.JSON(403, map[string]string{"error": "cannot delete published questionnaire"})
+		return problem(403, "cannot delete published questionnaire").withCode(CodeQuestionnairePublished)
 	}
 
 	err = s.service.DeleteQuestionnaire(questionnaireUUID, ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not delete questionnaire"})
+		return problem(500, "could not delete questionnaire")
 	}
 
 	return c.JSON(200, map[string]string{"message": "questionnaire deleted successfully"})
@@ -746,29 +911,32 @@ func (s *Server) deleteQuestionnaire(c echo.Context) error {
 
 // updateQuestion updates an existing question (only if questionnaire not published)
 // @Summary Update question
-// @Description Update a question's text and theme (only owner and only if questionnaire not published)
+// @Description Update a question's text and section (only owner and only if questionnaire not published). Without section_id the question moves out of its section. With If-Match, the update only applies if the question was not modified since its version was read.
 // @Tags questionnaires
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param questionnaireId path string true "Questionnaire ID"
 // @Param questionId path string true "Question ID"
+// @Param If-Match header string false "Version of the question as read, quoted"
 // @Param question body UpdateQuestionRequest true "Updated question data"
 // @Success 200 {object} map[string]interface{} "Question updated successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can update or questionnaire is published"
-// @Failure 404 {object} map[string]string "Question or questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires/{questionnaireId}/questions/{questionId} [put]
+// @Header 200 {string} ETag "Tag of the updated question"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can update or questionnaire is published"
+// @Failure 404 {object} Problem "Question or questionnaire not found"
+// @Failure 412 {object} Problem "Question modified since its version was read"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{questionnaireId}/questions/{questionId} [put]
 func (s *Server) updateQuestion(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	questionnaireID := c.Param("questionnaireId")
@@ -776,12 +944,17 @@ func (s *Server) updateQuestion(c echo.Context) error {
 
 	questionnaireUUID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	questionUUID, err := uuid.Parse(questionID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid question ID"})
+		return problem(400, "invalid question ID")
+	}
+
+	ifVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return problem(412, "If-Match does not match the question")
 	}
 
 	uq := new(UpdateQuestionRequest)
@@ -795,37 +968,44 @@ func (s *Server) updateQuestion(c echo.Context) error {
 
 	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
 	u, err := q.QueryOwner().Only(ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not verify questionnaire owner"})
+		return problem(500, "could not verify questionnaire owner")
 	}
 
 	if u.ID != userID {
-		return c.JSON(403, map[string]string{"error": "not authorized to update questions in this questionnaire"})
+		return problem(403, "not authorized to update questions in this questionnaire")
 	}
 
 	if q.IsPublished {
-		return c.JSON(403, map[string]string{"error": "cannot update questions in published questionnaire"})
+		return problem(403, "cannot update questions in published questionnaire").withCode(CodeQuestionnairePublished)
 	}
 
 	question, err := s.service.GetQuestionWithQuestionnaire(questionUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "question not found"})
+		return problem(404, "question not found")
 	}
 
 	if question.Edges.Questionnaire.ID != questionnaireUUID {
-		return c.JSON(400, map[string]string{"error": "question does not belong to specified questionnaire"})
+		return problem(400, "question does not belong to specified questionnaire")
 	}
 
-	updatedQuestion, err := s.service.UpdateQuestion(questionUUID, uq.Text, uq.Theme, ctx)
+	updatedQuestion, err := s.service.UpdateQuestion(questionUUID, uq.Text, uq.SectionID, uq.Required, ifVersion, ctx)
+	if errors.Is(err, database.ErrSectionNotInQuestionnaire) {
+		return err
+	}
+	if errors.Is(err, database.ErrVersionMismatch) {
+		return problem(412, "question was modified since it was read").withCode(database.ErrVersionMismatch.Code)
+	}
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not update question"})
+		return problem(500, "could not update question")
 	}
 
-	return c.JSON(200, updatedQuestion)
+	c.Response().Header().Set("ETag", versionTag(updatedQuestion.Version))
+	return c.JSON(200, newQuestionResponse(updatedQuestion))
 }
 
 // deleteQuestion deletes a question (only if questionnaire not published)
@@ -838,20 +1018,20 @@ func (s *Server) updateQuestion(c echo.Context) error {
 // @Param questionnaireId path string true "Questionnaire ID"
 // @Param questionId path string true "Question ID"
 // @Success 200 {object} map[string]string "Question deleted successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden - only owner can delete or questionnaire is published"
-// @Failure 404 {object} map[string]string "Question or questionnaire not found"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires/{questionnaireId}/questions/{questionId} [delete]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can delete or questionnaire is published"
+// @Failure 404 {object} Problem "Question or questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{questionnaireId}/questions/{questionId} [delete]
 func (s *Server) deleteQuestion(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	questionnaireID := c.Param("questionnaireId")
@@ -859,262 +1039,365 @@ func (s *Server) deleteQuestion(c echo.Context) error {
 
 	questionnaireUUID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	questionUUID, err := uuid.Parse(questionID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid question ID"})
+		return problem(400, "invalid question ID")
 	}
 
 	ctx := c.Request().Context()
 
 	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
 	u, err := q.QueryOwner().Only(ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not verify questionnaire owner"})
+		return problem(500, "could not verify questionnaire owner")
 	}
 
 	if u.ID != userID {
-		return c.JSON(403, map[string]string{"error": "not authorized to delete questions in this questionnaire"})
+		return problem(403, "not authorized to delete questions in this questionnaire")
 	}
 
 	if q.IsPublished {
-		return c.JSON(403, map[string]string{"error": "cannot delete questions in published questionnaire"})
+		return problem(403, "cannot delete questions in published questionnaire").withCode(CodeQuestionnairePublished)
 	}
 
 	question, err := s.service.GetQuestionWithQuestionnaire(questionUUID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "question not found"})
+		return problem(404, "question not found")
 	}
 
 	if question.Edges.Questionnaire.ID != questionnaireUUID {
-		return c.JSON(400, map[string]string{"error": "question does not belong to specified questionnaire"})
+		return problem(400, "question does not belong to specified questionnaire")
 	}
 
 	err = s.service.DeleteQuestion(questionUUID, ctx)
 	if err != nil {
-		return c.JSON(500, map[string]string{"error": "could not delete question"})
+		return problem(500, "could not delete question")
 	}
 
 	return c.JSON(200, map[string]string{"message": "question deleted successfully"})
 }
 
-// getUserQuestionnaires returns all questionnaires owned by the authenticated user
+// getUserQuestionnaires returns a page of the questionnaires owned by the authenticated user
 // @Summary Get user questionnaires
-// @Description Get all questionnaires owned by the authenticated user
+// @Description Get the questionnaires owned by the authenticated user, newest first by default
 // @Tags questionnaires
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {array} object "List of questionnaires"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /api/questionnaires [get]
+// @Param limit query int false "Page size, 1 to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param sort query string false "Sort field" Enums(created_at, title) default(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Param published query bool false "Only published or unpublished questionnaires"
+// @Param q query string false "Case insensitive search in the title"
+// @Param created_after query string false "RFC 3339 timestamp or Unix milliseconds, inclusive"
+// @Param created_before query string false "RFC 3339 timestamp or Unix milliseconds, exclusive"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} PageResponse[QuestionnaireResponse] "Page of questionnaires"
+// @Success 304 "Not modified since the If-None-Match tag"
+// @Failure 400 {object} Problem "Invalid query parameters"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires [get]
 func (s *Server) getUserQuestionnaires(c echo.Context) error {
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	pageReq, err := parsePageRequest(c)
+	if err != nil {
+		return err
+	}
+	filter := database.QuestionnaireFilter{Title: c.QueryParam("q")}
+	if filter.Published, err = parseBoolParam(c, "published"); err != nil {
+		return err
+	}
+	if filter.Created, err = parseCreatedRange(c); err != nil {
+		return err
 	}
 
 	ctx := c.Request().Context()
-	questionnaires, err := s.service.GetUserQuestionnaires(userID, ctx)
+	page, err := s.service.ListUserQuestionnaires(userID, filter, pageReq, ctx)
+	if isPageRequestError(err) {
+		return err
+	}
 	if err != nil {
 		log := GetLogger(c)
 		log.Error("failed to get user questionnaires",
 			zap.String("user_id", userID.String()),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not get questionnaires"})
+		return problem(500, "could not get questionnaires")
+	}
+
+	return conditionalJSON(c, 0, mapPageResponse(page, newQuestionnaireResponse))
+}
+
+// viewer is the role of the caller in a questionnaire, which decides the view
+// of it they get
+type viewer struct {
+	owner    bool
+	memberID uuid.UUID
+}
+
+// viewerOf finds whether the entity of the token owns q or is one of its
+// members, and fails with 403 when it is neither. An owner who also joined
+// their questionnaire sees it as its owner.
+func (s *Server) viewerOf(entityID, entityType string, q *ent.Questionnaire, ctx context.Context) (viewer, error) {
+	id, err := uuid.Parse(entityID)
+	if err != nil {
+		return viewer{}, problem(401, "unauthorized, invalid token")
 	}
 
-	return c.JSON(200, questionnaires)
+	switch entityType {
+	case "user":
+		if q.Edges.Owner != nil && q.Edges.Owner.ID == id {
+			return viewer{owner: true}, nil
+		}
+		if member, err := s.service.GetMemberByUserAndQuestionnaire(id, q.ID, ctx); err == nil {
+			return viewer{memberID: member.ID}, nil
+		}
+	case "member":
+		member, err := s.service.GetMemberWithQuestionnaire(id, ctx)
+		if err == nil && member.Edges.Questionnaire.ID == q.ID {
+			return viewer{memberID: member.ID}, nil
+		}
+	}
+	return viewer{}, problem(403, "forbidden")
 }
 
 // getQuestionnaireDetails returns questionnaire details if user is owner or member
 // @Summary Get questionnaire details
-// @Description Get detailed information about a questionnaire including questions and answers (if owner) or just questions (if member)
+// @Description Get detailed information about a questionnaire. The owner gets every member and answer. A member gets a MemberQuestionnaireResponse instead, with their own membership and answers only.
 // @Tags questionnaires
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
-// @Success 200 {object} object "Questionnaire details"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not found"
-// @Router /api/questionnaires/{id} [get]
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} QuestionnaireResponse "Questionnaire details, as seen by the owner"
+// @Header 200 {string} ETag "Tag of the details, usable with If-Match to update the questionnaire"
+// @Success 304 "Not modified since the If-None-Match tag"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Not found"
+// @Router /api/v1/questionnaires/{id} [get]
 func (s *Server) getQuestionnaireDetails(c echo.Context) error {
 	questionnaireID := c.Param("id")
 	qID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	ctx := c.Request().Context()
 	questionnaire, err := s.service.GetQuestionnaireWithDetails(qID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
-	// Check permissions: only owner or members can see details
-	userID, _ := uuid.Parse(entityIDStr)
-	isOwner := entityType == "user" && questionnaire.Edges.Owner.ID == userID
-	isMember := false
-
-	switch entityType {
-	case "user":
-		// Check if user is a member
-		_, err := s.service.GetMemberByUserAndQuestionnaire(userID, qID, ctx)
-		isMember = err == nil
-	case "member":
-		// Check if this member belongs to this questionnaire
-		member, err := s.service.GetMemberWithQuestionnaire(userID, ctx)
-		isMember = err == nil && member.Edges.Questionnaire.ID == qID
+	v, err := s.viewerOf(entityIDStr, entityType, questionnaire, ctx)
+	if err != nil {
+		return err
 	}
-
-	if !isOwner && !isMember {
-		return c.JSON(403, map[string]string{"error": "forbidden"})
+	if v.owner {
+		return conditionalJSON(c, questionnaire.Version, newQuestionnaireResponse(questionnaire))
 	}
-
-	return c.JSON(200, questionnaire)
+	return conditionalJSON(c, questionnaire.Version, newMemberQuestionnaireResponse(questionnaire, v.memberID))
 }
 
-// getQuestionnaireQuestions returns questions for a questionnaire if user has access
+// getQuestionnaireQuestions returns a page of questions for a questionnaire if user has access
 // @Summary Get questionnaire questions
-// @Description Get all questions for a specific questionnaire
+// @Description Get the questions of a specific questionnaire with their answers. The owner gets every answer. Members get MemberQuestionResponse items, with their own answer only.
 // @Tags questionnaires
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
-// @Success 200 {array} object "List of questions"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not found"
-// @Router /api/questionnaires/{id}/questions [get]
+// @Param limit query int false "Page size, 1 to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param sort query string false "Sort field" Enums(position, created_at) default(position)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param q query string false "Case insensitive search in the question text"
+// @Param created_after query string false "RFC 3339 timestamp or Unix milliseconds, inclusive"
+// @Param created_before query string false "RFC 3339 timestamp or Unix milliseconds, exclusive"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} PageResponse[QuestionResponse] "Page of questions"
+// @Success 304 "Not modified since the If-None-Match tag"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Not found"
+// @Router /api/v1/questionnaires/{id}/questions [get]
 func (s *Server) getQuestionnaireQuestions(c echo.Context) error {
 	questionnaireID := c.Param("id")
 	qID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	ctx := c.Request().Context()
 	questionnaire, err := s.service.GetQuestionnaireWithDetails(qID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
-	userID, _ := uuid.Parse(entityIDStr)
-	isOwner := entityType == "user" && questionnaire.Edges.Owner.ID == userID
-	isMember := false
-
-	switch entityType {
-	case "user":
-		_, err := s.service.GetMemberByUserAndQuestionnaire(userID, qID, ctx)
-		isMember = err == nil
-	case "member":
-		member, err := s.service.GetMemberWithQuestionnaire(userID, ctx)
-		isMember = err == nil && member.Edges.Questionnaire.ID == qID
+	v, err := s.viewerOf(entityIDStr, entityType, questionnaire, ctx)
+	if err != nil {
+		return err
 	}
 
-	if !isOwner && !isMember {
-		return c.JSON(403, map[string]string{"error": "forbidden"})
+	pageReq, err := parsePageRequest(c)
+	if err != nil {
+		return err
+	}
+	filter := database.QuestionFilter{Text: c.QueryParam("q")}
+	if filter.Created, err = parseCreatedRange(c); err != nil {
+		return err
 	}
 
-	questions, err := s.service.GetQuestionnaireQuestionsWithAnswers(qID, ctx)
+	page, err := s.service.ListQuestionnaireQuestions(qID, filter, pageReq, ctx)
+	if isPageRequestError(err) {
+		return err
+	}
 	if err != nil {
 		log := GetLogger(c)
 		log.Error("failed to get questionnaire questions",
 			zap.String("questionnaire_id", qID.String()),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not get questions"})
+		return problem(500, "could not get questions")
 	}
 
-	return c.JSON(200, questions)
+	if v.owner {
+		return conditionalJSON(c, 0, mapPageResponse(page, newQuestionResponse))
+	}
+	return conditionalJSON(c, 0, mapPageResponse(page, memberQuestionResponse(v.memberID)))
 }
 
-// getQuestionnaireMembers returns members for a questionnaire if user is owner
+// getQuestionnaireMembers returns a page of members for a questionnaire if user is owner
+// @Summary Get questionnaire members
+// @Description Get the members of a questionnaire. Only the owner can list them.
+// @Tags questionnaires
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param limit query int false "Page size, 1 to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param sort query string false "Sort field" Enums(created_at, display_name) default(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param answered query bool false "Only members who answered at least one question, or none"
+// @Param q query string false "Case insensitive search in the display name"
+// @Param created_after query string false "RFC 3339 timestamp or Unix milliseconds, inclusive"
+// @Param created_before query string false "RFC 3339 timestamp or Unix milliseconds, exclusive"
+// @Success 200 {object} PageResponse[MemberResponse] "Page of members"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Not found"
+// @Router /api/v1/questionnaires/{id}/members [get]
 func (s *Server) getQuestionnaireMembers(c echo.Context) error {
 	questionnaireID := c.Param("id")
 	qID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil || entityType != "user" {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	userID, err := uuid.Parse(entityIDStr)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	ctx := c.Request().Context()
 	questionnaire, err := s.service.GetQuestionnaireWithDetails(qID, ctx)
 	if err != nil {
-		return c.JSON(404, map[string]string{"error": "questionnaire not found"})
+		return problem(404, "questionnaire not found")
 	}
 
 	// Only owner can see members
 	if questionnaire.Edges.Owner.ID != userID {
-		return c.JSON(403, map[string]string{"error": "forbidden"})
+		return problem(403, "forbidden")
 	}
 
-	members, err := s.service.GetQuestionnaireMembers(qID, ctx)
+	pageReq, err := parsePageRequest(c)
+	if err != nil {
+		return err
+	}
+	filter := database.MemberFilter{DisplayName: c.QueryParam("q")}
+	if filter.Answered, err = parseBoolParam(c, "answered"); err != nil {
+		return err
+	}
+	if filter.Created, err = parseCreatedRange(c); err != nil {
+		return err
+	}
+
+	page, err := s.service.ListQuestionnaireMembers(qID, filter, pageReq, ctx)
+	if isPageRequestError(err) {
+		return err
+	}
 	if err != nil {
 		log := GetLogger(c)
 		log.Error("failed to get questionnaire members",
 			zap.String("questionnaire_id", qID.String()),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not get members"})
+		return problem(500, "could not get members")
 	}
 
-	return c.JSON(200, members)
+	return c.JSON(200, mapPageResponse(page, newMemberResponse))
 }
 
-// getMemberAnswers returns answers by the authenticated member/user for a questionnaire
+// getMemberAnswers returns a page of answers by the authenticated member/user for a questionnaire
 // @Summary Get member answers
-// @Description Get all answers provided by the authenticated user/member for a specific questionnaire
+// @Description Get the answers provided by the authenticated user/member for a specific questionnaire
 // @Tags questionnaires
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Questionnaire ID"
-// @Success 200 {array} object "List of answers"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Unauthorized"
-// @Failure 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not found"
-// @Router /api/questionnaires/{id}/my-answers [get]
+// @Param limit query int false "Page size, 1 to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param sort query string false "Sort field" Enums(created_at, updated_at) default(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param value query string false "Only answers with this value" Enums(Yes, No, Pass)
+// @Param created_after query string false "RFC 3339 timestamp or Unix milliseconds, inclusive"
+// @Param created_before query string false "RFC 3339 timestamp or Unix milliseconds, exclusive"
+// @Success 200 {object} PageResponse[AnswerResponse] "Page of answers"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Not found"
+// @Router /api/v1/questionnaires/{id}/my-answers [get]
 func (s *Server) getMemberAnswers(c echo.Context) error {
 	questionnaireID := c.Param("id")
 	qID, err := uuid.Parse(questionnaireID)
 	if err != nil {
-		return c.JSON(400, map[string]string{"error": "invalid questionnaire ID"})
+		return problem(400, "invalid questionnaire ID")
 	}
 
 	entityIDStr, entityType, err := GetValuesFromToken(c)
 	if err != nil {
-		return c.JSON(401, map[string]string{"error": "unauthorized, invalid token"})
+		return problem(401, "unauthorized, invalid token")
 	}
 
 	ctx := c.Request().Context()
@@ -1125,7 +1408,7 @@ func (s *Server) getMemberAnswers(c echo.Context) error {
 		userID, _ := uuid.Parse(entityIDStr)
 		member, err := s.service.GetMemberByUserAndQuestionnaire(userID, qID, ctx)
 		if err != nil {
-			return c.JSON(404, map[string]string{"error": "not a member of this questionnaire"})
+			return problem(404, "not a member of this questionnaire")
 		}
 		memberID = member.ID
 	case "member":
@@ -1133,21 +1416,36 @@ func (s *Server) getMemberAnswers(c echo.Context) error {
 		// Verify member belongs to this questionnaire
 		member, err := s.service.GetMemberWithQuestionnaire(memberID, ctx)
 		if err != nil || member.Edges.Questionnaire.ID != qID {
-			return c.JSON(403, map[string]string{"error": "forbidden"})
+			return problem(403, "forbidden")
 		}
 	default:
-		return c.JSON(401, map[string]string{"error": "invalid token type"})
+		return problem(401, "invalid token type")
 	}
 
-	answers, err := s.service.GetMemberAnswers(memberID, qID, ctx)
+	pageReq, err := parsePageRequest(c)
+	if err != nil {
+		return err
+	}
+	filter := database.AnswerFilter{Value: c.QueryParam("value")}
+	if filter.Value != "" && answer.AnswerValueValidator(answer.AnswerValue(filter.Value)) != nil {
+		return problem(400, "value must be one of Yes, No or Pass")
+	}
+	if filter.Created, err = parseCreatedRange(c); err != nil {
+		return err
+	}
+
+	page, err := s.service.ListMemberAnswers(memberID, qID, filter, pageReq, ctx)
+	if isPageRequestError(err) {
+		return err
+	}
 	if err != nil {
 		log := GetLogger(c)
 		log.Error("failed to get member answers",
 			zap.String("member_id", memberID.String()),
 			zap.String("questionnaire_id", qID.String()),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not get answers"})
+		return problem(500, "could not get answers")
 	}
 
-	return c.JSON(200, answers)
+	return c.JSON(200, mapPageResponse(page, newAnswerResponse))
 }
@@ -0,0 +1,411 @@
+package server
+
+import (
+	"radgifa/ent"
+
+	"github.com/google/uuid"
+)
+
+// The entities are never serialised as is: the response types below fix the
+// JSON contract of the API, whatever the schema becomes, and leave out what
+// must not leave the server, like password and passcode hashes. They keep
+// the layout clients already rely on, related entities under "edges", and an
+// edge is only present when the handler loaded it.
+//
+// What a caller sees depends on their role in the questionnaire. The owner
+// gets the full responses, QuestionnaireResponse and the types it refers to.
+// A member gets the Member* views, which leave out the other members, their
+// unique identifiers and their answers. Anyone holding an invitation gets
+// PublicQuestionnaireResponse.
+
+// QuestionnaireResponse is a questionnaire
+type QuestionnaireResponse struct {
+	ID              string                     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title           string                     `json:"title" example:"Best Pizza Topping"`
+	Description     string                     `json:"description" example:"Let's decide which pizza topping to order"`
+	IsPublished     bool                       `json:"is_published" example:"false"`
+	LockAfterSubmit bool                       `json:"lock_after_submit" example:"false"`
+	CreatedAt       int64                      `json:"created_at" example:"1735689600000"`
+	ClosedAt        *int64                     `json:"closed_at" example:"1735776000000"`
+	Version         int                        `json:"version" example:"1"`
+	Edges           QuestionnaireEdgesResponse `json:"edges"`
+}
+
+// QuestionnaireEdgesResponse holds the loaded relations of a questionnaire
+type QuestionnaireEdgesResponse struct {
+	Owner     *UserResponse      `json:"owner,omitempty"`
+	Members   []MemberResponse   `json:"members,omitempty"`
+	Questions []QuestionResponse `json:"questions,omitempty"`
+	Sections  []SectionResponse  `json:"sections,omitempty"`
+}
+
+// UserResponse is the public profile of a user
+type UserResponse struct {
+	ID          string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name        string `json:"name" example:"John Doe"`
+	Username    string `json:"username" example:"johndoe"`
+	DisplayName string `json:"display_name" example:"John"`
+	CreatedAt   int64  `json:"created_at" example:"1735689600000"`
+}
+
+// MemberResponse is a member of a questionnaire. CompletedAt is null until
+// they submit it.
+type MemberResponse struct {
+	ID               string              `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DisplayName      string              `json:"display_name" example:"John"`
+	UniqueIdentifier string              `json:"unique_identifier" example:"john"`
+	CreatedAt        int64               `json:"created_at" example:"1735689600000"`
+	CompletedAt      *int64              `json:"completed_at" example:"1735693200000"`
+	Edges            MemberEdgesResponse `json:"edges"`
+}
+
+// MemberEdgesResponse holds the loaded relations of a member
+type MemberEdgesResponse struct {
+	User          *UserResponse          `json:"user,omitempty"`
+	Questionnaire *QuestionnaireResponse `json:"questionnaire,omitempty"`
+	Answers       []AnswerResponse       `json:"answers,omitempty"`
+}
+
+// QuestionResponse is a question
+type QuestionResponse struct {
+	ID        string                `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Text      string                `json:"text" example:"Pineapple?"`
+	Required  bool                  `json:"required" example:"false"`
+	Position  int                   `json:"position" example:"0"`
+	Version   int                   `json:"version" example:"1"`
+	CreatedAt int64                 `json:"created_at" example:"1735689600000"`
+	Edges     QuestionEdgesResponse `json:"edges"`
+}
+
+// QuestionEdgesResponse holds the loaded relations of a question
+type QuestionEdgesResponse struct {
+	Questionnaire *QuestionnaireResponse `json:"questionnaire,omitempty"`
+	Section       *SectionResponse       `json:"section,omitempty"`
+	Answers       []AnswerResponse       `json:"answers,omitempty"`
+	Rules         []RuleResponse         `json:"rules,omitempty"`
+	Dependents    []RuleResponse         `json:"dependents,omitempty"`
+}
+
+// SectionResponse is a section of a questionnaire
+type SectionResponse struct {
+	ID          string               `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title       string               `json:"title" example:"Toppings"`
+	Description string               `json:"description" example:"What goes on the pizza"`
+	Position    int                  `json:"position" example:"0"`
+	CreatedAt   int64                `json:"created_at" example:"1735689600000"`
+	Edges       SectionEdgesResponse `json:"edges"`
+}
+
+// SectionEdgesResponse holds the loaded relations of a section
+type SectionEdgesResponse struct {
+	Questionnaire *QuestionnaireResponse `json:"questionnaire,omitempty"`
+	Questions     []QuestionResponse     `json:"questions,omitempty"`
+}
+
+// AnswerResponse is the answer of a member to a question
+type AnswerResponse struct {
+	ID          string              `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AnswerValue string              `json:"answer_value" example:"Yes" enums:"Yes,No,Neutral"`
+	CreatedAt   int64               `json:"created_at" example:"1735689600000"`
+	UpdatedAt   int64               `json:"updated_at" example:"1735689600000"`
+	Edges       AnswerEdgesResponse `json:"edges"`
+}
+
+// AnswerEdgesResponse holds the loaded relations of an answer
+type AnswerEdgesResponse struct {
+	Question *QuestionResponse `json:"question,omitempty"`
+	Member   *MemberResponse   `json:"member,omitempty"`
+}
+
+// RuleResponse is a branching rule: the question is shown when the source
+// question was answered with one of the answer values
+type RuleResponse struct {
+	ID           string            `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AnswerValues []string          `json:"answer_values" example:"Yes"`
+	CreatedAt    int64             `json:"created_at" example:"1735689600000"`
+	Edges        RuleEdgesResponse `json:"edges"`
+}
+
+// RuleEdgesResponse holds the loaded relations of a rule
+type RuleEdgesResponse struct {
+	Question *QuestionResponse `json:"question,omitempty"`
+	Source   *QuestionResponse `json:"source,omitempty"`
+}
+
+// MemberQuestionnaireResponse is a questionnaire as seen by one of its members
+type MemberQuestionnaireResponse struct {
+	ID              string                           `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title           string                           `json:"title" example:"Best Pizza Topping"`
+	Description     string                           `json:"description" example:"Let's decide which pizza topping to order"`
+	IsPublished     bool                             `json:"is_published" example:"true"`
+	LockAfterSubmit bool                             `json:"lock_after_submit" example:"false"`
+	CreatedAt       int64                            `json:"created_at" example:"1735689600000"`
+	ClosedAt        *int64                           `json:"closed_at" example:"1735776000000"`
+	Version         int                              `json:"version" example:"1"`
+	Edges           MemberQuestionnaireEdgesResponse `json:"edges"`
+}
+
+// MemberQuestionnaireEdgesResponse holds the relations of a questionnaire a
+// member may see. Members only holds the member themselves.
+type MemberQuestionnaireEdgesResponse struct {
+	Owner     *PublicUserResponse      `json:"owner,omitempty"`
+	Members   []ParticipantResponse    `json:"members,omitempty"`
+	Questions []MemberQuestionResponse `json:"questions,omitempty"`
+	Sections  []SectionResponse        `json:"sections,omitempty"`
+}
+
+// ParticipantResponse is a member of a questionnaire without their unique
+// identifier. CompletedAt is null until they submit it.
+type ParticipantResponse struct {
+	ID          string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DisplayName string `json:"display_name" example:"John"`
+	CompletedAt *int64 `json:"completed_at" example:"1735693200000"`
+}
+
+// MemberQuestionResponse is a question as seen by a member, with their own
+// answer only
+type MemberQuestionResponse struct {
+	ID        string                      `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Text      string                      `json:"text" example:"Pineapple?"`
+	Required  bool                        `json:"required" example:"false"`
+	Position  int                         `json:"position" example:"0"`
+	Version   int                         `json:"version" example:"1"`
+	CreatedAt int64                       `json:"created_at" example:"1735689600000"`
+	Edges     MemberQuestionEdgesResponse `json:"edges"`
+}
+
+// MemberQuestionEdgesResponse holds the relations of a question a member may
+// see
+type MemberQuestionEdgesResponse struct {
+	Section *SectionResponse `json:"section,omitempty"`
+	Answers []AnswerResponse `json:"answers,omitempty"`
+	Rules   []RuleResponse   `json:"rules,omitempty"`
+}
+
+// PublicUserResponse is the name a user shows to the members of their
+// questionnaires
+type PublicUserResponse struct {
+	ID          string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DisplayName string `json:"display_name" example:"John"`
+}
+
+// PublicQuestionnaireResponse is what an invitation tells about its
+// questionnaire before joining
+type PublicQuestionnaireResponse struct {
+	QuestionnaireID string `json:"questionnaire_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title           string `json:"title" example:"Best Pizza Topping"`
+	Description     string `json:"description" example:"Let's decide which pizza topping to order"`
+	IsPublished     bool   `json:"is_published" example:"true"`
+	ClosedAt        *int64 `json:"closed_at" example:"1735776000000"`
+}
+
+func newQuestionnaireResponse(q *ent.Questionnaire) QuestionnaireResponse {
+	return QuestionnaireResponse{
+		ID:              q.ID.String(),
+		Title:           q.Title,
+		Description:     q.Description,
+		IsPublished:     q.IsPublished,
+		LockAfterSubmit: q.LockAfterSubmit,
+		CreatedAt:       q.CreatedAt,
+		ClosedAt:        q.ClosedAt,
+		Version:         q.Version,
+		Edges: QuestionnaireEdgesResponse{
+			Owner:     mapOne(q.Edges.Owner, newUserResponse),
+			Members:   mapAll(q.Edges.Members, newMemberResponse),
+			Questions: mapAll(q.Edges.Questions, newQuestionResponse),
+			Sections:  mapAll(q.Edges.Sections, newSectionResponse),
+		},
+	}
+}
+
+func newUserResponse(u *ent.User) UserResponse {
+	return UserResponse{
+		ID:          u.ID.String(),
+		Name:        u.Name,
+		Username:    u.Username,
+		DisplayName: u.DisplayName,
+		CreatedAt:   u.CreatedAt,
+	}
+}
+
+func newMemberResponse(m *ent.Member) MemberResponse {
+	return MemberResponse{
+		ID:               m.ID.String(),
+		DisplayName:      m.DisplayName,
+		UniqueIdentifier: m.UniqueIdentifier,
+		CreatedAt:        m.CreatedAt,
+		CompletedAt:      m.CompletedAt,
+		Edges: MemberEdgesResponse{
+			User:          mapOne(m.Edges.User, newUserResponse),
+			Questionnaire: mapOne(m.Edges.Questionnaire, newQuestionnaireResponse),
+			Answers:       mapAll(m.Edges.Answers, newAnswerResponse),
+		},
+	}
+}
+
+func newQuestionResponse(q *ent.Question) QuestionResponse {
+	return QuestionResponse{
+		ID:        q.ID.String(),
+		Text:      q.Text,
+		Required:  q.Required,
+		Position:  q.Position,
+		Version:   q.Version,
+		CreatedAt: q.CreatedAt,
+		Edges: QuestionEdgesResponse{
+			Questionnaire: mapOne(q.Edges.Questionnaire, newQuestionnaireResponse),
+			Section:       mapOne(q.Edges.Section, newSectionResponse),
+			Answers:       mapAll(q.Edges.Answers, newAnswerResponse),
+			Rules:         mapAll(q.Edges.Rules, newRuleResponse),
+			Dependents:    mapAll(q.Edges.Dependents, newRuleResponse),
+		},
+	}
+}
+
+func newSectionResponse(s *ent.Section) SectionResponse {
+	return SectionResponse{
+		ID:          s.ID.String(),
+		Title:       s.Title,
+		Description: s.Description,
+		Position:    s.Position,
+		CreatedAt:   s.CreatedAt,
+		Edges: SectionEdgesResponse{
+			Questionnaire: mapOne(s.Edges.Questionnaire, newQuestionnaireResponse),
+			Questions:     mapAll(s.Edges.Questions, newQuestionResponse),
+		},
+	}
+}
+
+func newAnswerResponse(a *ent.Answer) AnswerResponse {
+	return AnswerResponse{
+		ID:          a.ID.String(),
+		AnswerValue: string(a.AnswerValue),
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+		Edges: AnswerEdgesResponse{
+			Question: mapOne(a.Edges.Question, newQuestionResponse),
+			Member:   mapOne(a.Edges.Member, newMemberResponse),
+		},
+	}
+}
+
+func newRuleResponse(r *ent.Rule) RuleResponse {
+	return RuleResponse{
+		ID:           r.ID.String(),
+		AnswerValues: r.AnswerValues,
+		CreatedAt:    r.CreatedAt,
+		Edges: RuleEdgesResponse{
+			Question: mapOne(r.Edges.Question, newQuestionResponse),
+			Source:   mapOne(r.Edges.Source, newQuestionResponse),
+		},
+	}
+}
+
+// newMemberQuestionnaireResponse renders q for the member memberID
+func newMemberQuestionnaireResponse(q *ent.Questionnaire, memberID uuid.UUID) MemberQuestionnaireResponse {
+	var self []*ent.Member
+	for _, m := range q.Edges.Members {
+		if m.ID == memberID {
+			self = append(self, m)
+		}
+	}
+	return MemberQuestionnaireResponse{
+		ID:              q.ID.String(),
+		Title:           q.Title,
+		Description:     q.Description,
+		IsPublished:     q.IsPublished,
+		LockAfterSubmit: q.LockAfterSubmit,
+		CreatedAt:       q.CreatedAt,
+		ClosedAt:        q.ClosedAt,
+		Version:         q.Version,
+		Edges: MemberQuestionnaireEdgesResponse{
+			Owner:     mapOne(q.Edges.Owner, newPublicUserResponse),
+			Members:   mapAll(self, newParticipantResponse),
+			Questions: mapAll(q.Edges.Questions, memberQuestionResponse(memberID)),
+			Sections:  mapAll(q.Edges.Sections, newPlainSectionResponse),
+		},
+	}
+}
+
+func newParticipantResponse(m *ent.Member) ParticipantResponse {
+	return ParticipantResponse{
+		ID:          m.ID.String(),
+		DisplayName: m.DisplayName,
+		CompletedAt: m.CompletedAt,
+	}
+}
+
+// memberQuestionResponse returns the mapping of questions for the member
+// memberID, which keeps their answer and drops the others
+func memberQuestionResponse(memberID uuid.UUID) func(*ent.Question) MemberQuestionResponse {
+	return func(q *ent.Question) MemberQuestionResponse {
+		var own []*ent.Answer
+		for _, a := range q.Edges.Answers {
+			if a.Edges.Member != nil && a.Edges.Member.ID == memberID {
+				own = append(own, a)
+			}
+		}
+		return MemberQuestionResponse{
+			ID:        q.ID.String(),
+			Text:      q.Text,
+			Required:  q.Required,
+			Position:  q.Position,
+			Version:   q.Version,
+			CreatedAt: q.CreatedAt,
+			Edges: MemberQuestionEdgesResponse{
+				Section: mapOne(q.Edges.Section, newPlainSectionResponse),
+				Answers: mapAll(own, newPlainAnswerResponse),
+				Rules:   mapAll(q.Edges.Rules, newRuleResponse),
+			},
+		}
+	}
+}
+
+func newPublicUserResponse(u *ent.User) PublicUserResponse {
+	return PublicUserResponse{ID: u.ID.String(), DisplayName: u.DisplayName}
+}
+
+func newPublicQuestionnaireResponse(q *ent.Questionnaire) PublicQuestionnaireResponse {
+	return PublicQuestionnaireResponse{
+		QuestionnaireID: q.ID.String(),
+		Title:           q.Title,
+		Description:     q.Description,
+		IsPublished:     q.IsPublished,
+		ClosedAt:        q.ClosedAt,
+	}
+}
+
+// newPlainSectionResponse renders a section without its relations
+func newPlainSectionResponse(s *ent.Section) SectionResponse {
+	r := newSectionResponse(s)
+	r.Edges = SectionEdgesResponse{}
+	return r
+}
+
+// newPlainAnswerResponse renders an answer without its relations
+func newPlainAnswerResponse(a *ent.Answer) AnswerResponse {
+	r := newAnswerResponse(a)
+	r.Edges = AnswerEdgesResponse{}
+	return r
+}
+
+// mapOne converts a loaded edge, nil when it was not loaded
+func mapOne[T, R any](v *T, convert func(*T) R) *R {
+	if v == nil {
+		return nil
+	}
+	r := convert(v)
+	return &r
+}
+
+// mapAll converts loaded edges, nil when they were not loaded so that the
+// edge is left out
+func mapAll[T, R any](vs []*T, convert func(*T) R) []R {
+	if vs == nil {
+		return nil
+	}
+	rs := make([]R, len(vs))
+	for i, v := range vs {
+		rs[i] = convert(v)
+	}
+	return rs
+}
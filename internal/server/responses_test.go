@@ -0,0 +1,165 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/ent/answer"
+
+	"github.com/google/uuid"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of testdata")
+
+// goldenQuestionnaire is a published questionnaire answered by two members,
+// the second one joined with a user account
+func goldenQuestionnaire() *ent.Questionnaire {
+	const created = 1735689600000
+	completed := int64(created + 3600000)
+
+	owner := &ent.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Name: "Ada Owner", Username: "ada", DisplayName: "Ada", Password: []byte("$2a$10$owner"), CreatedAt: created}
+	alice := &ent.Member{ID: uuid.MustParse("00000000-0000-0000-0000-000000000011"), DisplayName: "Alice", UniqueIdentifier: "alice", PassCode: []byte("$2a$10$alice"), CreatedAt: created, CompletedAt: &completed}
+	bob := &ent.Member{ID: uuid.MustParse("00000000-0000-0000-0000-000000000012"), DisplayName: "Bob", UniqueIdentifier: "bob", CreatedAt: created}
+	bob.Edges.User = &ent.User{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Name: "Bob Builder", Username: "bob", DisplayName: "Bob", Password: []byte("$2a$10$bob"), CreatedAt: created}
+
+	section := &ent.Section{ID: uuid.MustParse("00000000-0000-0000-0000-000000000021"), Title: "Toppings", Position: 0, CreatedAt: created}
+	pineapple := &ent.Question{ID: uuid.MustParse("00000000-0000-0000-0000-000000000031"), Text: "Pineapple?", Required: true, Position: 0, Version: 1, CreatedAt: created}
+	pineapple.Edges.Section = section
+	anchovies := &ent.Question{ID: uuid.MustParse("00000000-0000-0000-0000-000000000032"), Text: "Anchovies?", Position: 1, Version: 2, CreatedAt: created}
+	anchovies.Edges.Rules = []*ent.Rule{{
+		ID:           uuid.MustParse("00000000-0000-0000-0000-000000000041"),
+		AnswerValues: []string{"Yes"},
+		CreatedAt:    created,
+		Edges:        ent.RuleEdges{Source: &ent.Question{ID: pineapple.ID}},
+	}}
+
+	answerOf := func(id string, m *ent.Member, value answer.AnswerValue) *ent.Answer {
+		a := &ent.Answer{ID: uuid.MustParse(id), AnswerValue: value, CreatedAt: created, UpdatedAt: created}
+		a.Edges.Member = m
+		return a
+	}
+	pineapple.Edges.Answers = []*ent.Answer{
+		answerOf("00000000-0000-0000-0000-000000000051", alice, answer.AnswerValueYes),
+		answerOf("00000000-0000-0000-0000-000000000052", bob, answer.AnswerValueNo),
+	}
+	anchovies.Edges.Answers = []*ent.Answer{
+		answerOf("00000000-0000-0000-0000-000000000053", alice, answer.AnswerValueNo),
+	}
+
+	q := &ent.Questionnaire{ID: uuid.MustParse("00000000-0000-0000-0000-000000000100"), Title: "Pizza night", Description: "What do we order?", IsPublished: true, CreatedAt: created, Version: 4}
+	q.Edges.Owner = owner
+	q.Edges.Members = []*ent.Member{alice, bob}
+	q.Edges.Sections = []*ent.Section{section}
+	q.Edges.Questions = []*ent.Question{pineapple, anchovies}
+	return q
+}
+
+// assertGolden compares v, as JSON, with testdata/name.golden.json, which
+// go test -run TestResponseViews -update rewrites
+func assertGolden(t *testing.T, name string, v any) {
+	t.Helper()
+	got, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+
+	path := filepath.Join("testdata", name+".golden.json")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading the golden file, run with -update to create it: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from %s:\n%s", name, path, got)
+	}
+}
+
+func TestResponseViews(t *testing.T) {
+	q := goldenQuestionnaire()
+	alice, bob := q.Edges.Members[0], q.Edges.Members[1]
+
+	tests := []struct {
+		name string
+		view any
+	}{
+		{"questionnaire_owner", newQuestionnaireResponse(q)},
+		{"questionnaire_member", newMemberQuestionnaireResponse(q, bob.ID)},
+		{"questionnaire_public", newPublicQuestionnaireResponse(q)},
+		{"questions_member", mapAll(q.Edges.Questions, memberQuestionResponse(alice.ID))},
+		{"members_owner", mapAll(q.Edges.Members, newMemberResponse)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertGolden(t, tt.name, tt.view)
+		})
+	}
+}
+
+// memberDetailsService serves the details of questionnaire to its members
+type memberDetailsService struct {
+	detailsService
+}
+
+func (s *memberDetailsService) GetMemberWithQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	for _, m := range s.questionnaire.Edges.Members {
+		if m.ID == memberID {
+			return &ent.Member{ID: m.ID, Edges: ent.MemberEdges{Questionnaire: s.questionnaire}}, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func TestQuestionnaireDetailsByRole(t *testing.T) {
+	q := goldenQuestionnaire()
+	s := newTestServer(t, WithService(&memberDetailsService{detailsService{questionnaire: q}}))
+
+	tests := []struct {
+		name      string
+		token     string
+		want      int
+		wantIn    []string
+		wantNotIn []string
+	}{
+		{"owner", testToken(t, s, q.Edges.Owner.ID, "user"), http.StatusOK, []string{`"unique_identifier":"alice"`, `"unique_identifier":"bob"`}, nil},
+		{"member", testToken(t, s, q.Edges.Members[0].ID, "member"), http.StatusOK, []string{`"display_name":"Alice"`}, []string{"unique_identifier", `"Bob"`, "ada"}},
+		{"stranger", testToken(t, s, uuid.New(), "member"), http.StatusForbidden, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/questionnaires/"+q.ID.String(), nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			resp := httptest.NewRecorder()
+			s.Handler().ServeHTTP(resp, req)
+			if resp.Code != tt.want {
+				t.Fatalf("GET = %d %s, want %d", resp.Code, resp.Body, tt.want)
+			}
+			for _, s := range tt.wantIn {
+				if !strings.Contains(resp.Body.String(), s) {
+					t.Errorf("response %s lacks %s", resp.Body, s)
+				}
+			}
+			for _, s := range tt.wantNotIn {
+				if strings.Contains(resp.Body.String(), s) {
+					t.Errorf("response %s contains %s", resp.Body, s)
+				}
+			}
+		})
+	}
+}
@@ -2,30 +2,28 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"os"
-	"strconv"
+	"strings"
 	"time"
 
+	"radgifa/internal/database"
+
 	"github.com/golang-jwt/jwt/v5"
 	echojwt "github.com/labstack/echo-jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"github.com/natefinch/lumberjack"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
 	"golang.org/x/time/rate"
 )
 
 const (
 	defaultRequestsPerSecond rate.Limit = 10
-)
-
-var (
-	jwtSecret = []byte(os.Getenv("JWT_SECRET"))
-	reqs_sec  = setRequestsPerSecondLimit()
+	defaultServiceName                  = "radgifa"
 )
 
 type LoginCredentials struct {
@@ -59,21 +57,48 @@ func GetValuesFromToken(c echo.Context) (string, string, error) {
 	return entityIDStr, entityType, nil
 }
 
-// validateJWTToken valida un token JWT sin middleware (para rutas públicas con auth opcional)
-func validateJWTToken(tokenString string) (jwt.MapClaims, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Validar que sea exactamente HS256 como se usa en loginHandler
-		if token.Method != jwt.SigningMethodHS256 {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+// signingKey returns the key used to sign newly issued tokens
+func (s *Server) signingKey() []byte {
+	return s.signingKeys[0]
+}
+
+// parseJWTToken parses a token trying every configured key, so tokens signed
+// with a rotated-out key stay valid while it is still listed
+func (s *Server) parseJWTToken(ctx context.Context, tokenString string) (_ *jwt.Token, err error) {
+	_, span := startTokenSpan(ctx, "jwt")
+	defer func() { endTokenSpan(span, err) }()
+
+	var lastErr error
+	for _, key := range s.signingKeys {
+		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+			// Validar que sea exactamente HS256 como se usa en loginHandler
+			if token.Method != jwt.SigningMethodHS256 {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return key, nil
+		})
+		if err == nil && token.Valid {
+			return token, nil
 		}
-		return jwtSecret, nil
-	})
+		lastErr = err
+		if !errors.Is(err, jwt.ErrTokenSignatureInvalid) {
+			break
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("invalid token")
+	}
+	return nil, lastErr
+}
 
+// validateJWTToken valida un token JWT sin middleware (para rutas públicas con auth opcional)
+func (s *Server) validateJWTToken(ctx context.Context, tokenString string) (jwt.MapClaims, error) {
+	token, err := s.parseJWTToken(ctx, tokenString)
 	if err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
 		return claims, nil
 	}
 
@@ -84,11 +109,16 @@ func (s *Server) RegisterRoutes() http.Handler {
 	e := echo.New()
 
 	e.Validator = NewValidator()
+	e.JSONSerializer = legacySerializer{}
+	e.HTTPErrorHandler = s.httpErrorHandler
 
-	logger := newZapLogger()
+	logger := s.logger
 
 	e.Use(middleware.RequestID())
+	e.Use(otelecho.Middleware(s.serviceName))
+	e.Use(requestIDTracing())
 
+	e.Use(prometheusMiddleware())
 	e.Use(zapRequestLogger(logger))
 	e.Use(zapInjectLogger(logger))
 
@@ -98,54 +128,70 @@ func (s *Server) RegisterRoutes() http.Handler {
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"https://*", "http://*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowHeaders:     []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowHeaders:     []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "If-Match", "If-None-Match", "Idempotency-Key"},
+		ExposeHeaders:    []string{"ETag", "Idempotent-Replayed", "Deprecation", "Sunset", "Link"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
-	authRateLimiter := middleware.RateLimiter(middleware.NewRateLimiterMemoryStore(reqs_sec))
-
-	e.GET("/register", s.serveFrontend)
-	e.POST("/register", s.RegisterHandler, authRateLimiter)
-	e.GET("/login", s.serveFrontend)
-	e.POST("/login", s.loginHandler, authRateLimiter)
-
-	e.POST("/check/username", s.checkUsernameAvailability)
-	e.POST("/check/member/:token", s.checkMemberIdentifierAvailability)
-
-	e.GET("/join/:token/info", s.getQuestionnaireInfoFromToken)
-	e.POST("/join/:token/info", s.getQuestionnaireInfoFromToken)
-	e.GET("/join/:token", s.serveFrontend)
-	e.POST("/join/:token", s.createQuestionnaireMember).Name = "join-questionnaire"
-
-	api := e.Group("/api")
-	jwtMiddleware := echojwt.JWT(jwtSecret)
-	api.Use(jwtMiddleware)
-
-	// Questionnaire endpoints
-	api.GET("/questionnaires", s.getUserQuestionnaires)
-	api.POST("/questionnaires", s.createQuestionnaire)
-	api.GET("/questionnaires/:id", s.getQuestionnaireDetails)
-	api.PUT("/questionnaires/:id", s.updateQuestionnaire)
-	api.DELETE("/questionnaires/:id", s.deleteQuestionnaire)
-	api.POST("/questionnaires/:id/publish", s.publishQuestionnaire)
-	api.GET("/questionnaires/:id/questions", s.getQuestionnaireQuestions)
-	api.GET("/questionnaires/:id/members", s.getQuestionnaireMembers)
-	api.GET("/questionnaires/:id/my-answers", s.getMemberAnswers)
-	api.POST("/questionnaires/:id/invite", s.generateQuestionnaireInvitation)
-	api.POST("/questionnaires/:id/question", s.createNewQuestion)
-	api.PUT("/questionnaires/:questionnaireId/questions/:questionId", s.updateQuestion)
-	api.DELETE("/questionnaires/:questionnaireId/questions/:questionId", s.deleteQuestion)
-
-	// Question endpoints
-	api.POST("/question/:id", s.newQuestionAnswer)
+	authRateLimiter := middleware.RateLimiter(middleware.NewRateLimiterMemoryStore(s.requestsPerSecond))
+
+	// Every route hangs from the configured prefix so the handler can be
+	// mounted under a sub-path of an embedding application's mux
+	root := e.Group(s.routePrefix)
+
+	// Pages of the frontend, rendered by the Vue router
+	root.GET("/register", s.serveFrontend)
+	root.GET("/login", s.serveFrontend)
+	root.GET("/join/:token", s.serveFrontend).Name = "join-questionnaire"
+
+	jwtMiddleware := echojwt.WithConfig(echojwt.Config{
+		ParseTokenFunc: func(c echo.Context, auth string) (interface{}, error) {
+			if strings.HasPrefix(auth, database.AccessTokenPrefix) {
+				return s.parseAccessToken(c, auth)
+			}
+			return s.parseJWTToken(c.Request().Context(), auth)
+		},
+	})
 
-	e.GET("/health", s.healthHandler)
+	// The API lives under /api/v1. The unversioned routes it started with
+	// are kept as deprecated aliases until the sunset date.
+	v1 := root.Group("/api/v1")
+	s.publicRoutes(v1, authRateLimiter)
+	v1Private := v1.Group("", jwtMiddleware)
+	s.privateRoutes(v1Private)
+	// These came after the versioning, they have no legacy alias
+	v1Private.POST("/graphql", s.graphqlHandler, requireScope(database.ScopeRead))
+	v1Private.GET("/tokens", s.listAccessTokens, sessionOnly)
+	v1Private.POST("/tokens", s.createAccessToken, sessionOnly)
+	v1Private.DELETE("/tokens/:id", s.revokeAccessToken, sessionOnly)
+	v1Private.POST("/questionnaires/:id/close", s.closeQuestionnaire, requireScope(database.ScopeQuestionnaires))
+	v1Private.GET("/questionnaires/:id/webhooks", s.listWebhooks, requireScope(database.ScopeRead))
+	v1Private.POST("/questionnaires/:id/webhooks", s.createWebhook, requireScope(database.ScopeQuestionnaires))
+	v1Private.DELETE("/questionnaires/:id/webhooks/:webhookId", s.deleteWebhook, requireScope(database.ScopeQuestionnaires))
+	v1Private.GET("/questionnaires/:id/webhooks/:webhookId/deliveries", s.listWebhookDeliveries, requireScope(database.ScopeRead))
+	v1Private.POST("/questionnaires/:id/webhooks/:webhookId/deliveries/:deliveryId/redeliver", s.redeliverWebhookDelivery, requireScope(database.ScopeQuestionnaires))
+
+	s.publicRoutes(root, authRateLimiter, s.deprecated)
+	s.privateRoutes(root.Group("/api", jwtMiddleware, s.deprecated))
+
+	root.GET("/health", s.healthHandler)
+	root.GET("/livez", s.livezHandler)
+	root.GET("/readyz", s.readyzHandler)
+	root.GET("/metrics", s.metricsHandler())
+
+	if s.adminToken != "" {
+		admin := root.Group("/admin", s.requireAdminToken)
+		if s.logLevel != nil {
+			admin.GET("/log-level", s.getLogLevel)
+			admin.PUT("/log-level", s.setLogLevel)
+		}
+	}
 
 	// Swagger endpoint
-	e.GET("/swagger/*", echoSwagger.WrapHandler)
+	root.GET("/swagger/*", echoSwagger.WrapHandler)
 
 	// Serve static frontend files
-	e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
+	root.Use(middleware.StaticWithConfig(middleware.StaticConfig{
 		Root:   "frontend/dist",
 		Index:  "index.html",
 		Browse: false,
@@ -155,6 +201,63 @@ func (s *Server) RegisterRoutes() http.Handler {
 	return e
 }
 
+// publicRoutes mounts the routes that need no token. The middleware is
+// added to every route.
+func (s *Server) publicRoutes(g *echo.Group, authRateLimiter echo.MiddlewareFunc, m ...echo.MiddlewareFunc) {
+	limited := append(m[:len(m):len(m)], authRateLimiter)
+	g.POST("/register", s.RegisterHandler, limited...)
+	g.POST("/login", s.loginHandler, limited...)
+
+	g.POST("/check/username", s.checkUsernameAvailability, m...)
+	g.POST("/check/member/:token", s.checkMemberIdentifierAvailability, m...)
+
+	g.GET("/join/:token/info", s.getQuestionnaireInfoFromToken, m...)
+	g.POST("/join/:token/info", s.getQuestionnaireInfoFromToken, m...)
+	g.POST("/join/:token", s.createQuestionnaireMember, append(m[:len(m):len(m)], s.idempotent)...)
+}
+
+// privateRoutes mounts the routes that need a token on a group checking it
+func (s *Server) privateRoutes(api *echo.Group) {
+	// The scopes a personal access token needs, sessions have them all
+	read := requireScope(database.ScopeRead)
+	edit := requireScope(database.ScopeQuestionnaires)
+	answer := requireScope(database.ScopeAnswers)
+
+	// Questionnaire endpoints
+	api.GET("/questionnaires", s.getUserQuestionnaires, read)
+	api.POST("/questionnaires", s.createQuestionnaire, edit, s.idempotent)
+	api.GET("/questionnaires/:id", s.getQuestionnaireDetails, read)
+	api.PUT("/questionnaires/:id", s.updateQuestionnaire, edit)
+	api.DELETE("/questionnaires/:id", s.deleteQuestionnaire, edit)
+	api.POST("/questionnaires/:id/publish", s.publishQuestionnaire, edit)
+	api.GET("/questionnaires/:id/questions", s.getQuestionnaireQuestions, read)
+	api.GET("/questionnaires/:id/members", s.getQuestionnaireMembers, read)
+	api.GET("/questionnaires/:id/my-answers", s.getMemberAnswers, read)
+	api.GET("/questionnaires/:id/my-questions", s.getMyQuestions, read)
+	api.POST("/questionnaires/:id/answers", s.newQuestionnaireAnswers, answer)
+	api.POST("/questionnaires/:id/submit", s.submitQuestionnaire, answer)
+	api.POST("/questionnaires/:id/invite", s.generateQuestionnaireInvitation, edit)
+	api.POST("/questionnaires/:id/question", s.createNewQuestion, edit, s.idempotent)
+	api.PUT("/questionnaires/:id/questions/order", s.reorderQuestions, edit)
+	api.PUT("/questionnaires/:questionnaireId/questions/:questionId", s.updateQuestion, edit)
+	api.DELETE("/questionnaires/:questionnaireId/questions/:questionId", s.deleteQuestion, edit)
+	api.GET("/questionnaires/:questionnaireId/questions/:questionId/rules", s.getQuestionRules, read)
+	api.POST("/questionnaires/:questionnaireId/questions/:questionId/rules", s.createQuestionRule, edit)
+	api.DELETE("/questionnaires/:questionnaireId/questions/:questionId/rules/:ruleId", s.deleteQuestionRule, edit)
+	api.GET("/questionnaires/:id/sections", s.getQuestionnaireSections, read)
+	api.POST("/questionnaires/:id/sections", s.createSection, edit)
+	api.PUT("/questionnaires/:id/sections/order", s.reorderSections, edit)
+	api.PUT("/questionnaires/:questionnaireId/sections/:sectionId", s.updateSection, edit)
+	api.DELETE("/questionnaires/:questionnaireId/sections/:sectionId", s.deleteSection, edit)
+	api.GET("/questionnaires/:id/completion", s.getQuestionnaireCompletion, read)
+
+	// Membership endpoints
+	api.GET("/memberships", s.getUserMemberships, read)
+
+	// Question endpoints
+	api.POST("/question/:id", s.newQuestionAnswer, answer)
+}
+
 func (s *Server) HelloWorldHandler(c echo.Context) error {
 
 	log := GetLogger(c)
@@ -172,18 +275,6 @@ func (s *Server) serveFrontend(c echo.Context) error {
 	return c.File("frontend/dist/index.html")
 }
 
-func setRequestsPerSecondLimit() rate.Limit {
-	rateStr := os.Getenv("REQUESTS_PER_SECOND")
-	if rateStr == "" {
-		return defaultRequestsPerSecond
-	}
-	r, err := strconv.Atoi(rateStr)
-	if err != nil || r <= 0 {
-		return defaultRequestsPerSecond
-	}
-	return rate.Limit(r)
-}
-
 func (s *Server) healthHandler(c echo.Context) error {
 	return c.JSON(http.StatusOK, s.service.Health())
 }
@@ -199,28 +290,6 @@ func (s *Server) HealthHandler(c echo.Context) error {
 	return c.JSON(http.StatusOK, s.service.Health())
 }
 
-func newZapLogger() *zap.Logger {
-
-	fileSink := zapcore.AddSync(&lumberjack.Logger{
-		Filename:   "logs/app.log",
-		MaxSize:    50, // MB
-		MaxBackups: 7,
-		MaxAge:     14, // days
-		Compress:   true,
-	})
-
-	encCfg := zap.NewProductionEncoderConfig()
-	encCfg.TimeKey = "ts"
-	encCfg.EncodeTime = zapcore.ISO8601TimeEncoder
-	jsonEncoder := zapcore.NewJSONEncoder(encCfg)
-
-	fileCore := zapcore.NewCore(jsonEncoder, fileSink, zapcore.InfoLevel)
-	consoleCore := zapcore.NewCore(jsonEncoder, zapcore.AddSync(os.Stdout), zapcore.InfoLevel)
-
-	core := zapcore.NewTee(fileCore, consoleCore)
-	return zap.New(core)
-}
-
 func zapRequestLogger(logger *zap.Logger) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -259,12 +328,16 @@ func zapInjectLogger(base *zap.Logger) echo.MiddlewareFunc {
 		return func(c echo.Context) error {
 			req := c.Request()
 			requestID := c.Response().Header().Get(echo.HeaderXRequestID)
-			reqLogger := base.With(
+			ctx := req.Context()
+			fields := []zap.Field{
 				zap.String("request_id", requestID),
 				zap.String("method", req.Method),
 				zap.String("path", c.Path()),
-			)
-			ctx := req.Context()
+			}
+			if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+				fields = append(fields, zap.String("trace_id", sc.TraceID().String()))
+			}
+			reqLogger := base.With(fields...)
 			ctx = context.WithValue(ctx, ctxLoggerKey{}, reqLogger)
 			c.SetRequest(req.WithContext(ctx))
 			return next(c)
@@ -0,0 +1,311 @@
+package server
+
+import (
+	"errors"
+
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+type NewRuleRequest struct {
+	SourceQuestionID uuid.UUID `json:"source_question_id" validate:"required" swaggertype:"string" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	AnswerValues     []string  `json:"answer_values" validate:"required,min=1,dive,oneof=Yes No Pass" example:"Yes"`
+}
+
+// getQuestionRules lists the branching rules of a question
+// @Summary Get question rules
+// @Description Get the rules deciding who sees a question. A question with rules is only shown to the members whose answer to the source question of every rule is one of its answer values (only owner).
+// @Tags rules
+// @Produce json
+// @Security BearerAuth
+// @Param questionnaireId path string true "Questionnaire ID"
+// @Param questionId path string true "Question ID"
+// @Success 200 {array} RuleResponse "Rules of the question"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can list rules"
+// @Failure 404 {object} Problem "Question or questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{questionnaireId}/questions/{questionId}/rules [get]
+func (s *Server) getQuestionRules(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("questionnaireId"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	questionUUID, err := uuid.Parse(c.Param("questionId"))
+	if err != nil {
+		return problem(400, "invalid question ID")
+	}
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to list rules in this questionnaire")
+	}
+
+	question, err := s.service.GetQuestionWithQuestionnaire(questionUUID, ctx)
+	if err != nil {
+		return problem(404, "question not found")
+	}
+
+	if question.Edges.Questionnaire.ID != questionnaireUUID {
+		return problem(400, "question does not belong to specified questionnaire")
+	}
+
+	rules, err := s.service.GetQuestionRules(questionUUID, ctx)
+	if err != nil {
+		return problem(500, "could not get rules")
+	}
+
+	return c.JSON(200, mapAll(rules, newRuleResponse))
+}
+
+// createQuestionRule adds a branching rule to a question
+// @Summary Create question rule
+// @Description Only show the question to the members who answered the source question with one of the answer values. Rules of a question must all hold, and a question cannot depend on itself, directly or through other rules (only owner and only if questionnaire not published).
+// @Tags rules
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param questionnaireId path string true "Questionnaire ID"
+// @Param questionId path string true "Question ID"
+// @Param rule body NewRuleRequest true "Rule data"
+// @Success 201 {object} RuleResponse "Rule created successfully"
+// @Failure 400 {object} Problem "Bad request or invalid rule"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can add rules or questionnaire is published"
+// @Failure 404 {object} Problem "Question or questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{questionnaireId}/questions/{questionId}/rules [post]
+func (s *Server) createQuestionRule(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("questionnaireId"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	questionUUID, err := uuid.Parse(c.Param("questionId"))
+	if err != nil {
+		return problem(400, "invalid question ID")
+	}
+
+	nr := new(NewRuleRequest)
+	if err := BindAndValidate(c, nr); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to add rules in this questionnaire")
+	}
+
+	if q.IsPublished {
+		return problem(403, "cannot add rules in published questionnaire").withCode(CodeQuestionnairePublished)
+	}
+
+	question, err := s.service.GetQuestionWithQuestionnaire(questionUUID, ctx)
+	if err != nil {
+		return problem(404, "question not found")
+	}
+
+	if question.Edges.Questionnaire.ID != questionnaireUUID {
+		return problem(400, "question does not belong to specified questionnaire")
+	}
+
+	rule, err := s.service.CreateRule(questionUUID, nr.SourceQuestionID, nr.AnswerValues, ctx)
+	if errors.Is(err, database.ErrInvalidRule) {
+		return err
+	}
+	if err != nil {
+		GetLogger(c).Error("Could not create rule", zap.String("question_id", questionUUID.String()), zap.Error(err))
+		return problem(500, "could not create rule")
+	}
+
+	return c.JSON(201, newRuleResponse(rule))
+}
+
+// deleteQuestionRule removes a branching rule from a question
+// @Summary Delete question rule
+// @Description Remove a rule from a question (only owner and only if questionnaire not published)
+// @Tags rules
+// @Produce json
+// @Security BearerAuth
+// @Param questionnaireId path string true "Questionnaire ID"
+// @Param questionId path string true "Question ID"
+// @Param ruleId path string true "Rule ID"
+// @Success 200 {object} map[string]string "Rule deleted successfully"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can delete rules or questionnaire is published"
+// @Failure 404 {object} Problem "Rule, question or questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{questionnaireId}/questions/{questionId}/rules/{ruleId} [delete]
+func (s *Server) deleteQuestionRule(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("questionnaireId"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	questionUUID, err := uuid.Parse(c.Param("questionId"))
+	if err != nil {
+		return problem(400, "invalid question ID")
+	}
+
+	ruleUUID, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		return problem(400, "invalid rule ID")
+	}
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to delete rules in this questionnaire")
+	}
+
+	if q.IsPublished {
+		return problem(403, "cannot delete rules in published questionnaire").withCode(CodeQuestionnairePublished)
+	}
+
+	question, err := s.service.GetQuestionWithQuestionnaire(questionUUID, ctx)
+	if err != nil {
+		return problem(404, "question not found")
+	}
+
+	if question.Edges.Questionnaire.ID != questionnaireUUID {
+		return problem(400, "question does not belong to specified questionnaire")
+	}
+
+	rule, err := s.service.GetRuleWithQuestion(ruleUUID, ctx)
+	if err != nil {
+		return problem(404, "rule not found")
+	}
+
+	if rule.Edges.Question.ID != questionUUID {
+		return problem(400, "rule does not belong to specified question")
+	}
+
+	if err := s.service.DeleteRule(ruleUUID, ctx); err != nil {
+		return problem(500, "could not delete rule")
+	}
+
+	return c.JSON(200, map[string]string{"message": "rule deleted successfully"})
+}
+
+// getMyQuestions returns the questions the authenticated member sees
+// @Summary Get visible questions
+// @Description Get, in order, the questions the authenticated member sees given the answers they gave so far. Questions hidden by branching rules are left out.
+// @Tags questionnaires
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Success 200 {array} MemberQuestionResponse "Visible questions"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Not a member of this questionnaire"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/my-questions [get]
+func (s *Server) getMyQuestions(c echo.Context) error {
+	qID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	ctx := c.Request().Context()
+	var memberID uuid.UUID
+
+	switch entityType {
+	case "user":
+		userID, _ := uuid.Parse(entityIDStr)
+		member, err := s.service.GetMemberByUserAndQuestionnaire(userID, qID, ctx)
+		if err != nil {
+			return problem(404, "not a member of this questionnaire")
+		}
+		memberID = member.ID
+	case "member":
+		memberID, _ = uuid.Parse(entityIDStr)
+		member, err := s.service.GetMemberWithQuestionnaire(memberID, ctx)
+		if err != nil || member.Edges.Questionnaire.ID != qID {
+			return problem(403, "forbidden")
+		}
+	default:
+		return problem(401, "invalid token type")
+	}
+
+	questions, err := s.service.GetVisibleQuestions(memberID, qID, ctx)
+	if err != nil {
+		GetLogger(c).Error("failed to get visible questions",
+			zap.String("member_id", memberID.String()),
+			zap.String("questionnaire_id", qID.String()),
+			zap.Error(err))
+		return problem(500, "could not get questions")
+	}
+
+	return c.JSON(200, mapAll(questions, memberQuestionResponse(memberID)))
+}
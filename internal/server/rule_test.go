@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type hiddenQuestionService struct {
+	stubService
+	questionnaireID uuid.UUID
+}
+
+func (s *hiddenQuestionService) GetQuestionWithQuestionnaire(questionID uuid.UUID, ctx context.Context) (*ent.Question, error) {
+	q := &ent.Question{ID: questionID}
+	q.Edges.Questionnaire = &ent.Questionnaire{ID: s.questionnaireID}
+	return q, nil
+}
+
+func (s *hiddenQuestionService) GetMemberWithQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	m := &ent.Member{ID: memberID}
+	m.Edges.Questionnaire = &ent.Questionnaire{ID: s.questionnaireID}
+	return m, nil
+}
+
+func (s *hiddenQuestionService) CreateAnswer(memberID, questionID uuid.UUID, answerValue string, ctx context.Context) (*ent.Answer, error) {
+	return nil, database.ErrQuestionHidden
+}
+
+func TestAnswerToHiddenQuestion(t *testing.T) {
+	s := newTestServer(t, WithService(&hiddenQuestionService{questionnaireID: uuid.New()}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/question/"+uuid.NewString(), strings.NewReader(`{"answer_value":"Yes"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "member"))
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, req)
+	if resp.Code != http.StatusConflict {
+		t.Fatalf("answering a hidden question = %d %s, want 409", resp.Code, resp.Body)
+	}
+	if !strings.Contains(resp.Body.String(), "hidden") {
+		t.Errorf("body %s does not explain the question is hidden", resp.Body)
+	}
+}
+
+func TestCreateRuleValidation(t *testing.T) {
+	s := newTestServer(t)
+	path := "/api/questionnaires/" + uuid.NewString() + "/questions/" + uuid.NewString() + "/rules"
+
+	for _, body := range []string{
+		`{"source_question_id":"` + uuid.NewString() + `","answer_values":[]}`,
+		`{"source_question_id":"` + uuid.NewString() + `","answer_values":["Maybe"]}`,
+	} {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "user"))
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		if resp.Code != http.StatusBadRequest {
+			t.Errorf("POST %s with %s = %d %s, want 400", path, body, resp.Code, resp.Body)
+		}
+	}
+}
@@ -0,0 +1,491 @@
+package server
+
+import (
+	"errors"
+	"strings"
+
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/microcosm-cc/bluemonday"
+	"go.uber.org/zap"
+)
+
+type SectionRequest struct {
+	Title       string `json:"title" validate:"required,min=1,max=200,no_whitespace_only" example:"Toppings"`
+	Description string `json:"description" validate:"omitempty,max=1000" example:"Everything that goes on the pizza"`
+}
+
+type ReorderSectionsRequest struct {
+	SectionIDs []uuid.UUID `json:"section_ids" validate:"required,min=1" swaggertype:"array,string" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+}
+
+func (sr *SectionRequest) Sanitize() {
+	p := bluemonday.StrictPolicy()
+	sr.Title = strings.TrimSpace(p.Sanitize(sr.Title))
+	sr.Description = strings.TrimSpace(p.Sanitize(sr.Description))
+}
+
+// CompletionResponse breaks down the progress of a questionnaire per section
+type CompletionResponse struct {
+	Sections  []SectionCompletionResponse `json:"sections"`
+	Members   []MemberCompletionResponse  `json:"members"`
+	Submitted int                         `json:"submitted" example:"1"`
+}
+
+// SectionCompletionResponse summarises a section over all the members. The
+// questions outside any section come last, with a null section_id.
+type SectionCompletionResponse struct {
+	SectionID        *string `json:"section_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title            string  `json:"title" example:"Toppings"`
+	Questions        int     `json:"questions" example:"4"`
+	MembersCompleted int     `json:"members_completed" example:"2"`
+}
+
+// MemberCompletionResponse is the progress of one member in every section.
+// CompletedAt is null until the member submits the questionnaire.
+type MemberCompletionResponse struct {
+	MemberID        string            `json:"member_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DisplayName     string            `json:"display_name" example:"John"`
+	CompletedAt     *int64            `json:"completed_at" example:"1700000000000"`
+	MissingRequired int               `json:"missing_required" example:"0"`
+	Sections        []SectionProgress `json:"sections"`
+}
+
+// SectionProgress counts the questions of a section the member answered
+type SectionProgress struct {
+	SectionID *string `json:"section_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	MembershipProgress
+}
+
+func newCompletionResponse(completion database.Completion) CompletionResponse {
+	resp := CompletionResponse{
+		Sections: make([]SectionCompletionResponse, len(completion.Sections)),
+		Members:  make([]MemberCompletionResponse, len(completion.Members)),
+	}
+	for i, m := range completion.Members {
+		resp.Members[i] = MemberCompletionResponse{
+			MemberID:        m.ID.String(),
+			DisplayName:     m.DisplayName,
+			CompletedAt:     m.CompletedAt,
+			MissingRequired: completion.MissingRequired[m.ID],
+			Sections:        make([]SectionProgress, len(completion.Sections)),
+		}
+		if m.CompletedAt != nil {
+			resp.Submitted++
+		}
+	}
+
+	for i, sc := range completion.Sections {
+		section := SectionCompletionResponse{Questions: sc.QuestionCount}
+		if sc.Section != nil {
+			id := sc.Section.ID.String()
+			section.SectionID = &id
+			section.Title = sc.Section.Title
+		}
+		for j, m := range completion.Members {
+			progress := newProgress(sc.Answered[m.ID], sc.QuestionCount)
+			if progress.Complete {
+				section.MembersCompleted++
+			}
+			resp.Members[j].Sections[i] = SectionProgress{SectionID: section.SectionID, MembershipProgress: progress}
+		}
+		resp.Sections[i] = section
+	}
+	return resp
+}
+
+// getQuestionnaireSections returns the sections of a questionnaire in order
+// @Summary Get questionnaire sections
+// @Description Get the sections of a questionnaire in their order. The owner and the members can list them.
+// @Tags sections
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Success 200 {array} SectionResponse "Sections in order"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/sections [get]
+func (s *Server) getQuestionnaireSections(c echo.Context) error {
+	qID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	ctx := c.Request().Context()
+	questionnaire, err := s.service.GetQuestionnaireWithDetails(qID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	userID, _ := uuid.Parse(entityIDStr)
+	isOwner := entityType == "user" && questionnaire.Edges.Owner.ID == userID
+	isMember := false
+
+	switch entityType {
+	case "user":
+		_, err := s.service.GetMemberByUserAndQuestionnaire(userID, qID, ctx)
+		isMember = err == nil
+	case "member":
+		member, err := s.service.GetMemberWithQuestionnaire(userID, ctx)
+		isMember = err == nil && member.Edges.Questionnaire.ID == qID
+	}
+
+	if !isOwner && !isMember {
+		return problem(403, "forbidden")
+	}
+
+	sections, err := s.service.GetQuestionnaireSections(qID, ctx)
+	if err != nil {
+		GetLogger(c).Error("failed to get questionnaire sections", zap.String("questionnaire_id", qID.String()), zap.Error(err))
+		return problem(500, "could not get sections")
+	}
+
+	return c.JSON(200, mapAll(sections, newSectionResponse))
+}
+
+// createSection appends a section to a questionnaire
+// @Summary Create section
+// @Description Append a section to a questionnaire (only owner can do this)
+// @Tags sections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param section body SectionRequest true "Section data"
+// @Success 201 {object} SectionResponse "Section created successfully"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can create sections"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/sections [post]
+func (s *Server) createSection(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	sr := new(SectionRequest)
+	if err := BindAndValidate(c, sr); err != nil {
+		return err
+	}
+	sr.Sanitize()
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to add sections to this questionnaire")
+	}
+
+	section, err := s.service.CreateSection(questionnaireUUID, sr.Title, sr.Description, ctx)
+	if err != nil {
+		GetLogger(c).Error("Could not create section", zap.String("questionnaire_id", questionnaireUUID.String()), zap.Error(err))
+		return problem(500, "could not create section")
+	}
+
+	return c.JSON(201, newSectionResponse(section))
+}
+
+// updateSection updates the title and description of a section
+// @Summary Update section
+// @Description Update a section's title and description (only owner and only if questionnaire not published)
+// @Tags sections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param questionnaireId path string true "Questionnaire ID"
+// @Param sectionId path string true "Section ID"
+// @Param section body SectionRequest true "Updated section data"
+// @Success 200 {object} SectionResponse "Section updated successfully"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can update or questionnaire is published"
+// @Failure 404 {object} Problem "Section or questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{questionnaireId}/sections/{sectionId} [put]
+func (s *Server) updateSection(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("questionnaireId"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	sectionUUID, err := uuid.Parse(c.Param("sectionId"))
+	if err != nil {
+		return problem(400, "invalid section ID")
+	}
+
+	sr := new(SectionRequest)
+	if err := BindAndValidate(c, sr); err != nil {
+		return err
+	}
+	sr.Sanitize()
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to update sections in this questionnaire")
+	}
+
+	if q.IsPublished {
+		return problem(403, "cannot update sections in published questionnaire").withCode(CodeQuestionnairePublished)
+	}
+
+	section, err := s.service.GetSectionWithQuestionnaire(sectionUUID, ctx)
+	if err != nil {
+		return problem(404, "section not found")
+	}
+
+	if section.Edges.Questionnaire.ID != questionnaireUUID {
+		return problem(400, "section does not belong to specified questionnaire")
+	}
+
+	updated, err := s.service.UpdateSection(sectionUUID, sr.Title, sr.Description, ctx)
+	if err != nil {
+		return problem(500, "could not update section")
+	}
+
+	return c.JSON(200, newSectionResponse(updated))
+}
+
+// deleteSection deletes a section and keeps its questions outside any section
+// @Summary Delete section
+// @Description Delete a section of a questionnaire. Its questions are kept, outside any section (only owner and only if questionnaire not published)
+// @Tags sections
+// @Produce json
+// @Security BearerAuth
+// @Param questionnaireId path string true "Questionnaire ID"
+// @Param sectionId path string true "Section ID"
+// @Success 200 {object} map[string]string "Section deleted successfully"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can delete or questionnaire is published"
+// @Failure 404 {object} Problem "Section or questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{questionnaireId}/sections/{sectionId} [delete]
+func (s *Server) deleteSection(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("questionnaireId"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	sectionUUID, err := uuid.Parse(c.Param("sectionId"))
+	if err != nil {
+		return problem(400, "invalid section ID")
+	}
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to delete sections in this questionnaire")
+	}
+
+	if q.IsPublished {
+		return problem(403, "cannot delete sections in published questionnaire").withCode(CodeQuestionnairePublished)
+	}
+
+	section, err := s.service.GetSectionWithQuestionnaire(sectionUUID, ctx)
+	if err != nil {
+		return problem(404, "section not found")
+	}
+
+	if section.Edges.Questionnaire.ID != questionnaireUUID {
+		return problem(400, "section does not belong to specified questionnaire")
+	}
+
+	if err := s.service.DeleteSection(sectionUUID, ctx); err != nil {
+		return problem(500, "could not delete section")
+	}
+
+	return c.JSON(200, map[string]string{"message": "section deleted successfully"})
+}
+
+// reorderSections rewrites the order of the sections of a questionnaire
+// @Summary Reorder sections
+// @Description Set the order of the sections of a questionnaire in one step (only owner and only if not published). section_ids must list every section of the questionnaire exactly once.
+// @Tags sections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param order body ReorderSectionsRequest true "Section IDs in their new order"
+// @Success 200 {array} SectionResponse "Sections in their new order"
+// @Failure 400 {object} Problem "Bad request or incomplete order"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden - only owner can reorder or questionnaire is published"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/sections/order [put]
+func (s *Server) reorderSections(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	questionnaireUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	rs := new(ReorderSectionsRequest)
+	if err := BindAndValidate(c, rs); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(questionnaireUUID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "not authorized to reorder sections in this questionnaire")
+	}
+
+	if q.IsPublished {
+		return problem(403, "cannot reorder sections in published questionnaire").withCode(CodeQuestionnairePublished)
+	}
+
+	sections, err := s.service.ReorderSections(questionnaireUUID, rs.SectionIDs, ctx)
+	if errors.Is(err, database.ErrInvalidSectionOrder) {
+		return err
+	}
+	if err != nil {
+		GetLogger(c).Error("Could not reorder sections", zap.String("questionnaire_id", questionnaireUUID.String()), zap.Error(err))
+		return problem(500, "could not reorder sections")
+	}
+
+	return c.JSON(200, mapAll(sections, newSectionResponse))
+}
+
+// getQuestionnaireCompletion breaks down the progress of the members per section
+// @Summary Get completion per section
+// @Description Get, for every section, how many members answered all its questions, and the progress of each member section by section. Questions outside any section are reported last with a null section_id. Each member also carries when they submitted the questionnaire and how many required questions they still have to answer. Only the owner can see it.
+// @Tags sections
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param If-None-Match header string false "ETag of a previous response"
+// @Success 200 {object} CompletionResponse "Completion per section"
+// @Success 304 "Not modified since the If-None-Match tag"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/completion [get]
+func (s *Server) getQuestionnaireCompletion(c echo.Context) error {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	qID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	ctx := c.Request().Context()
+
+	q, err := s.service.GetQuestionnaire(qID, ctx)
+	if err != nil {
+		return problem(404, "questionnaire not found")
+	}
+
+	u, err := q.QueryOwner().Only(ctx)
+	if err != nil {
+		return problem(500, "could not verify questionnaire owner")
+	}
+
+	if u.ID != userID {
+		return problem(403, "forbidden")
+	}
+
+	completion, err := s.service.GetQuestionnaireCompletion(qID, ctx)
+	if err != nil {
+		GetLogger(c).Error("failed to get questionnaire completion", zap.String("questionnaire_id", qID.String()), zap.Error(err))
+		return problem(500, "could not get completion")
+	}
+
+	return conditionalJSON(c, 0, newCompletionResponse(completion))
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+func TestNewCompletionResponse(t *testing.T) {
+	alice := &ent.Member{ID: uuid.New(), DisplayName: "Alice"}
+	bob := &ent.Member{ID: uuid.New(), DisplayName: "Bob"}
+	toppings := &ent.Section{ID: uuid.New(), Title: "Toppings"}
+
+	resp := newCompletionResponse(database.Completion{
+		Members: []*ent.Member{alice, bob},
+		Sections: []database.SectionCompletion{
+			{Section: toppings, QuestionCount: 2, Answered: map[uuid.UUID]int{alice.ID: 2, bob.ID: 1}},
+			{QuestionCount: 1, Answered: map[uuid.UUID]int{bob.ID: 1}},
+		},
+	})
+
+	if len(resp.Sections) != 2 {
+		t.Fatalf("got %d sections, want 2", len(resp.Sections))
+	}
+	if got := resp.Sections[0]; got.SectionID == nil || *got.SectionID != toppings.ID.String() || got.Title != "Toppings" || got.MembersCompleted != 1 {
+		t.Errorf("toppings = %+v", got)
+	}
+	if got := resp.Sections[1]; got.SectionID != nil || got.Questions != 1 || got.MembersCompleted != 1 {
+		t.Errorf("questions outside sections = %+v", got)
+	}
+
+	if len(resp.Members) != 2 {
+		t.Fatalf("got %d members, want 2", len(resp.Members))
+	}
+	if got := resp.Members[0].Sections[0].MembershipProgress; got != (MembershipProgress{Answered: 2, Total: 2, Complete: true}) {
+		t.Errorf("Alice in toppings = %+v", got)
+	}
+	if got := resp.Members[0].Sections[1].MembershipProgress; got != (MembershipProgress{Answered: 0, Total: 1}) {
+		t.Errorf("Alice outside sections = %+v", got)
+	}
+	if got := resp.Members[1].Sections[0].MembershipProgress; got != (MembershipProgress{Answered: 1, Total: 2}) {
+		t.Errorf("Bob in toppings = %+v", got)
+	}
+}
+
+func TestSectionRoutesValidation(t *testing.T) {
+	s := newTestServer(t)
+	questionnaireID := uuid.NewString()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		entityType string
+		body       string
+		want       int
+		wantError  string
+	}{
+		{"create as member", http.MethodPost, "/api/questionnaires/" + questionnaireID + "/sections", "member", `{"title":"Toppings"}`, http.StatusUnauthorized, "unauthorized"},
+		{"create without title", http.MethodPost, "/api/questionnaires/" + questionnaireID + "/sections", "user", `{"title":""}`, http.StatusBadRequest, "title"},
+		{"update invalid id", http.MethodPut, "/api/questionnaires/" + questionnaireID + "/sections/nope", "user", `{"title":"Toppings"}`, http.StatusBadRequest, "invalid section id"},
+		{"reorder empty", http.MethodPut, "/api/questionnaires/" + questionnaireID + "/sections/order", "user", `{"section_ids":[]}`, http.StatusBadRequest, "section_ids"},
+		{"completion as member", http.MethodGet, "/api/questionnaires/" + questionnaireID + "/completion", "member", ``, http.StatusUnauthorized, "unauthorized"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), tt.entityType))
+		resp := httptest.NewRecorder()
+		s.Handler().ServeHTTP(resp, req)
+		if resp.Code != tt.want {
+			t.Errorf("%s: %s %s = %d %s, want %d", tt.name, tt.method, tt.path, resp.Code, resp.Body, tt.want)
+		}
+		if !strings.Contains(strings.ToLower(resp.Body.String()), tt.wantError) {
+			t.Errorf("%s: body %s does not mention %q", tt.name, resp.Body, tt.wantError)
+		}
+	}
+}
@@ -2,15 +2,23 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
-	"os"
-	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	_ "github.com/joho/godotenv/autoload"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
+	"radgifa/internal/config"
 	"radgifa/internal/database"
+	"radgifa/internal/graph"
+	"radgifa/internal/lifecycle"
+	"radgifa/internal/logging"
+	"radgifa/internal/webhook"
 )
 
 type Server struct {
@@ -18,28 +26,309 @@ type Server struct {
 
 	service    database.Service
 	kvmanager  KVManager
+	logger     *zap.Logger
 	httpServer *http.Server
+	handler    http.Handler
+
+	signingKeys       [][]byte
+	routePrefix       string
+	requestsPerSecond rate.Limit
+	idempotencyTTL    time.Duration
+	idempotencyMu     sync.Mutex
+	legacySunset      time.Time
+
+	logLevel    *zap.AtomicLevel
+	adminToken  string
+	serviceName string
+
+	diskPath     string
+	minFreeDisk  uint64
+	shuttingDown atomic.Bool
+	drainDelay   time.Duration
+
+	lifecycle  *lifecycle.Manager
+	ownsStores bool
+
+	graphql http.Handler
+
+	webhooks *webhook.Dispatcher
+}
+
+// Option configures a Server built with New
+type Option func(*Server)
+
+// WithService sets the data access service used by every handler
+func WithService(service database.Service) Option {
+	return func(s *Server) {
+		s.service = service
+	}
+}
+
+// WithKVManager sets the key-value store used for invitation tokens
+func WithKVManager(kvmanager KVManager) Option {
+	return func(s *Server) {
+		s.kvmanager = kvmanager
+	}
+}
+
+// WithLogger sets the logger used for request and handler logs
+func WithLogger(logger *zap.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithLogLevel exposes the level of the logger on /admin/log-level, so it
+// can be changed at runtime
+func WithLogLevel(level zap.AtomicLevel) Option {
+	return func(s *Server) {
+		s.logLevel = &level
+	}
+}
+
+// WithServiceName names the server in the spans of the HTTP requests,
+// "radgifa" by default
+func WithServiceName(name string) Option {
+	return func(s *Server) {
+		s.serviceName = name
+	}
 }
 
-func NewServer() *Server {
-	port, _ := strconv.Atoi(os.Getenv("PORT"))
+// WithAdminToken enables the /admin endpoints for requests bearing token
+func WithAdminToken(token string) Option {
+	return func(s *Server) {
+		s.adminToken = token
+	}
+}
+
+// WithSigningKeys sets the HS256 keys for JWTs. The first key signs new
+// tokens and every key is accepted when verifying, which allows rotation
+func WithSigningKeys(keys ...[]byte) Option {
+	return func(s *Server) {
+		s.signingKeys = keys
+	}
+}
+
+// WithRoutePrefix mounts every route under prefix, e.g. "/radgifa"
+func WithRoutePrefix(prefix string) Option {
+	return func(s *Server) {
+		s.routePrefix = prefix
+	}
+}
+
+// WithRequestsPerSecond sets the rate limit applied to register and login
+func WithRequestsPerSecond(limit rate.Limit) Option {
+	return func(s *Server) {
+		s.requestsPerSecond = limit
+	}
+}
+
+// WithIdempotencyTTL sets how long the responses of requests bearing an
+// Idempotency-Key are replayed to retries
+func WithIdempotencyTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.idempotencyTTL = ttl
+	}
+}
+
+// WithLegacySunset sets the date announced in the Sunset header of the
+// deprecated unversioned routes. The zero time leaves the header out.
+func WithLegacySunset(sunset time.Time) Option {
+	return func(s *Server) {
+		s.legacySunset = sunset
+	}
+}
+
+// WithDiskCheck makes /readyz fail when less than minFreeBytes are
+// available on the filesystem holding path
+func WithDiskCheck(path string, minFreeBytes uint64) Option {
+	return func(s *Server) {
+		s.diskPath = path
+		s.minFreeDisk = minFreeBytes
+	}
+}
+
+// WithDrainDelay makes ShutdownHTTP fail /readyz for d before it closes the
+// listeners, so load balancers see the server leave before it refuses
+// connections
+func WithDrainDelay(d time.Duration) Option {
+	return func(s *Server) {
+		s.drainDelay = d
+	}
+}
+
+// WithLifecycle registers the server with m, so it is stopped by
+// m.Shutdown together with the rest of the application
+func WithLifecycle(m *lifecycle.Manager) Option {
+	return func(s *Server) {
+		s.lifecycle = m
+	}
+}
+
+// WithWebhookDispatcher runs d, which sends the webhook deliveries, until
+// the server shuts down
+func WithWebhookDispatcher(d *webhook.Dispatcher) Option {
+	return func(s *Server) {
+		s.webhooks = d
+	}
+}
+
+// withOwnedStores makes the server responsible for closing the logger, the
+// service and the KV manager it was given
+func withOwnedStores() Option {
+	return func(s *Server) {
+		s.ownsStores = true
+	}
+}
+
+// WithPort sets the port used by ListenAndServe
+func WithPort(port int) Option {
+	return func(s *Server) {
+		s.port = port
+	}
+}
+
+// New builds a Server from the given options. The service, the KV manager
+// and at least one signing key are required.
+func New(opts ...Option) (*Server, error) {
 	newServer := &Server{
-		port: port,
+		logger:            zap.NewNop(),
+		requestsPerSecond: defaultRequestsPerSecond,
+		idempotencyTTL:    defaultIdempotencyTTL,
+		serviceName:       defaultServiceName,
+	}
+	for _, opt := range opts {
+		opt(newServer)
+	}
 
-		service:   database.New(),
-		kvmanager: NewKVManager(),
+	if newServer.service == nil {
+		return nil, errors.New("server: a database service is required")
+	}
+	if newServer.kvmanager == nil {
+		return nil, errors.New("server: a KV manager is required")
+	}
+	if len(newServer.signingKeys) == 0 {
+		return nil, errors.New("server: at least one signing key is required")
+	}
+	for i, key := range newServer.signingKeys {
+		if len(key) == 0 {
+			return nil, fmt.Errorf("server: signing key %d is empty", i)
+		}
+	}
+	if newServer.logger == nil {
+		newServer.logger = zap.NewNop()
+	}
+	if newServer.serviceName == "" {
+		newServer.serviceName = defaultServiceName
+	}
+	if newServer.requestsPerSecond <= 0 {
+		return nil, errors.New("server: requests per second must be positive")
 	}
+	newServer.routePrefix = strings.TrimRight(newServer.routePrefix, "/")
+	if newServer.routePrefix != "" && !strings.HasPrefix(newServer.routePrefix, "/") {
+		return nil, fmt.Errorf("server: route prefix %q must start with /", newServer.routePrefix)
+	}
+
+	// Built before serving: the handler installs its restrictions on the
+	// ent client of the service
+	newServer.graphql = graph.NewHandler(newServer.service, newServer.logger, graphValidator{NewValidator()})
+	newServer.handler = newServer.RegisterRoutes()
 
 	// Declare Server config
 	newServer.httpServer = &http.Server{
 		Addr:         fmt.Sprintf(":%d", newServer.port),
-		Handler:      newServer.RegisterRoutes(),
+		Handler:      newServer.handler,
 		IdleTimeout:  time.Minute,
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 30 * time.Second,
 	}
 
-	return newServer
+	if newServer.lifecycle == nil {
+		newServer.lifecycle = lifecycle.New()
+	}
+	newServer.registerComponents()
+
+	return newServer, nil
+}
+
+// registerComponents registers what the server stops on shutdown. The
+// stores come first so they are closed after the HTTP server has drained.
+func (s *Server) registerComponents() {
+	if s.ownsStores {
+		s.lifecycle.Register("logger", func(context.Context) error {
+			// Syncing a console sink fails on most platforms, it is not worth reporting
+			_ = s.logger.Sync()
+			return nil
+		})
+		s.lifecycle.RegisterCloser("database", s.service.Close)
+		s.lifecycle.RegisterCloser("kv", s.kvmanager.Close)
+		if kv, ok := s.kvmanager.(kvCollector); ok {
+			s.lifecycle.Register("kv-gc", kv.StopGC)
+		}
+	}
+	if s.webhooks != nil {
+		// Stopped once the HTTP server no longer queues deliveries
+		s.lifecycle.Go("webhooks", s.webhooks.Run)
+	}
+	s.lifecycle.Register("http", s.ShutdownHTTP)
+}
+
+// NewServer builds the standalone server from the application configuration,
+// opening the database and the KV store it describes. The server closes them
+// on Shutdown. Extra options, such as WithLifecycle, are applied last.
+func NewServer(cfg *config.Config, opts ...Option) (*Server, error) {
+	logger, level, err := logging.New(cfg.Log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up logging: %w", err)
+	}
+
+	service, err := database.Open(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	kvmanager, err := OpenKVManager(cfg.KV.StoragePath)
+	if err != nil {
+		service.Close()
+		return nil, fmt.Errorf("failed to open KV store: %w", err)
+	}
+
+	baseOpts := []Option{
+		WithPort(cfg.Server.Port),
+		WithService(service),
+		WithKVManager(kvmanager),
+		WithLogger(logger),
+		WithLogLevel(level),
+		WithAdminToken(cfg.Auth.AdminToken),
+		WithServiceName(cfg.Tracing.ServiceName),
+		WithDrainDelay(cfg.Server.ShutdownDrain()),
+		WithSigningKeys([]byte(cfg.Auth.JWTSecret)),
+		WithRequestsPerSecond(rate.Limit(cfg.Server.RequestsPerSecond)),
+		WithIdempotencyTTL(cfg.Server.IdempotencyTTL()),
+		WithLegacySunset(cfg.Server.LegacySunset()),
+		WithRoutePrefix(cfg.Server.RoutePrefix),
+		WithDiskCheck(cfg.KV.StoragePath, uint64(cfg.KV.MinFreeMB)<<20),
+		WithWebhookDispatcher(webhook.New(service, logger.Named("webhook"), webhook.Options{
+			PollInterval:         time.Duration(cfg.Webhooks.PollIntervalSeconds) * time.Second,
+			Timeout:              time.Duration(cfg.Webhooks.TimeoutSeconds) * time.Second,
+			MaxAttempts:          cfg.Webhooks.MaxAttempts,
+			RetryDelay:           time.Duration(cfg.Webhooks.RetryDelaySeconds) * time.Second,
+			AllowPrivateNetworks: cfg.Webhooks.AllowPrivateNetworks,
+		})),
+		withOwnedStores(),
+	}
+	opts = append(baseOpts, opts...)
+	newServer, err := New(opts...)
+	if err != nil {
+		kvmanager.Close()
+		service.Close()
+		return nil, err
+	}
+	return newServer, nil
+}
+
+// Handler returns the HTTP handler serving every route
+func (s *Server) Handler() http.Handler {
+	return s.handler
 }
 
 // ListenAndServe starts the HTTP server
@@ -47,15 +336,25 @@ func (s *Server) ListenAndServe() error {
 	return s.httpServer.ListenAndServe()
 }
 
-// ShutdownHTTP gracefully shuts down the HTTP server
+// ShutdownHTTP makes /readyz fail, waits for the drain delay while still
+// serving, then gracefully shuts down the HTTP server. The deadline of ctx
+// cuts the delay short.
 func (s *Server) ShutdownHTTP(ctx context.Context) error {
+	s.shuttingDown.Store(true)
+	if s.drainDelay > 0 {
+		s.logger.Info("draining before shutdown", zap.Duration("delay", s.drainDelay))
+		timer := time.NewTimer(s.drainDelay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}
 	return s.httpServer.Shutdown(ctx)
 }
 
-// Shutdown gracefully shuts down the server and closes all resources
-func (s *Server) Shutdown() error {
-	if err := s.kvmanager.Close(); err != nil {
-		return fmt.Errorf("failed to close KVManager: %w", err)
-	}
-	return nil
+// Shutdown stops every component registered with the server's lifecycle
+// manager before the deadline of ctx, and reports those that failed
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.lifecycle.Shutdown(ctx).Err()
 }
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type recordingService struct {
+	stubService
+	stopped *[]string
+}
+
+func (s recordingService) Close() error {
+	*s.stopped = append(*s.stopped, "database")
+	return nil
+}
+
+type recordingKV struct {
+	stubKV
+	stopped *[]string
+}
+
+func (kv recordingKV) StopGC(context.Context) error {
+	*kv.stopped = append(*kv.stopped, "kv-gc")
+	return nil
+}
+
+func (kv recordingKV) Close() error {
+	*kv.stopped = append(*kv.stopped, "kv")
+	return nil
+}
+
+func TestShutdownOrder(t *testing.T) {
+	var stopped []string
+	s := newTestServer(t,
+		WithService(recordingService{stopped: &stopped}),
+		WithKVManager(recordingKV{stopped: &stopped}),
+		withOwnedStores(),
+	)
+
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if !s.shuttingDown.Load() {
+		t.Error("the HTTP server was not shut down")
+	}
+	if got := strings.Join(stopped, ","); got != "kv-gc,kv,database" {
+		t.Errorf("stop order = %s, want kv-gc,kv,database", got)
+	}
+}
+
+func TestShutdownLeavesBorrowedStores(t *testing.T) {
+	var stopped []string
+	s := newTestServer(t,
+		WithService(recordingService{stopped: &stopped}),
+		WithKVManager(recordingKV{stopped: &stopped}),
+	)
+
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if len(stopped) != 0 {
+		t.Errorf("Shutdown() closed %v it does not own", stopped)
+	}
+}
+
+// testToken signs a token for the given entity with the server's key
+func testToken(t *testing.T, s *Server, entityID uuid.UUID, entityType string) string {
+	t.Helper()
+	claims := &JWTClaims{
+		EntityId:   entityID.String(),
+		EntityType: entityType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.signingKey())
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return token
+}
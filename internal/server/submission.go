@@ -0,0 +1,86 @@
+package server
+
+import (
+	"errors"
+
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// SubmitResponse is returned once a member submitted a questionnaire
+type SubmitResponse struct {
+	MemberID    string `json:"member_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompletedAt int64  `json:"completed_at" example:"1700000000000"`
+}
+
+// submitQuestionnaire marks the authenticated member as done
+// @Summary Submit a questionnaire
+// @Description Mark the authenticated member as done with the questionnaire. Every required question the member sees must be answered. Changing an answer afterwards clears the submission, or is refused when the questionnaire locks answers after submission.
+// @Tags questionnaires
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Success 200 {object} SubmitResponse "Questionnaire submitted"
+// @Failure 400 {object} Problem "Required questions not answered, listed in missing_question_ids"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Not a member of this questionnaire"
+// @Failure 409 {object} Problem "Already submitted and answers are locked"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/questionnaires/{id}/submit [post]
+func (s *Server) submitQuestionnaire(c echo.Context) error {
+	qID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid questionnaire ID")
+	}
+
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil {
+		return problem(401, "unauthorized, invalid token")
+	}
+
+	ctx := c.Request().Context()
+	var memberID uuid.UUID
+
+	switch entityType {
+	case "user":
+		userID, _ := uuid.Parse(entityIDStr)
+		member, err := s.service.GetMemberByUserAndQuestionnaire(userID, qID, ctx)
+		if err != nil {
+			return problem(404, "not a member of this questionnaire")
+		}
+		memberID = member.ID
+	case "member":
+		memberID, _ = uuid.Parse(entityIDStr)
+		member, err := s.service.GetMemberWithQuestionnaire(memberID, ctx)
+		if err != nil || member.Edges.Questionnaire.ID != qID {
+			return problem(403, "forbidden")
+		}
+	default:
+		return problem(401, "invalid token type")
+	}
+
+	member, err := s.service.SubmitQuestionnaire(memberID, ctx)
+	if errors.Is(err, database.ErrMissingRequiredAnswers) {
+		// Rendered with the missing_question_ids
+		return err
+	}
+	if errors.Is(err, database.ErrQuestionnaireClosed) {
+		return err
+	}
+	if errors.Is(err, database.ErrAnswersLocked) {
+		return problem(409, "already submitted, answers are locked").withCode(database.ErrAnswersLocked.Code)
+	}
+	if err != nil {
+		GetLogger(c).Error("failed to submit questionnaire",
+			zap.String("member_id", memberID.String()),
+			zap.String("questionnaire_id", qID.String()),
+			zap.Error(err))
+		return problem(500, "could not submit questionnaire")
+	}
+
+	return c.JSON(200, SubmitResponse{MemberID: member.ID.String(), CompletedAt: *member.CompletedAt})
+}
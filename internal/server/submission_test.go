@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type submitService struct {
+	stubService
+	questionnaireID uuid.UUID
+	err             error
+}
+
+func (s *submitService) GetMemberWithQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	m := &ent.Member{ID: memberID}
+	m.Edges.Questionnaire = &ent.Questionnaire{ID: s.questionnaireID}
+	return m, nil
+}
+
+func (s *submitService) SubmitQuestionnaire(memberID uuid.UUID, ctx context.Context) (*ent.Member, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	completedAt := time.Now().UnixMilli()
+	return &ent.Member{ID: memberID, CompletedAt: &completedAt}, nil
+}
+
+func TestSubmitQuestionnaire(t *testing.T) {
+	missing := uuid.New()
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"submitted", nil, http.StatusOK},
+		{"missing required answers", &database.MissingAnswersError{QuestionIDs: []uuid.UUID{missing}}, http.StatusBadRequest},
+		{"locked", database.ErrAnswersLocked, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qID := uuid.New()
+			s := newTestServer(t, WithService(&submitService{questionnaireID: qID, err: tt.err}))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/questionnaires/"+qID.String()+"/submit", nil)
+			req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "member"))
+			resp := httptest.NewRecorder()
+			s.Handler().ServeHTTP(resp, req)
+			if resp.Code != tt.want {
+				t.Fatalf("POST submit = %d %s, want %d", resp.Code, resp.Body, tt.want)
+			}
+
+			if tt.want == http.StatusBadRequest {
+				var body Problem
+				if err := json.Unmarshal(resp.Body.Bytes(), &body); err != nil {
+					t.Fatal(err)
+				}
+				if len(body.MissingQuestionIDs) != 1 || body.MissingQuestionIDs[0] != missing.String() {
+					t.Errorf("missing_question_ids = %v, want [%s]", body.MissingQuestionIDs, missing)
+				}
+			}
+		})
+	}
+}
+
+func TestSubmitOtherQuestionnaire(t *testing.T) {
+	s := newTestServer(t, WithService(&submitService{questionnaireID: uuid.New()}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/questionnaires/"+uuid.NewString()+"/submit", nil)
+	req.Header.Set("Authorization", "Bearer "+testToken(t, s, uuid.New(), "member"))
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, req)
+	if resp.Code != http.StatusForbidden {
+		t.Fatalf("submitting another questionnaire = %d %s, want 403", resp.Code, resp.Body)
+	}
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/microcosm-cc/bluemonday"
+	"go.uber.org/zap"
+)
+
+// CodeInsufficientScope is the code of the problem refusing a personal
+// access token a route outside its scopes
+const CodeInsufficientScope = "insufficient_scope"
+
+// CreateAccessTokenRequest creates a personal access token
+type CreateAccessTokenRequest struct {
+	Name   string   `json:"name" validate:"required,max=100,no_whitespace_only" example:"nightly export"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=read questionnaires:write answers:write" example:"read"`
+	// Unix milliseconds, the token never expires when left out
+	ExpiresAt *int64 `json:"expires_at,omitempty" example:"1767225600000"`
+}
+
+func (r *CreateAccessTokenRequest) Sanitize() {
+	r.Name = strings.TrimSpace(bluemonday.StrictPolicy().Sanitize(r.Name))
+}
+
+// AccessTokenResponse describes a personal access token, without the token
+type AccessTokenResponse struct {
+	ID         string   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name       string   `json:"name" example:"nightly export"`
+	Prefix     string   `json:"prefix" example:"rgf_Xk3v9Q"`
+	Scopes     []string `json:"scopes" example:"read"`
+	CreatedAt  int64    `json:"created_at" example:"1735689600000"`
+	ExpiresAt  *int64   `json:"expires_at" example:"1767225600000"`
+	LastUsedAt *int64   `json:"last_used_at" example:"1735776000000"`
+}
+
+// CreatedAccessTokenResponse is a new personal access token. Token is only
+// sent this once.
+type CreatedAccessTokenResponse struct {
+	AccessTokenResponse
+	Token string `json:"token" example:"rgf_Xk3v9Q..."`
+}
+
+func newAccessTokenResponse(t *ent.AccessToken) AccessTokenResponse {
+	return AccessTokenResponse{
+		ID:         t.ID.String(),
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		Scopes:     t.Scopes,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+	}
+}
+
+// parseAccessToken authenticates a personal access token as a token of its
+// user carrying its scopes, so the handlers need not tell it from a session
+func (s *Server) parseAccessToken(c echo.Context, raw string) (_ *jwt.Token, err error) {
+	ctx, span := startTokenSpan(c.Request().Context(), "access_token")
+	defer func() { endTokenSpan(span, err) }()
+
+	found, err := s.service.AuthenticateAccessToken(raw, ctx)
+	if err != nil {
+		if !errors.Is(err, database.ErrInvalidAccessToken) {
+			GetLogger(c).Error("failed to authenticate access token", zap.Error(err))
+		}
+		// Answered with a 401 like an invalid JWT, whatever went wrong
+		return nil, errors.New("invalid token")
+	}
+	return &jwt.Token{
+		Valid: true,
+		Claims: jwt.MapClaims{
+			"entity_id": found.Edges.User.ID.String(),
+			"type":      "user",
+			"token_id":  found.ID.String(),
+			"scopes":    found.Scopes,
+		},
+	}, nil
+}
+
+// tokenScopes returns the scopes of the personal access token of the
+// request, ok false for a session, which has them all
+func tokenScopes(c echo.Context) (scopes []string, ok bool) {
+	token, _ := c.Get("user").(*jwt.Token)
+	if token == nil {
+		return nil, false
+	}
+	claims, _ := token.Claims.(jwt.MapClaims)
+	scopes, ok = claims["scopes"].([]string)
+	return scopes, ok
+}
+
+// requireScope refuses the personal access tokens without scope
+func requireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if scopes, ok := tokenScopes(c); ok && !slices.Contains(scopes, scope) {
+				return problem(403, "the access token lacks the scope "+scope).withCode(CodeInsufficientScope)
+			}
+			return next(c)
+		}
+	}
+}
+
+// sessionOnly refuses the personal access tokens, so a leaked one cannot
+// mint others
+func sessionOnly(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if _, ok := tokenScopes(c); ok {
+			return problem(403, "access tokens cannot be managed with an access token").withCode(CodeInsufficientScope)
+		}
+		return next(c)
+	}
+}
+
+// sessionUser returns the ID of the user of the request
+func sessionUser(c echo.Context) (uuid.UUID, error) {
+	entityIDStr, entityType, err := GetValuesFromToken(c)
+	if err != nil || entityType != "user" {
+		return uuid.Nil, problem(401, "unauthorized, invalid token")
+	}
+	userID, err := uuid.Parse(entityIDStr)
+	if err != nil {
+		return uuid.Nil, problem(401, "unauthorized, invalid token")
+	}
+	return userID, nil
+}
+
+// createAccessToken creates a personal access token
+// @Summary Create personal access token
+// @Description Create a named token for scripts, sent as a bearer token like a session token. It only reaches
+// @Description the routes of its scopes: read (every GET and GraphQL queries), questionnaires:write (editing,
+// @Description publishing and inviting) and answers:write (answering and submitting). The token is only returned
+// @Description by this call. Access tokens cannot manage access tokens.
+// @Tags tokens
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param token body CreateAccessTokenRequest true "Token to create"
+// @Success 201 {object} CreatedAccessTokenResponse
+// @Failure 400 {object} Problem "Invalid request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Called with an access token"
+// @Router /api/v1/tokens [post]
+func (s *Server) createAccessToken(c echo.Context) error {
+	userID, err := sessionUser(c)
+	if err != nil {
+		return err
+	}
+	req := new(CreateAccessTokenRequest)
+	if err := BindAndValidate(c, req); err != nil {
+		return err
+	}
+	if req.ExpiresAt != nil && *req.ExpiresAt <= time.Now().UnixMilli() {
+		return problem(400, "expires_at must be in the future").withCode(CodeInvalidParameter)
+	}
+
+	created, token, err := s.service.CreateAccessToken(userID, req.Name, req.Scopes, req.ExpiresAt, c.Request().Context())
+	if err != nil {
+		GetLogger(c).Error("failed to create access token",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		return problem(500, "could not create access token")
+	}
+	return c.JSON(201, CreatedAccessTokenResponse{
+		AccessTokenResponse: newAccessTokenResponse(created),
+		Token:               token,
+	})
+}
+
+// listAccessTokens lists the personal access tokens of the user
+// @Summary List personal access tokens
+// @Description List the personal access tokens of the user, newest first, without the tokens themselves
+// @Tags tokens
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} AccessTokenResponse
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Called with an access token"
+// @Router /api/v1/tokens [get]
+func (s *Server) listAccessTokens(c echo.Context) error {
+	userID, err := sessionUser(c)
+	if err != nil {
+		return err
+	}
+	tokens, err := s.service.ListAccessTokens(userID, c.Request().Context())
+	if err != nil {
+		GetLogger(c).Error("failed to list access tokens",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+		return problem(500, "could not list access tokens")
+	}
+	resp := make([]AccessTokenResponse, len(tokens))
+	for i, t := range tokens {
+		resp[i] = newAccessTokenResponse(t)
+	}
+	return c.JSON(200, resp)
+}
+
+// revokeAccessToken revokes a personal access token
+// @Summary Revoke personal access token
+// @Description Delete a personal access token of the user, it stops working at once
+// @Tags tokens
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Token ID"
+// @Success 200 {object} map[string]string "Token revoked"
+// @Failure 400 {object} Problem "Invalid token ID"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Called with an access token"
+// @Failure 404 {object} Problem "Token not found"
+// @Router /api/v1/tokens/{id} [delete]
+func (s *Server) revokeAccessToken(c echo.Context) error {
+	userID, err := sessionUser(c)
+	if err != nil {
+		return err
+	}
+	tokenID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return problem(400, "invalid token ID")
+	}
+	err = s.service.RevokeAccessToken(userID, tokenID, c.Request().Context())
+	if errors.Is(err, database.ErrNotFound) {
+		return problem(404, "access token not found")
+	}
+	if err != nil {
+		return problem(500, "could not revoke access token")
+	}
+	return c.JSON(200, map[string]string{"message": "access token revoked"})
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+// tokenService knows one personal access token, of the given scopes
+type tokenService struct {
+	stubService
+	userID uuid.UUID
+	token  string
+	scopes []string
+}
+
+func (s tokenService) AuthenticateAccessToken(token string, _ context.Context) (*ent.AccessToken, error) {
+	if token != s.token {
+		return nil, database.ErrInvalidAccessToken
+	}
+	found := &ent.AccessToken{ID: uuid.New(), Scopes: s.scopes}
+	found.Edges.User = &ent.User{ID: s.userID}
+	return found, nil
+}
+
+func (s tokenService) CreateAccessToken(userID uuid.UUID, name string, scopes []string, expiresAt *int64, _ context.Context) (*ent.AccessToken, string, error) {
+	return &ent.AccessToken{ID: uuid.New(), Name: name, Prefix: s.token[:10], Scopes: scopes, ExpiresAt: expiresAt}, s.token, nil
+}
+
+func (s tokenService) RevokeAccessToken(userID, tokenID uuid.UUID, _ context.Context) error {
+	return database.ErrAccessTokenNotFound
+}
+
+func tokenRequest(s *Server, method, path, bearer, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+bearer)
+	rec := httptest.NewRecorder()
+	s.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func problemCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var p Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("decoding %s: %v", rec.Body, err)
+	}
+	return p.Code
+}
+
+func TestAccessTokenScopes(t *testing.T) {
+	svc := tokenService{userID: uuid.New(), token: database.AccessTokenPrefix + "readonly-secret", scopes: []string{database.ScopeRead}}
+	s := newTestServer(t, WithService(svc))
+
+	// The handler runs, and refuses the malformed ID
+	if rec := tokenRequest(s, http.MethodGet, "/api/v1/questionnaires/not-a-uuid/completion", svc.token, ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("read with a read token: status = %d, want 400", rec.Code)
+	}
+
+	rec := tokenRequest(s, http.MethodPut, "/api/v1/questionnaires/not-a-uuid", svc.token, `{"title":"Pizza night"}`)
+	if rec.Code != http.StatusForbidden || problemCode(t, rec) != CodeInsufficientScope {
+		t.Errorf("edit with a read token: status = %d, body = %s, want 403 %s", rec.Code, rec.Body, CodeInsufficientScope)
+	}
+
+	rec = tokenRequest(s, http.MethodGet, "/api/v1/tokens", svc.token, "")
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("listing tokens with a token: status = %d, want 403", rec.Code)
+	}
+
+	if rec := tokenRequest(s, http.MethodGet, "/api/v1/questionnaires/not-a-uuid/completion", svc.token+"x", ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("unknown token: status = %d, want 401", rec.Code)
+	}
+}
+
+func TestCreateAccessToken(t *testing.T) {
+	svc := tokenService{token: database.AccessTokenPrefix + "fresh-secret"}
+	s := newTestServer(t, WithService(svc))
+	session := testToken(t, s, uuid.New(), "user")
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"valid", `{"name":"ci","scopes":["read","answers:write"]}`, http.StatusCreated},
+		{"expiring", `{"name":"ci","scopes":["read"],"expires_at":` + jsonInt(time.Now().Add(time.Hour).UnixMilli()) + `}`, http.StatusCreated},
+		{"unknown scope", `{"name":"ci","scopes":["admin"]}`, http.StatusBadRequest},
+		{"no scope", `{"name":"ci","scopes":[]}`, http.StatusBadRequest},
+		{"expired", `{"name":"ci","scopes":["read"],"expires_at":1}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := tokenRequest(s, http.MethodPost, "/api/v1/tokens", session, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, body = %s, want %d", rec.Code, rec.Body, tt.wantStatus)
+			}
+			if rec.Code != http.StatusCreated {
+				return
+			}
+			var created CreatedAccessTokenResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+				t.Fatal(err)
+			}
+			if created.Token != svc.token || created.Name != "ci" {
+				t.Errorf("response = %+v, want the token once", created)
+			}
+		})
+	}
+
+	if rec := tokenRequest(s, http.MethodDelete, "/api/v1/tokens/"+uuid.NewString(), session, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("revoking an unknown token: status = %d, want 404", rec.Code)
+	}
+}
+
+func jsonInt(n int64) string {
+	b, _ := json.Marshal(n)
+	return string(b)
+}
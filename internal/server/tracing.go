@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+
+	"radgifa/internal/database"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// requestIDTracing attaches the request ID generated by middleware.RequestID
+// to the server span and to the baggage, so service spans and downstream
+// calls carry it too
+func requestIDTracing() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+			if requestID == "" {
+				return next(c)
+			}
+
+			req := c.Request()
+			ctx := req.Context()
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("http.request_id", requestID))
+
+			if member, err := baggage.NewMember(database.RequestIDBaggageKey, requestID); err == nil {
+				if bag, err := baggage.FromContext(ctx).SetMember(member); err == nil {
+					c.SetRequest(req.WithContext(baggage.ContextWithBaggage(ctx, bag)))
+				}
+			}
+			return next(c)
+		}
+	}
+}
+
+const tracerName = "radgifa/internal/server"
+
+// startTokenSpan starts the span of parsing a bearer token of the given
+// kind, jwt or access_token. The token itself is never recorded.
+func startTokenSpan(ctx context.Context, kind string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, "auth.ParseToken",
+		trace.WithAttributes(attribute.String("auth.token_kind", kind)))
+}
+
+// endTokenSpan records the outcome of parsing a token and ends its span. A
+// refused token is the caller's fault, so it does not fail the span.
+func endTokenSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetAttributes(attribute.String("auth.outcome", "invalid"), attribute.String("auth.error", err.Error()))
+	} else {
+		span.SetAttributes(attribute.String("auth.outcome", "valid"))
+	}
+	span.End()
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordSpans records the spans ended until the test ends
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+// spanAttribute returns the value of the attribute key of span, empty
+// without it
+func spanAttribute(span sdktrace.ReadOnlySpan, key string) string {
+	for _, attr := range span.Attributes() {
+		if string(attr.Key) == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestRequestIDTracing(t *testing.T) {
+	recorder := recordSpans(t)
+
+	s := newTestServer(t)
+	resp := httptest.NewRecorder()
+	s.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	requestID := resp.Header().Get("X-Request-Id")
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	for _, attr := range spans[0].Attributes() {
+		if attr.Key == "http.request_id" {
+			if attr.Value.AsString() != requestID {
+				t.Errorf("http.request_id = %q, want %q", attr.Value.AsString(), requestID)
+			}
+			return
+		}
+	}
+	t.Errorf("server span has no http.request_id attribute")
+}
+
+func TestTokenParsingSpans(t *testing.T) {
+	svc := tokenService{userID: uuid.New(), token: database.AccessTokenPrefix + "traced-secret", scopes: []string{database.ScopeRead}}
+	s := newTestServer(t, WithService(svc))
+
+	tests := []struct {
+		name    string
+		bearer  string
+		kind    string
+		outcome string
+	}{
+		{"session", testToken(t, s, svc.userID, "user"), "jwt", "valid"},
+		{"forged session", "not-a-jwt", "jwt", "invalid"},
+		{"access token", svc.token, "access_token", "valid"},
+		{"unknown access token", database.AccessTokenPrefix + "unknown", "access_token", "invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := recordSpans(t)
+			tokenRequest(s, http.MethodGet, "/api/v1/tokens", tt.bearer, "")
+
+			var found bool
+			for _, span := range recorder.Ended() {
+				if span.Name() != "auth.ParseToken" {
+					continue
+				}
+				found = true
+				if kind := spanAttribute(span, "auth.token_kind"); kind != tt.kind {
+					t.Errorf("auth.token_kind = %q, want %q", kind, tt.kind)
+				}
+				if outcome := spanAttribute(span, "auth.outcome"); outcome != tt.outcome {
+					t.Errorf("auth.outcome = %q, want %q", outcome, tt.outcome)
+				}
+				if !span.Parent().IsValid() {
+					t.Error("the token span is not a child of the request span")
+				}
+			}
+			if !found {
+				t.Fatal("no auth.ParseToken span recorded")
+			}
+		})
+	}
+}
+
+func TestServiceNameInSpans(t *testing.T) {
+	recorder := recordSpans(t)
+	s := newTestServer(t, WithServiceName("pizza-api"))
+	s.Handler().ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	for _, attr := range spans[0].Attributes() {
+		if attr.Value.Emit() == "pizza-api" {
+			return
+		}
+	}
+	t.Errorf("server span attributes %v do not name the service pizza-api", spans[0].Attributes())
+}
@@ -38,10 +38,10 @@ func (u *NewUserRequest) Sanitize() {
 // @Produce json
 // @Param user body NewUserRequest true "User registration data"
 // @Success 201 {object} map[string]string "User created successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 409 {object} map[string]string "Username already exists"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /register [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 409 {object} Problem "Username already exists"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/register [post]
 func (s *Server) RegisterHandler(c echo.Context) error {
 	nuser := new(NewUserRequest)
 	if err := BindAndValidate(c, nuser); err != nil {
@@ -56,14 +56,13 @@ func (s *Server) RegisterHandler(c echo.Context) error {
 		log.Error("failed to check username availability during registration",
 			zap.String("username", nuser.Username),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not validate username"})
+		return problem(500, "could not validate username")
 	}
 	if !isAvailable {
-		return c.JSON(409, map[string]interface{}{
-			"error": "validation failed",
-			"details": map[string]string{
-				"Username": "Username is already taken",
-			},
+		return validationProblem(409, CodeAlreadyTaken, FieldError{
+			Field:   "username",
+			Code:    "taken",
+			Message: "username is already taken",
 		})
 	}
 
@@ -74,7 +73,7 @@ func (s *Server) RegisterHandler(c echo.Context) error {
 			zap.String("username", nuser.Username),
 			zap.String("name", nuser.Name),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not register user"})
+		return problem(500, "could not register user")
 	}
 
 	return c.JSON(201, map[string]string{"message": "user registered successfully"})
@@ -88,10 +87,10 @@ func (s *Server) RegisterHandler(c echo.Context) error {
 // @Produce json
 // @Param credentials body LoginCredentials true "Login credentials"
 // @Success 200 {object} map[string]string "JWT token"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 401 {object} map[string]string "Invalid credentials"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /login [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Invalid credentials"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/login [post]
 func (s *Server) loginHandler(c echo.Context) error {
 	creds := new(LoginCredentials)
 	if err := BindAndValidate(c, creds); err != nil {
@@ -106,7 +105,8 @@ func (s *Server) loginHandler(c echo.Context) error {
 		log.Warn("user login attempt failed",
 			zap.String("username", creds.Username),
 			zap.Error(err))
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid credentials"})
+		loginFailuresTotal.WithLabelValues("user").Inc()
+		return problem(http.StatusUnauthorized, "invalid credentials").withCode(CodeInvalidCredentials)
 	}
 
 	claims := &JWTClaims{
@@ -119,9 +119,9 @@ func (s *Server) loginHandler(c echo.Context) error {
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	t, err := token.SignedString(jwtSecret)
+	t, err := token.SignedString(s.signingKey())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "could not generate token"})
+		return problem(http.StatusInternalServerError, "could not generate token")
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
@@ -140,9 +140,9 @@ func (s *Server) loginHandler(c echo.Context) error {
 // @Produce json
 // @Param availability body CheckAvailabilityRequest true "Username to check"
 // @Success 200 {object} map[string]interface{} "Username availability status"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 500 {object} map[string]string "Internal server error"
-// @Router /check/username [post]
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 500 {object} Problem "Internal server error"
+// @Router /api/v1/check/username [post]
 func (s *Server) checkUsernameAvailability(c echo.Context) error {
 	req := new(CheckAvailabilityRequest)
 	if err := BindAndValidate(c, req); err != nil {
@@ -156,7 +156,7 @@ func (s *Server) checkUsernameAvailability(c echo.Context) error {
 		log.Error("failed to check username availability",
 			zap.String("username", req.Value),
 			zap.Error(err))
-		return c.JSON(500, map[string]string{"error": "could not check username availability"})
+		return problem(500, "could not check username availability")
 	}
 
 	return c.JSON(200, map[string]interface{}{
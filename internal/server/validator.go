@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"unicode"
@@ -20,30 +21,47 @@ type CustomValidator struct {
 	validator *validator.Validate
 }
 
-// Validate validates structs
+// Validate validates structs. Invalid fields are reported as a Problem whose
+// errors name them as in the JSON body, like "answers[0].question_id".
 func (cv *CustomValidator) Validate(i interface{}) error {
 	if err := cv.validator.Struct(i); err != nil {
 		// Extraer errores de validación específicos
 		if validationErrors, ok := err.(validator.ValidationErrors); ok {
-			errors := make(map[string]string)
-			for _, fieldError := range validationErrors {
-				fieldName := fieldError.Field()
-				errorMessage := getFieldErrorMessage(fieldError)
-				errors[fieldName] = errorMessage
+			errs := make([]FieldError, len(validationErrors))
+			for i, fieldError := range validationErrors {
+				errs[i] = FieldError{
+					Field:   fieldPath(fieldError),
+					Code:    fieldError.Tag(),
+					Message: getFieldErrorMessage(fieldError),
+				}
 			}
-			return echo.NewHTTPError(400, map[string]interface{}{
-				"error":   "validation failed",
-				"details": errors,
-			})
+			return validationProblem(400, CodeValidationFailed, errs...)
 		}
-		return echo.NewHTTPError(400, "validation failed")
+		return problem(400, "validation failed").withCode(CodeValidationFailed)
 	}
 	return nil
 }
 
+// fieldPath is the namespace of the field without the name of the struct
+func fieldPath(fe validator.FieldError) string {
+	_, path, _ := strings.Cut(fe.Namespace(), ".")
+	return path
+}
+
 // NewValidator creates a new validator with custom validation rules
 func NewValidator() *CustomValidator {
 	v := validator.New()
+	// Name fields after their JSON key in errors
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
 
 	// Register custom validations
 	v.RegisterValidation("password_strength", validatePasswordStrength)
@@ -121,8 +139,20 @@ func getFieldErrorMessage(fe validator.FieldError) string {
 	case "required":
 		return fmt.Sprintf("%s is required", fieldName)
 	case "min":
+		if isCollection(fe.Kind()) {
+			return fmt.Sprintf("%s must have at least %s items", fieldName, param)
+		}
+		if isNumber(fe.Kind()) {
+			return fmt.Sprintf("%s must be at least %s", fieldName, param)
+		}
 		return fmt.Sprintf("%s must be at least %s characters", fieldName, param)
 	case "max":
+		if isCollection(fe.Kind()) {
+			return fmt.Sprintf("%s must have at most %s items", fieldName, param)
+		}
+		if isNumber(fe.Kind()) {
+			return fmt.Sprintf("%s must be at most %s", fieldName, param)
+		}
 		return fmt.Sprintf("%s must be at most %s characters", fieldName, param)
 	case "username_format":
 		return fmt.Sprintf("%s can only contain letters, numbers, hyphens and underscores", fieldName)
@@ -137,11 +167,19 @@ func getFieldErrorMessage(fe validator.FieldError) string {
 	}
 }
 
+func isCollection(kind reflect.Kind) bool {
+	return kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map
+}
+
+func isNumber(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Float64
+}
+
 // BindAndValidate is a helper that binds JSON data to a struct, sanitizes it if possible, and validates it
 func BindAndValidate[T any](c echo.Context, data *T) error {
 	// Bind JSON data
 	if err := c.Bind(data); err != nil {
-		return echo.NewHTTPError(400, map[string]string{"error": "invalid request"})
+		return problem(400, "invalid request body")
 	}
 
 	// Sanitize if the type implements Sanitizer interface
@@ -0,0 +1,313 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"radgifa/ent"
+	"radgifa/ent/webhookdelivery"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// CreateWebhookRequest subscribes an URL to events of a questionnaire
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" validate:"required,http_url,max=2000" example:"https://example.com/radgifa"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=member.joined answers.submitted questionnaire.published questionnaire.closed" example:"member.joined"`
+}
+
+func (r *CreateWebhookRequest) Sanitize() {
+	r.URL = strings.TrimSpace(r.URL)
+}
+
+// WebhookResponse is a webhook, without its secret
+type WebhookResponse struct {
+	ID        string   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	URL       string   `json:"url" example:"https://example.com/radgifa"`
+	Events    []string `json:"events" example:"member.joined"`
+	CreatedAt int64    `json:"created_at" example:"1735689600000"`
+}
+
+// CreatedWebhookResponse is a new webhook. Secret, the key of the
+// signatures, is only sent this once.
+type CreatedWebhookResponse struct {
+	WebhookResponse
+	Secret string `json:"secret" example:"whsec_3f9a..."`
+}
+
+// WebhookDeliveryResponse is an event sent, or to send, to a webhook.
+// NextAttemptAt is only set while it is pending, and ResponseStatus is null
+// until a response comes.
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Event          string          `json:"event" example:"member.joined"`
+	Status         string          `json:"status" example:"succeeded" enums:"pending,succeeded,failed"`
+	Attempts       int             `json:"attempts" example:"1"`
+	NextAttemptAt  *int64          `json:"next_attempt_at,omitempty" example:"1735689660000"`
+	LastAttemptAt  *int64          `json:"last_attempt_at" example:"1735689600000"`
+	ResponseStatus *int            `json:"response_status" example:"200"`
+	Error          string          `json:"error,omitempty" example:"unexpected status 503: try later"`
+	CreatedAt      int64           `json:"created_at" example:"1735689600000"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+func newWebhookResponse(w *ent.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:        w.ID.String(),
+		URL:       w.URL,
+		Events:    w.Events,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func newWebhookDeliveryResponse(d *ent.WebhookDelivery) WebhookDeliveryResponse {
+	r := WebhookDeliveryResponse{
+		ID:             d.ID.String(),
+		Event:          d.Event,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt,
+		Payload:        d.Payload,
+	}
+	if d.Status == webhookdelivery.StatusPending {
+		r.NextAttemptAt = &d.NextAttemptAt
+	}
+	return r
+}
+
+// webhookQuestionnaire returns the questionnaire of the id path parameter when
+// the user of the request owns it
+func (s *Server) webhookQuestionnaire(c echo.Context) (*ent.Questionnaire, error) {
+	userID, err := sessionUser(c)
+	if err != nil {
+		return nil, err
+	}
+	questionnaireID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, problem(400, "invalid questionnaire ID")
+	}
+	q, err := s.service.GetQuestionnaire(questionnaireID, c.Request().Context())
+	if err != nil {
+		return nil, problem(404, "questionnaire not found")
+	}
+	if q.Edges.Owner == nil || q.Edges.Owner.ID != userID {
+		return nil, problem(403, "not authorized to manage the webhooks of this questionnaire")
+	}
+	return q, nil
+}
+
+// ownedWebhook returns the webhook of the webhookId path parameter when it
+// belongs to the questionnaire of the id parameter, which the user of the
+// request owns
+func (s *Server) ownedWebhook(c echo.Context) (*ent.Webhook, error) {
+	q, err := s.webhookQuestionnaire(c)
+	if err != nil {
+		return nil, err
+	}
+	webhookID, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		return nil, problem(400, "invalid webhook ID")
+	}
+	hook, err := s.service.GetWebhookWithQuestionnaire(webhookID, c.Request().Context())
+	if errors.Is(err, database.ErrNotFound) || (err == nil && hook.Edges.Questionnaire.ID != q.ID) {
+		return nil, problem(404, "webhook not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// createWebhook subscribes an URL to events of a questionnaire
+// @Summary Create webhook
+// @Description Subscribe an URL to events of a questionnaire: member.joined, answers.submitted,
+// @Description questionnaire.published and questionnaire.closed. Every event is POSTed as JSON with an
+// @Description X-Radgifa-Signature header, "sha256=" and the hex HMAC-SHA256 of the body keyed with the secret,
+// @Description which is only returned by this call. Failed deliveries are retried with an exponential backoff.
+// @Description Only the owner can manage the webhooks.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param webhook body CreateWebhookRequest true "Webhook to create"
+// @Success 201 {object} CreatedWebhookResponse
+// @Failure 400 {object} Problem "Invalid request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Router /api/v1/questionnaires/{id}/webhooks [post]
+func (s *Server) createWebhook(c echo.Context) error {
+	q, err := s.webhookQuestionnaire(c)
+	if err != nil {
+		return err
+	}
+	req := new(CreateWebhookRequest)
+	if err := BindAndValidate(c, req); err != nil {
+		return err
+	}
+
+	hook, err := s.service.CreateWebhook(q.ID, req.URL, req.Events, c.Request().Context())
+	if err != nil {
+		GetLogger(c).Error("failed to create webhook",
+			zap.String("questionnaire_id", q.ID.String()),
+			zap.Error(err))
+		return problem(500, "could not create webhook")
+	}
+	return c.JSON(201, CreatedWebhookResponse{
+		WebhookResponse: newWebhookResponse(hook),
+		Secret:          hook.Secret,
+	})
+}
+
+// listWebhooks lists the webhooks of a questionnaire
+// @Summary List webhooks
+// @Description List the webhooks of a questionnaire, oldest first, without their secrets
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Success 200 {array} WebhookResponse
+// @Failure 400 {object} Problem "Invalid questionnaire ID"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Questionnaire not found"
+// @Router /api/v1/questionnaires/{id}/webhooks [get]
+func (s *Server) listWebhooks(c echo.Context) error {
+	q, err := s.webhookQuestionnaire(c)
+	if err != nil {
+		return err
+	}
+	hooks, err := s.service.ListWebhooks(q.ID, c.Request().Context())
+	if err != nil {
+		GetLogger(c).Error("failed to list webhooks",
+			zap.String("questionnaire_id", q.ID.String()),
+			zap.Error(err))
+		return problem(500, "could not list webhooks")
+	}
+	resp := make([]WebhookResponse, len(hooks))
+	for i, hook := range hooks {
+		resp[i] = newWebhookResponse(hook)
+	}
+	return c.JSON(200, resp)
+}
+
+// deleteWebhook deletes a webhook
+// @Summary Delete webhook
+// @Description Delete a webhook with its delivery log. The deliveries not sent yet are dropped.
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param webhookId path string true "Webhook ID"
+// @Success 200 {object} map[string]string "Webhook deleted"
+// @Failure 400 {object} Problem "Invalid ID"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Webhook not found"
+// @Router /api/v1/questionnaires/{id}/webhooks/{webhookId} [delete]
+func (s *Server) deleteWebhook(c echo.Context) error {
+	hook, err := s.ownedWebhook(c)
+	if err != nil {
+		return err
+	}
+	err = s.service.DeleteWebhook(hook.ID, c.Request().Context())
+	if errors.Is(err, database.ErrNotFound) {
+		return problem(404, "webhook not found")
+	}
+	if err != nil {
+		GetLogger(c).Error("failed to delete webhook",
+			zap.String("webhook_id", hook.ID.String()),
+			zap.Error(err))
+		return problem(500, "could not delete webhook")
+	}
+	return c.JSON(200, map[string]string{"message": "webhook deleted"})
+}
+
+// listWebhookDeliveries returns a page of the delivery log of a webhook
+// @Summary List webhook deliveries
+// @Description Get the deliveries of a webhook, newest first by default, with their payload, attempts and the
+// @Description outcome of the last one
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param webhookId path string true "Webhook ID"
+// @Param limit query int false "Page size, 1 to 100" default(20)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param order query string false "Sort order of the creation date" Enums(asc, desc) default(desc)
+// @Success 200 {object} PageResponse[WebhookDeliveryResponse] "Page of deliveries"
+// @Failure 400 {object} Problem "Bad request"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Webhook not found"
+// @Router /api/v1/questionnaires/{id}/webhooks/{webhookId}/deliveries [get]
+func (s *Server) listWebhookDeliveries(c echo.Context) error {
+	hook, err := s.ownedWebhook(c)
+	if err != nil {
+		return err
+	}
+	pageReq, err := parsePageRequest(c)
+	if err != nil {
+		return err
+	}
+
+	page, err := s.service.ListWebhookDeliveries(hook.ID, pageReq, c.Request().Context())
+	if isPageRequestError(err) {
+		return err
+	}
+	if err != nil {
+		GetLogger(c).Error("failed to list webhook deliveries",
+			zap.String("webhook_id", hook.ID.String()),
+			zap.Error(err))
+		return problem(500, "could not list webhook deliveries")
+	}
+	return c.JSON(200, mapPageResponse(page, newWebhookDeliveryResponse))
+}
+
+// redeliverWebhookDelivery sends a delivery again
+// @Summary Redeliver webhook delivery
+// @Description Queue the payload of a delivery again, whether it succeeded or failed. The new delivery gets
+// @Description its own ID and attempts, the payload keeps the ID of the event.
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Questionnaire ID"
+// @Param webhookId path string true "Webhook ID"
+// @Param deliveryId path string true "Delivery ID"
+// @Success 202 {object} WebhookDeliveryResponse "Delivery queued"
+// @Failure 400 {object} Problem "Invalid ID"
+// @Failure 401 {object} Problem "Unauthorized"
+// @Failure 403 {object} Problem "Forbidden"
+// @Failure 404 {object} Problem "Webhook or delivery not found"
+// @Router /api/v1/questionnaires/{id}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver [post]
+func (s *Server) redeliverWebhookDelivery(c echo.Context) error {
+	hook, err := s.ownedWebhook(c)
+	if err != nil {
+		return err
+	}
+	deliveryID, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		return problem(400, "invalid delivery ID")
+	}
+
+	delivery, err := s.service.RedeliverWebhookDelivery(hook.ID, deliveryID, c.Request().Context())
+	if errors.Is(err, database.ErrNotFound) {
+		return problem(404, "webhook delivery not found")
+	}
+	if err != nil {
+		GetLogger(c).Error("failed to redeliver webhook delivery",
+			zap.String("webhook_id", hook.ID.String()),
+			zap.String("delivery_id", deliveryID.String()),
+			zap.Error(err))
+		return problem(500, "could not redeliver")
+	}
+	return c.JSON(202, newWebhookDeliveryResponse(delivery))
+}
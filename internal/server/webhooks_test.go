@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+// webhookService has one questionnaire, owned by ownerID, with one webhook
+type webhookService struct {
+	stubService
+	ownerID         uuid.UUID
+	questionnaireID uuid.UUID
+	webhookID       uuid.UUID
+	published       bool
+	closed          bool
+}
+
+func (s *webhookService) GetQuestionnaire(questionnaireID uuid.UUID, _ context.Context) (*ent.Questionnaire, error) {
+	if questionnaireID != s.questionnaireID {
+		return nil, database.ErrNotFound
+	}
+	q := &ent.Questionnaire{ID: questionnaireID, IsPublished: s.published}
+	q.Edges.Owner = &ent.User{ID: s.ownerID}
+	return q, nil
+}
+
+func (s *webhookService) CloseQuestionnaire(questionnaireID uuid.UUID, _ context.Context) (*ent.Questionnaire, error) {
+	if s.closed {
+		return nil, database.ErrQuestionnaireClosed
+	}
+	closedAt := int64(1735689600000)
+	q := &ent.Questionnaire{ID: questionnaireID, IsPublished: true, ClosedAt: &closedAt}
+	q.Edges.Owner = &ent.User{ID: s.ownerID}
+	return q, nil
+}
+
+func (s *webhookService) CreateWebhook(questionnaireID uuid.UUID, url string, events []string, _ context.Context) (*ent.Webhook, error) {
+	return &ent.Webhook{ID: s.webhookID, URL: url, Events: events, Secret: database.WebhookSecretPrefix + "secret"}, nil
+}
+
+func (s *webhookService) GetWebhookWithQuestionnaire(webhookID uuid.UUID, _ context.Context) (*ent.Webhook, error) {
+	if webhookID != s.webhookID {
+		return nil, database.ErrWebhookNotFound
+	}
+	hook := &ent.Webhook{ID: webhookID}
+	hook.Edges.Questionnaire = &ent.Questionnaire{ID: s.questionnaireID}
+	return hook, nil
+}
+
+func (s *webhookService) RedeliverWebhookDelivery(webhookID, deliveryID uuid.UUID, _ context.Context) (*ent.WebhookDelivery, error) {
+	return nil, database.ErrWebhookDeliveryNotFound
+}
+
+func newWebhookService() *webhookService {
+	return &webhookService{ownerID: uuid.New(), questionnaireID: uuid.New(), webhookID: uuid.New(), published: true}
+}
+
+func TestCreateWebhook(t *testing.T) {
+	svc := newWebhookService()
+	s := newTestServer(t, WithService(svc))
+	path := "/api/v1/questionnaires/" + svc.questionnaireID.String() + "/webhooks"
+	owner := testToken(t, s, svc.ownerID, "user")
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"valid", `{"url":" https://example.com/hook ","events":["member.joined","questionnaire.closed"]}`, http.StatusCreated},
+		{"no events", `{"url":"https://example.com/hook","events":[]}`, http.StatusBadRequest},
+		{"unknown event", `{"url":"https://example.com/hook","events":["member.left"]}`, http.StatusBadRequest},
+		{"not http", `{"url":"ftp://example.com/hook","events":["member.joined"]}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := tokenRequest(s, http.MethodPost, path, owner, tt.body)
+			if rec.Code != tt.want {
+				t.Fatalf("POST webhooks = %d %s, want %d", rec.Code, rec.Body, tt.want)
+			}
+			if tt.want != http.StatusCreated {
+				return
+			}
+			var created CreatedWebhookResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+				t.Fatal(err)
+			}
+			if created.URL != "https://example.com/hook" || created.Secret == "" {
+				t.Errorf("created = %+v, want the trimmed URL and the secret", created)
+			}
+		})
+	}
+}
+
+func TestWebhooksOwnerOnly(t *testing.T) {
+	svc := newWebhookService()
+	s := newTestServer(t, WithService(svc))
+	other := testToken(t, s, uuid.New(), "user")
+	base := "/api/v1/questionnaires/" + svc.questionnaireID.String()
+
+	for _, r := range []struct{ method, path string }{
+		{http.MethodGet, base + "/webhooks"},
+		{http.MethodPost, base + "/webhooks"},
+		{http.MethodDelete, base + "/webhooks/" + svc.webhookID.String()},
+		{http.MethodPost, base + "/close"},
+	} {
+		if rec := tokenRequest(s, r.method, r.path, other, `{}`); rec.Code != http.StatusForbidden {
+			t.Errorf("%s %s = %d, want 403", r.method, r.path, rec.Code)
+		}
+	}
+}
+
+func TestWebhookOfOtherQuestionnaire(t *testing.T) {
+	svc := newWebhookService()
+	s := newTestServer(t, WithService(svc))
+	owner := testToken(t, s, svc.ownerID, "user")
+	base := "/api/v1/questionnaires/" + svc.questionnaireID.String() + "/webhooks/"
+
+	if rec := tokenRequest(s, http.MethodGet, base+uuid.NewString()+"/deliveries", owner, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("deliveries of an unknown webhook = %d, want 404", rec.Code)
+	}
+	rec := tokenRequest(s, http.MethodPost, base+svc.webhookID.String()+"/deliveries/"+uuid.NewString()+"/redeliver", owner, "")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("redeliver an unknown delivery = %d %s, want 404", rec.Code, rec.Body)
+	}
+}
+
+func TestCloseQuestionnaire(t *testing.T) {
+	tests := []struct {
+		name      string
+		published bool
+		closed    bool
+		want      int
+		code      string
+	}{
+		{"published", true, false, http.StatusOK, ""},
+		{"draft", false, false, http.StatusConflict, CodeConflict},
+		{"already closed", true, true, http.StatusConflict, "questionnaire_closed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newWebhookService()
+			svc.published, svc.closed = tt.published, tt.closed
+			s := newTestServer(t, WithService(svc))
+
+			rec := tokenRequest(s, http.MethodPost, "/api/v1/questionnaires/"+svc.questionnaireID.String()+"/close", testToken(t, s, svc.ownerID, "user"), "")
+			if rec.Code != tt.want {
+				t.Fatalf("POST close = %d %s, want %d", rec.Code, rec.Body, tt.want)
+			}
+			if tt.code != "" {
+				if code := problemCode(t, rec); code != tt.code {
+					t.Errorf("code = %q, want %q", code, tt.code)
+				}
+				return
+			}
+			var q QuestionnaireResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &q); err != nil {
+				t.Fatal(err)
+			}
+			if q.ClosedAt == nil {
+				t.Error("closed_at is null, want the closing date")
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// Package webhook sends the events of the questionnaires to the webhooks
+// their owners subscribed. The deliveries are queued in the database by the
+// service, in the transaction of the change they announce, and a Dispatcher
+// sends them, retrying the failed ones with an exponential backoff.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// Headers of every delivery. The signature is "sha256=" followed by the hex
+// HMAC-SHA256 of the body keyed with the secret of the webhook.
+const (
+	SignatureHeader = "X-Radgifa-Signature"
+	EventHeader     = "X-Radgifa-Event"
+	DeliveryHeader  = "X-Radgifa-Delivery"
+)
+
+const signaturePrefix = "sha256="
+
+// Defaults of the Options left out
+const (
+	DefaultPollInterval = 5 * time.Second
+	DefaultTimeout      = 10 * time.Second
+	DefaultMaxAttempts  = 8
+	DefaultRetryDelay   = time.Minute
+	DefaultMaxDelay     = 6 * time.Hour
+	DefaultBatchSize    = 20
+)
+
+// maxErrorLength bounds the error recorded for an attempt
+const maxErrorLength = 500
+
+// ErrPrivateAddress is why an attempt to a loopback, private or link-local
+// address fails, unless the dispatcher allows private networks
+var ErrPrivateAddress = errors.New("webhook: refusing to connect to a private address")
+
+// AttemptsTotal counts the attempts to send a delivery, by outcome
+// (succeeded, retried, failed)
+var AttemptsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "radgifa",
+	Name:      "webhook_attempts_total",
+	Help:      "Attempts to send a webhook delivery, by outcome (succeeded, retried, failed).",
+}, []string{"outcome"})
+
+// Sign returns the signature of body sent with the secret of a webhook
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify tells whether signature is the signature of body, in constant
+// time. Receivers written in Go can use it.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Queue is the part of database.Service the dispatcher uses
+type Queue interface {
+	ClaimWebhookDeliveries(limit int, lease time.Duration, ctx context.Context) ([]*ent.WebhookDelivery, error)
+	RecordWebhookAttempt(deliveryID uuid.UUID, attempt database.WebhookAttempt, ctx context.Context) error
+}
+
+// Options tunes a Dispatcher. The zero values take the defaults.
+type Options struct {
+	// PollInterval is how long the dispatcher waits for new deliveries
+	// once the queue is empty
+	PollInterval time.Duration
+	// Timeout bounds one attempt
+	Timeout time.Duration
+	// MaxAttempts is how many times a delivery is tried before it fails
+	MaxAttempts int
+	// RetryDelay is the wait after the first failed attempt, doubled after
+	// every other one up to MaxDelay
+	RetryDelay time.Duration
+	MaxDelay   time.Duration
+	// BatchSize is how many deliveries are sent at once
+	BatchSize int
+	// AllowPrivateNetworks lets the deliveries reach loopback, private and
+	// link-local addresses, a receiver on the same host for instance. They
+	// are refused by default, so that a webhook cannot probe the network
+	// the server runs in.
+	AllowPrivateNetworks bool
+	// Client sends the requests, by default a client with Timeout that does
+	// not follow redirects and checks the addresses it dials. A Client given
+	// here is used as is.
+	Client *http.Client
+}
+
+// Dispatcher sends the queued deliveries. Several dispatchers, in as many
+// processes, can share a queue.
+type Dispatcher struct {
+	queue  Queue
+	logger *zap.Logger
+	opts   Options
+}
+
+// New returns a Dispatcher sending the deliveries of queue
+func New(queue Queue, logger *zap.Logger, opts Options) *Dispatcher {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = DefaultRetryDelay
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = DefaultMaxDelay
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.Client == nil {
+		opts.Client = newClient(opts.Timeout, opts.AllowPrivateNetworks)
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Dispatcher{queue: queue, logger: logger, opts: opts}
+}
+
+// Run sends the deliveries as they come due until ctx is cancelled. It
+// drains the queue before waiting for the next poll.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := d.Poll(ctx)
+			if err != nil && ctx.Err() == nil {
+				d.logger.Error("failed to claim webhook deliveries", zap.Error(err))
+			}
+			if err != nil || n < d.opts.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll sends one batch of due deliveries and returns how many it sent
+func (d *Dispatcher) Poll(ctx context.Context) (int, error) {
+	// The lease outlasts an attempt, so a delivery is only claimed again
+	// when its dispatcher did not record the attempt
+	deliveries, err := d.queue.ClaimWebhookDeliveries(d.opts.BatchSize, 2*d.opts.Timeout, ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			attempt := d.send(ctx, delivery)
+			// Recorded even when shutting down, so the attempt is not lost
+			if err := d.queue.RecordWebhookAttempt(delivery.ID, attempt, context.WithoutCancel(ctx)); err != nil {
+				d.logger.Error("failed to record webhook attempt",
+					zap.String("delivery_id", delivery.ID.String()),
+					zap.Error(err))
+			}
+		}()
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// send makes one attempt to send the delivery. Any 2xx response accepts
+// it; failures are retried until the attempts run out.
+func (d *Dispatcher) send(ctx context.Context, delivery *ent.WebhookDelivery) database.WebhookAttempt {
+	hook := delivery.Edges.Webhook
+	log := d.logger.With(
+		zap.String("delivery_id", delivery.ID.String()),
+		zap.String("webhook_id", hook.ID.String()),
+		zap.String("event", delivery.Event))
+
+	attempt := database.WebhookAttempt{At: time.Now().UnixMilli()}
+	attempt.StatusCode, attempt.Error = d.post(ctx, hook, delivery)
+	if attempt.Error == "" {
+		attempt.Succeeded = true
+		AttemptsTotal.WithLabelValues("succeeded").Inc()
+		log.Debug("webhook delivered", zap.Int("status", attempt.StatusCode))
+		return attempt
+	}
+
+	if attempts := delivery.Attempts + 1; attempts < d.opts.MaxAttempts {
+		attempt.RetryAt = time.Now().Add(d.backoff(attempts)).UnixMilli()
+		AttemptsTotal.WithLabelValues("retried").Inc()
+		log.Info("webhook delivery failed, retrying",
+			zap.Int("attempts", attempts),
+			zap.Time("retry_at", time.UnixMilli(attempt.RetryAt)),
+			zap.String("error", attempt.Error))
+		return attempt
+	}
+	AttemptsTotal.WithLabelValues("failed").Inc()
+	log.Warn("webhook delivery failed, giving up",
+		zap.Int("attempts", d.opts.MaxAttempts),
+		zap.String("error", attempt.Error))
+	return attempt
+}
+
+// post sends the payload of the delivery to the webhook and returns the
+// status of the response, and why it failed
+func (d *Dispatcher) post(ctx context.Context, hook *ent.Webhook, delivery *ent.WebhookDelivery) (int, string) {
+	ctx, cancel := context.WithTimeout(ctx, d.opts.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, truncate(err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Radgifa-Webhook/1")
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, delivery.Payload))
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+
+	resp, err := d.opts.Client.Do(req)
+	if err != nil {
+		return 0, truncate(err.Error())
+	}
+	defer resp.Body.Close()
+	// The body is not recorded, the delivery log would otherwise show what
+	// any URL answers. Reading a little lets the connection be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorLength))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Sprintf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, ""
+}
+
+// newClient returns the default client of a dispatcher. Redirects are not
+// followed, a 3xx fails the attempt, and unless allowPrivate the addresses
+// are checked once resolved, right before connecting, so that a host name
+// pointing to a private address is refused too.
+func newClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		dialer.Control = refusePrivate
+		// A proxy would connect on our behalf, past the check
+		transport.Proxy = nil
+	}
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// refusePrivate is the Control of the dialer, called with the resolved
+// address of every connection
+func refusePrivate(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	ip = ip.Unmap()
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("%w %s", ErrPrivateAddress, ip)
+	}
+	return nil
+}
+
+// backoff returns the wait after the given number of failed attempts
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.opts.RetryDelay
+	for i := 1; i < attempts && delay < d.opts.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, d.opts.MaxDelay)
+}
+
+func truncate(s string) string {
+	if len(s) > maxErrorLength {
+		s = s[:maxErrorLength]
+	}
+	// The cut, or the response, may leave invalid UTF-8 the column rejects
+	return strings.ToValidUTF8(s, "")
+}
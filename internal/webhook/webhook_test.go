@@ -0,0 +1,240 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"radgifa/ent"
+	"radgifa/internal/database"
+
+	"github.com/google/uuid"
+)
+
+// memoryQueue holds deliveries in memory and keeps the attempts recorded
+type memoryQueue struct {
+	mu         sync.Mutex
+	deliveries []*ent.WebhookDelivery
+	attempts   []database.WebhookAttempt
+}
+
+func (q *memoryQueue) ClaimWebhookDeliveries(limit int, lease time.Duration, _ context.Context) ([]*ent.WebhookDelivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var claimed []*ent.WebhookDelivery
+	for _, d := range q.deliveries {
+		if len(claimed) < limit && d.Status == "pending" && d.NextAttemptAt <= time.Now().UnixMilli() {
+			d.NextAttemptAt = time.Now().Add(lease).UnixMilli()
+			claimed = append(claimed, d)
+		}
+	}
+	return claimed, nil
+}
+
+func (q *memoryQueue) RecordWebhookAttempt(deliveryID uuid.UUID, attempt database.WebhookAttempt, _ context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.attempts = append(q.attempts, attempt)
+	for _, d := range q.deliveries {
+		if d.ID != deliveryID {
+			continue
+		}
+		d.Attempts++
+		switch {
+		case attempt.Succeeded:
+			d.Status = "succeeded"
+		case attempt.RetryAt != 0:
+			d.NextAttemptAt = attempt.RetryAt
+		default:
+			d.Status = "failed"
+		}
+	}
+	return nil
+}
+
+func newDelivery(url string) *ent.WebhookDelivery {
+	d := &ent.WebhookDelivery{
+		ID:      uuid.New(),
+		Event:   database.EventMemberJoined,
+		Payload: []byte(`{"type":"member.joined"}`),
+		Status:  "pending",
+	}
+	d.Edges.Webhook = &ent.Webhook{ID: uuid.New(), URL: url, Secret: "whsec_test"}
+	return d
+}
+
+func TestDispatcherSignsDeliveries(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	delivery := newDelivery(receiver.URL)
+	queue := &memoryQueue{deliveries: []*ent.WebhookDelivery{delivery}}
+	if n, err := New(queue, nil, Options{AllowPrivateNetworks: true}).Poll(context.Background()); err != nil || n != 1 {
+		t.Fatalf("Poll() = %d, %v, want 1 delivery sent", n, err)
+	}
+
+	if !Verify("whsec_test", body, got.Header.Get(SignatureHeader)) {
+		t.Errorf("signature %q does not match the body %s", got.Header.Get(SignatureHeader), body)
+	}
+	if got.Header.Get(EventHeader) != database.EventMemberJoined || got.Header.Get(DeliveryHeader) != delivery.ID.String() {
+		t.Errorf("headers = %v, want the event and the delivery ID", got.Header)
+	}
+	if delivery.Status != "succeeded" || queue.attempts[0].StatusCode != http.StatusNoContent {
+		t.Errorf("delivery = %+v, attempts = %+v, want it succeeded", delivery, queue.attempts)
+	}
+	if Verify("whsec_other", body, got.Header.Get(SignatureHeader)) {
+		t.Error("the signature matches another secret")
+	}
+}
+
+func TestDispatcherRetries(t *testing.T) {
+	var calls int
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "try later", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	delivery := newDelivery(receiver.URL)
+	queue := &memoryQueue{deliveries: []*ent.WebhookDelivery{delivery}}
+	d := New(queue, nil, Options{RetryDelay: time.Millisecond, MaxDelay: time.Millisecond, AllowPrivateNetworks: true})
+	for i := 0; i < 3; i++ {
+		// Past the retry delay
+		time.Sleep(5 * time.Millisecond)
+		if _, err := d.Poll(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if delivery.Status != "succeeded" || delivery.Attempts != 3 {
+		t.Fatalf("delivery = %+v, want it succeeded on the third attempt", delivery)
+	}
+	first := queue.attempts[0]
+	if first.StatusCode != http.StatusServiceUnavailable || first.RetryAt == 0 || first.Error != "unexpected status 503" {
+		t.Errorf("first attempt = %+v, want a 503 to retry, without the body", first)
+	}
+}
+
+func TestDispatcherGivesUp(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	receiver.Close() // Refuses connections from now on
+
+	delivery := newDelivery(receiver.URL)
+	delivery.Attempts = 2
+	queue := &memoryQueue{deliveries: []*ent.WebhookDelivery{delivery}}
+	if _, err := New(queue, nil, Options{MaxAttempts: 3, AllowPrivateNetworks: true}).Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Status != "failed" || queue.attempts[0].StatusCode != 0 || queue.attempts[0].Error == "" {
+		t.Errorf("delivery = %+v, attempt = %+v, want it failed without response", delivery, queue.attempts[0])
+	}
+}
+
+func TestDispatcherRefusesPrivateAddresses(t *testing.T) {
+	var calls int
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer receiver.Close()
+
+	// localhost resolves to loopback: the check is on the address dialed
+	url := strings.Replace(receiver.URL, "127.0.0.1", "localhost", 1)
+	for _, u := range []string{receiver.URL, url} {
+		delivery := newDelivery(u)
+		queue := &memoryQueue{deliveries: []*ent.WebhookDelivery{delivery}}
+		if _, err := New(queue, nil, Options{}).Poll(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if attempt := queue.attempts[0]; attempt.StatusCode != 0 || !strings.Contains(attempt.Error, "private address") {
+			t.Errorf("attempt to %s = %+v, want it refused", u, attempt)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("receiver called %d times, want 0", calls)
+	}
+}
+
+func TestRefusePrivate(t *testing.T) {
+	tests := []struct {
+		address string
+		refused bool
+	}{
+		{"93.184.215.14:443", false},
+		{"[2606:2800:21f:cb07:6820:80da:af6b:8b2c]:443", false},
+		{"127.0.0.1:80", true},
+		{"10.1.2.3:80", true},
+		{"172.16.0.1:80", true},
+		{"192.168.1.1:80", true},
+		{"169.254.169.254:80", true},
+		{"0.0.0.0:80", true},
+		{"[::1]:80", true},
+		{"[fd00::1]:80", true},
+		{"[fe80::1]:80", true},
+		{"[::ffff:127.0.0.1]:80", true},
+	}
+	for _, tt := range tests {
+		err := refusePrivate("tcp", tt.address, nil)
+		if refused := errors.Is(err, ErrPrivateAddress); refused != tt.refused {
+			t.Errorf("refusePrivate(%s) = %v, want refused %t", tt.address, err, tt.refused)
+		}
+	}
+}
+
+func TestDispatcherDoesNotFollowRedirects(t *testing.T) {
+	var followed bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		followed = true
+	}))
+	defer target.Close()
+	receiver := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer receiver.Close()
+
+	delivery := newDelivery(receiver.URL)
+	queue := &memoryQueue{deliveries: []*ent.WebhookDelivery{delivery}}
+	if _, err := New(queue, nil, Options{AllowPrivateNetworks: true}).Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if attempt := queue.attempts[0]; attempt.StatusCode != http.StatusTemporaryRedirect || attempt.Succeeded {
+		t.Errorf("attempt = %+v, want the redirect to fail it", attempt)
+	}
+	if followed {
+		t.Error("the redirect was followed")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := New(&memoryQueue{}, nil, Options{RetryDelay: time.Minute, MaxDelay: time.Hour})
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{7, time.Hour},
+		{50, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Register creates a user account
+func (c *Client) Register(ctx context.Context, user NewUser) error {
+	_, err := c.do(ctx, request{method: http.MethodPost, path: "/register", body: user}, nil)
+	return err
+}
+
+// Login logs a user in and authenticates the following calls with their
+// token
+func (c *Client) Login(ctx context.Context, username, password string) (*Login, error) {
+	creds := map[string]string{"username": username, "password": password}
+	login := new(Login)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: "/login", body: creds}, login); err != nil {
+		return nil, err
+	}
+	c.SetToken(login.Token)
+	return login, nil
+}
+
+// UsernameAvailable tells whether no user has taken username
+func (c *Client) UsernameAvailable(ctx context.Context, username string) (bool, error) {
+	var out struct {
+		Available bool `json:"available"`
+	}
+	_, err := c.do(ctx, request{method: http.MethodPost, path: "/check/username", body: map[string]string{"value": username}}, &out)
+	return out.Available, err
+}
+
+// CreateQuestionnaire creates a questionnaire owned by the caller and
+// returns its ID
+func (c *Client) CreateQuestionnaire(ctx context.Context, q NewQuestionnaire) (string, error) {
+	var id string
+	_, err := c.do(ctx, request{method: http.MethodPost, path: "/questionnaires", body: q, idempotent: true}, &id)
+	return id, err
+}
+
+// ListQuestionnaires returns a page of the questionnaires the caller owns
+func (c *Client) ListQuestionnaires(ctx context.Context, opts ListOptions) (*Page[Questionnaire], error) {
+	page := new(Page[Questionnaire])
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: "/questionnaires", query: opts.values()}, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// GetQuestionnaire returns a questionnaire with its questions, sections and
+// members, as seen by the caller
+func (c *Client) GetQuestionnaire(ctx context.Context, id string) (*Questionnaire, error) {
+	q := new(Questionnaire)
+	resp, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s", id)}, q)
+	if err != nil {
+		return nil, err
+	}
+	q.ETag = resp.header.Get("ETag")
+	return q, nil
+}
+
+// UpdateQuestionnaire replaces the title, description and lock of a
+// questionnaire. With a non empty ifMatch, usually the ETag of the
+// questionnaire as read, it fails with the code "version_mismatch" when the
+// questionnaire changed since.
+func (c *Client) UpdateQuestionnaire(ctx context.Context, id string, update QuestionnaireUpdate, ifMatch string) (*Questionnaire, error) {
+	req := request{method: http.MethodPut, path: pathf("/questionnaires/%s", id), body: update}
+	if ifMatch != "" {
+		req.header = http.Header{"If-Match": {ifMatch}}
+	}
+	q := new(Questionnaire)
+	resp, err := c.do(ctx, req, q)
+	if err != nil {
+		return nil, err
+	}
+	q.ETag = resp.header.Get("ETag")
+	return q, nil
+}
+
+// PublishQuestionnaire opens a questionnaire to its members. It can no
+// longer be edited afterwards.
+func (c *Client) PublishQuestionnaire(ctx context.Context, id string) (*Questionnaire, error) {
+	q := new(Questionnaire)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/publish", id)}, q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// CloseQuestionnaire closes a published questionnaire: joining, answering
+// and submitting then fail with the code "questionnaire_closed"
+func (c *Client) CloseQuestionnaire(ctx context.Context, id string) (*Questionnaire, error) {
+	q := new(Questionnaire)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/close", id)}, q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// DeleteQuestionnaire deletes a questionnaire with its questions, members
+// and answers
+func (c *Client) DeleteQuestionnaire(ctx context.Context, id string) error {
+	_, err := c.do(ctx, request{method: http.MethodDelete, path: pathf("/questionnaires/%s", id)}, nil)
+	return err
+}
+
+// ListMembers returns a page of the members of a questionnaire
+func (c *Client) ListMembers(ctx context.Context, questionnaireID string, opts ListOptions) (*Page[Member], error) {
+	page := new(Page[Member])
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/members", questionnaireID), query: opts.values()}, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// Completion returns the progress of the members of a questionnaire
+func (c *Client) Completion(ctx context.Context, questionnaireID string) (*Completion, error) {
+	completion := new(Completion)
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/completion", questionnaireID)}, completion); err != nil {
+		return nil, err
+	}
+	return completion, nil
+}
+
+// CreateSection appends a section to a questionnaire
+func (c *Client) CreateSection(ctx context.Context, questionnaireID string, section NewSection) (*Section, error) {
+	s := new(Section)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/sections", questionnaireID), body: section}, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListSections returns the sections of a questionnaire in order
+func (c *Client) ListSections(ctx context.Context, questionnaireID string) ([]Section, error) {
+	var sections []Section
+	_, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/sections", questionnaireID)}, &sections)
+	return sections, err
+}
+
+// CreateQuestion adds a question to a questionnaire
+func (c *Client) CreateQuestion(ctx context.Context, questionnaireID string, question NewQuestion) (*CreatedQuestion, error) {
+	created := new(CreatedQuestion)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/question", questionnaireID), body: question, idempotent: true}, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListQuestions returns a page of the questions of a questionnaire. The
+// owner gets every answer, a member their own.
+func (c *Client) ListQuestions(ctx context.Context, questionnaireID string, opts ListOptions) (*Page[Question], error) {
+	page := new(Page[Question])
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/questions", questionnaireID), query: opts.values()}, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// AllQuestions returns every question of a questionnaire, following the
+// pages of ListQuestions
+func (c *Client) AllQuestions(ctx context.Context, questionnaireID string) ([]Question, error) {
+	var questions []Question
+	opts := ListOptions{Limit: 100}
+	for {
+		page, err := c.ListQuestions(ctx, questionnaireID, opts)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, page.Items...)
+		if page.NextCursor == "" {
+			return questions, nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+// UpdateQuestion replaces the text, section and required flag of a question
+func (c *Client) UpdateQuestion(ctx context.Context, questionnaireID, questionID string, question QuestionUpdate) (*Question, error) {
+	q := new(Question)
+	if _, err := c.do(ctx, request{method: http.MethodPut, path: pathf("/questionnaires/%s/questions/%s", questionnaireID, questionID), body: question}, q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// DeleteQuestion deletes a question and its answers
+func (c *Client) DeleteQuestion(ctx context.Context, questionnaireID, questionID string) error {
+	_, err := c.do(ctx, request{method: http.MethodDelete, path: pathf("/questionnaires/%s/questions/%s", questionnaireID, questionID)}, nil)
+	return err
+}
+
+// Invite creates an invitation to join a questionnaire
+func (c *Client) Invite(ctx context.Context, questionnaireID string) (*Invitation, error) {
+	invitation := new(Invitation)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/invite", questionnaireID)}, invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// InvitationInfo returns the questionnaire an invitation token is for. It
+// needs no token.
+func (c *Client) InvitationInfo(ctx context.Context, token string) (*PublicQuestionnaire, error) {
+	q := new(PublicQuestionnaire)
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/join/%s/info", token)}, q); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// IdentifierAvailable tells whether no member of the questionnaire of an
+// invitation took identifier
+func (c *Client) IdentifierAvailable(ctx context.Context, token, identifier string) (bool, error) {
+	var out struct {
+		Available bool `json:"available"`
+	}
+	_, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/check/member/%s", token), body: map[string]string{"value": identifier}}, &out)
+	return out.Available, err
+}
+
+// Join joins the questionnaire of an invitation and authenticates the
+// following calls as the member it creates or logs in. A logged in user
+// joins under their account and keeps their token.
+func (c *Client) Join(ctx context.Context, token string, join JoinRequest) (*Membership, error) {
+	m := new(Membership)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/join/%s", token), body: join, idempotent: true}, m); err != nil {
+		return nil, err
+	}
+	if m.Token != "" {
+		c.SetToken(m.Token)
+	}
+	return m, nil
+}
+
+// MyQuestions returns, in order, the questions the caller sees as a member
+// given the answers they gave so far
+func (c *Client) MyQuestions(ctx context.Context, questionnaireID string) ([]Question, error) {
+	var questions []Question
+	_, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/my-questions", questionnaireID)}, &questions)
+	return questions, err
+}
+
+// MyAnswers returns a page of the answers of the caller as a member
+func (c *Client) MyAnswers(ctx context.Context, questionnaireID string, opts ListOptions) (*Page[Answer], error) {
+	page := new(Page[Answer])
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/my-answers", questionnaireID), query: opts.values()}, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// Answer answers a question, replacing the previous answer of the caller
+func (c *Client) Answer(ctx context.Context, questionID string, value AnswerValue) (*SavedAnswer, error) {
+	saved := new(SavedAnswer)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/question/%s", questionID), body: map[string]AnswerValue{"answer_value": value}}, saved); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// AnswerAll saves several answers to a questionnaire at once, all or none
+func (c *Client) AnswerAll(ctx context.Context, questionnaireID string, answers []QuestionAnswer) (*SavedAnswers, error) {
+	saved := new(SavedAnswers)
+	body := map[string][]QuestionAnswer{"answers": answers}
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/answers", questionnaireID), body: body}, saved); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// Submit marks the caller as done with a questionnaire. When required
+// questions are unanswered it fails with the code
+// "missing_required_answers", listing them in MissingQuestionIDs.
+func (c *Client) Submit(ctx context.Context, questionnaireID string) (*Submission, error) {
+	submission := new(Submission)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/submit", questionnaireID)}, submission); err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+// CreateAccessToken creates a personal access token, to pass to WithToken.
+// It needs the token of a login, access tokens cannot create others.
+func (c *Client) CreateAccessToken(ctx context.Context, token NewAccessToken) (*AccessToken, error) {
+	created := new(AccessToken)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: "/tokens", body: token}, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListAccessTokens returns the personal access tokens of the caller, newest
+// first
+func (c *Client) ListAccessTokens(ctx context.Context) ([]AccessToken, error) {
+	var tokens []AccessToken
+	_, err := c.do(ctx, request{method: http.MethodGet, path: "/tokens"}, &tokens)
+	return tokens, err
+}
+
+// RevokeAccessToken revokes a personal access token of the caller
+func (c *Client) RevokeAccessToken(ctx context.Context, id string) error {
+	_, err := c.do(ctx, request{method: http.MethodDelete, path: pathf("/tokens/%s", id)}, nil)
+	return err
+}
+
+// CreateWebhook subscribes an URL to events of a questionnaire. The
+// returned webhook holds the secret the deliveries are signed with.
+func (c *Client) CreateWebhook(ctx context.Context, questionnaireID string, hook NewWebhook) (*Webhook, error) {
+	created := new(Webhook)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: pathf("/questionnaires/%s/webhooks", questionnaireID), body: hook}, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListWebhooks returns the webhooks of a questionnaire, oldest first
+func (c *Client) ListWebhooks(ctx context.Context, questionnaireID string) ([]Webhook, error) {
+	var hooks []Webhook
+	_, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/webhooks", questionnaireID)}, &hooks)
+	return hooks, err
+}
+
+// DeleteWebhook deletes a webhook with its delivery log
+func (c *Client) DeleteWebhook(ctx context.Context, questionnaireID, webhookID string) error {
+	_, err := c.do(ctx, request{method: http.MethodDelete, path: pathf("/questionnaires/%s/webhooks/%s", questionnaireID, webhookID)}, nil)
+	return err
+}
+
+// ListWebhookDeliveries returns a page of the deliveries of a webhook,
+// newest first by default
+func (c *Client) ListWebhookDeliveries(ctx context.Context, questionnaireID, webhookID string, opts ListOptions) (*Page[WebhookDelivery], error) {
+	page := new(Page[WebhookDelivery])
+	if _, err := c.do(ctx, request{method: http.MethodGet, path: pathf("/questionnaires/%s/webhooks/%s/deliveries", questionnaireID, webhookID), query: opts.values()}, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// Redeliver queues the payload of a delivery again and returns the new
+// delivery
+func (c *Client) Redeliver(ctx context.Context, questionnaireID, webhookID, deliveryID string) (*WebhookDelivery, error) {
+	delivery := new(WebhookDelivery)
+	path := pathf("/questionnaires/%s/webhooks/%s/deliveries/%s/redeliver", questionnaireID, webhookID, deliveryID)
+	if _, err := c.do(ctx, request{method: http.MethodPost, path: path}, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (o ListOptions) values() url.Values {
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	for name, value := range map[string]string{"cursor": o.Cursor, "sort": o.Sort, "order": o.Order, "q": o.Query} {
+		if value != "" {
+			v.Set(name, value)
+		}
+	}
+	return v
+}
@@ -0,0 +1,308 @@
+// Package client is a typed Go client for the /api/v1 routes of the Radgifa
+// API, for scripts and services that would otherwise craft HTTP calls from
+// docs/swagger.json.
+//
+//	c, _ := client.New("https://radgifa.example.com")
+//	if _, err := c.Login(ctx, "johndoe", password); err != nil {
+//		return err
+//	}
+//	id, _ := c.CreateQuestionnaire(ctx, client.NewQuestionnaire{Title: "Pizza night"})
+//
+// Login and Join keep the token they receive, so the calls that follow are
+// authenticated. Failed requests are returned as *Error, which carries the
+// problem document of the API. Requests that cannot have been applied, and
+// those the API deduplicates with an Idempotency-Key, are retried with an
+// exponential backoff on network errors and on 429, 502, 503 and 504
+// responses.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetries   = 3
+	defaultRetryWait = 500 * time.Millisecond
+	maxRetryWait     = 30 * time.Second
+)
+
+// Client calls the API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	userAgent  string
+	retries    int
+	retryWait  time.Duration
+
+	mu    sync.RWMutex
+	token string
+}
+
+// Option configures a Client built with New
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send the requests
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken authenticates the requests with a token obtained earlier, by
+// Login, Join or the radgifa CLI
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithRetries sets how many times a failed request is retried, and the wait
+// before the first retry, which doubles with each attempt. Zero retries
+// disables them.
+func WithRetries(retries int, wait time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.retryWait = wait
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// New builds a client for the API served at baseURL, including its route
+// prefix if any, e.g. "https://example.com/radgifa"
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("client: invalid base URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("client: base URL %q must be http or https", baseURL)
+	}
+
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/") + "/api/v1",
+		httpClient: http.DefaultClient,
+		userAgent:  "radgifa-go-client",
+		retries:    defaultRetries,
+		retryWait:  defaultRetryWait,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.retries < 0 {
+		return nil, errors.New("client: retries must not be negative")
+	}
+	return c, nil
+}
+
+// Token returns the token authenticating the requests, empty before Login,
+// Join or WithToken
+func (c *Client) Token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token
+}
+
+// SetToken replaces the token authenticating the requests
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+}
+
+// request describes a call to the API
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	body   any
+	header http.Header
+	// idempotent marks the POST routes deduplicating retries bearing an
+	// Idempotency-Key, which the client then generates
+	idempotent bool
+}
+
+// response is what the API answered to a successful request
+type response struct {
+	status int
+	header http.Header
+}
+
+// do sends req, retrying it when that is safe, and decodes the JSON body of
+// a successful response into out unless it is nil
+func (c *Client) do(ctx context.Context, req request, out any) (*response, error) {
+	var body []byte
+	if req.body != nil {
+		var err error
+		if body, err = json.Marshal(req.body); err != nil {
+			return nil, fmt.Errorf("client: encoding request: %w", err)
+		}
+	}
+
+	header := req.header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	if req.idempotent && header.Get("Idempotency-Key") == "" {
+		key, err := newIdempotencyKey()
+		if err != nil {
+			return nil, err
+		}
+		header.Set("Idempotency-Key", key)
+	}
+	retryable := req.method != http.MethodPost || header.Get("Idempotency-Key") != ""
+
+	u := c.baseURL + req.path
+	if len(req.query) > 0 {
+		u += "?" + req.query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, req.method, u, header, body)
+		wait, retry := c.backoff(attempt, resp, err)
+		if !retryable || !retry {
+			if err != nil {
+				return nil, err
+			}
+			return c.decode(resp, out)
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, method, u string, header http.Header, body []byte) (*http.Response, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, u, r)
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	for name, values := range header {
+		httpReq.Header[name] = values
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if token := c.Token(); token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.httpClient.Do(httpReq)
+}
+
+// backoff tells whether the outcome of an attempt is worth retrying and how
+// long to wait first. A Retry-After header in seconds wins over the
+// exponential backoff.
+func (c *Client) backoff(attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= c.retries {
+		return 0, false
+	}
+	if err != nil {
+		// The context ending is not a transient failure
+		return c.wait(attempt), !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+	case http.StatusConflict:
+		// An earlier attempt bearing the same Idempotency-Key is in flight
+		if peekCode(resp) != "idempotency_key_in_progress" {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxRetryWait), true
+	}
+	return c.wait(attempt), true
+}
+
+// wait is the exponential backoff after the given attempt, up to
+// maxRetryWait. It doubles step by step, as shifting by a large attempt
+// would overflow.
+func (c *Client) wait(attempt int) time.Duration {
+	wait := c.retryWait
+	for i := 0; i < attempt && wait < maxRetryWait; i++ {
+		wait *= 2
+	}
+	return min(wait, maxRetryWait)
+}
+
+// peekCode returns the code of a problem response, leaving its body
+// readable
+func peekCode(resp *http.Response) string {
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	var p struct {
+		Code string `json:"code"`
+	}
+	_ = json.Unmarshal(data, &p)
+	return p.Code
+}
+
+func (c *Client) decode(resp *http.Response, out any) (*response, error) {
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("client: reading response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, newError(resp, data)
+	}
+	if out != nil && resp.StatusCode != http.StatusNotModified && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, fmt.Errorf("client: decoding %s response: %w", resp.Request.URL.Path, err)
+		}
+	}
+	return &response{status: resp.StatusCode, header: resp.Header}, nil
+}
+
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("client: generating an idempotency key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// pathf formats the path of a route, escaping its parameters
+func pathf(format string, params ...string) string {
+	escaped := make([]any, len(params))
+	for i, p := range params {
+		escaped[i] = url.PathEscape(p)
+	}
+	return fmt.Sprintf(format, escaped...)
+}
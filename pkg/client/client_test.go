@@ -0,0 +1,194 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"radgifa/internal/webhook"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	c, err := New(ts.URL+"/radgifa/", WithRetries(3, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func writeProblem(w http.ResponseWriter, status int, code, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{"status": status, "code": code, "detail": detail})
+}
+
+func TestLoginKeepsToken(t *testing.T) {
+	var gotAuth string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/radgifa/api/v1/login":
+			json.NewEncoder(w).Encode(map[string]string{"token": "abc", "type": "user"})
+		case "/radgifa/api/v1/questionnaires":
+			gotAuth = r.Header.Get("Authorization")
+			json.NewEncoder(w).Encode(map[string]any{"items": []any{map[string]any{"id": "q1", "title": "Pizza night"}}, "total": 1})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	if _, err := c.Login(context.Background(), "john", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	page, err := c.ListQuestionnaires(context.Background(), ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotAuth != "Bearer abc" {
+		t.Errorf("Authorization = %q, want the token of the login", gotAuth)
+	}
+	if page.Total != 1 || page.Items[0].Title != "Pizza night" {
+		t.Errorf("page = %+v", page)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         func(c *Client) error
+		wantAttempts int
+	}{
+		{"idempotent POST", func(c *Client) error {
+			_, err := c.CreateQuestionnaire(context.Background(), NewQuestionnaire{Title: "Pizza night"})
+			return err
+		}, 3},
+		{"GET", func(c *Client) error {
+			_, err := c.Completion(context.Background(), "q1")
+			return err
+		}, 3},
+		{"other POST", func(c *Client) error {
+			_, err := c.Submit(context.Background(), "q1")
+			return err
+		}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var keys []string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				keys = append(keys, r.Header.Get("Idempotency-Key"))
+				if len(keys) < 3 {
+					writeProblem(w, http.StatusServiceUnavailable, "unavailable", "draining")
+					return
+				}
+				if r.Method == http.MethodGet {
+					w.Write([]byte(`{"submitted":0}`))
+					return
+				}
+				w.Write([]byte(`"q1"`))
+			})
+
+			err := tt.call(c)
+			if len(keys) != tt.wantAttempts {
+				t.Fatalf("%d attempts, want %d", len(keys), tt.wantAttempts)
+			}
+			if tt.wantAttempts == 1 {
+				var apiErr *Error
+				if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+					t.Errorf("err = %v, want the 503", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "idempotent POST" && (keys[0] == "" || keys[0] != keys[1] || keys[1] != keys[2]) {
+				t.Errorf("Idempotency-Key of the attempts = %q, want the same key", keys)
+			}
+		})
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	c, err := New("http://localhost", WithRetries(100, time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, attempt := range []int{5, 40, 70, 99} {
+		wait, retry := c.backoff(attempt, nil, errors.New("connection refused"))
+		if !retry || wait != maxRetryWait {
+			t.Errorf("backoff(%d) after a network error = %s, %v, want %s", attempt, wait, retry, maxRetryWait)
+		}
+	}
+	if wait, _ := c.backoff(1, nil, errors.New("connection refused")); wait != 2*time.Second {
+		t.Errorf("backoff(1) = %s, want 2s", wait)
+	}
+}
+
+func TestErrorCarriesProblem(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":400,"code":"validation_failed","detail":"invalid request","errors":[{"field":"title","code":"required","message":"title is required"}]}`))
+	})
+
+	_, err := c.CreateQuestionnaire(context.Background(), NewQuestionnaire{})
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("err = %v, want an *Error", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Code != "validation_failed" || len(apiErr.Errors) != 1 || apiErr.Errors[0].Field != "title" {
+		t.Errorf("error = %+v", apiErr)
+	}
+}
+
+// TestTypesMatchServer decodes the golden responses of the server, failing
+// on any field the client types lack
+func TestTypesMatchServer(t *testing.T) {
+	tests := []struct {
+		file string
+		into any
+	}{
+		{"questionnaire_owner", new(Questionnaire)},
+		{"questionnaire_member", new(Questionnaire)},
+		{"questionnaire_public", new(PublicQuestionnaire)},
+		{"questions_member", new([]Question)},
+		{"members_owner", new([]Member)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join("..", "..", "internal", "server", "testdata", tt.file+".golden.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			dec := json.NewDecoder(bytes.NewReader(data))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(tt.into); err != nil {
+				t.Errorf("decoding %s: %v", tt.file, err)
+			}
+		})
+	}
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"type":"member.joined"}`)
+	signature := webhook.Sign("whsec_test", body)
+	if !VerifyWebhookSignature("whsec_test", body, signature) {
+		t.Errorf("the signature of the server %q does not verify", signature)
+	}
+	if VerifyWebhookSignature("whsec_other", body, signature) || VerifyWebhookSignature("whsec_test", append(body, ' '), signature) {
+		t.Error("the signature verifies with another secret or body")
+	}
+}
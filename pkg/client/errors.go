@@ -0,0 +1,58 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error is an API error, decoded from its RFC 7807 problem document. Code
+// is the stable, machine readable kind of the error, such as
+// "validation_failed" or "version_mismatch".
+type Error struct {
+	StatusCode         int          `json:"status"`
+	Type               string       `json:"type"`
+	Title              string       `json:"title"`
+	Detail             string       `json:"detail"`
+	Instance           string       `json:"instance"`
+	Code               string       `json:"code"`
+	RequestID          string       `json:"request_id"`
+	Errors             []FieldError `json:"errors"`
+	MissingQuestionIDs []string     `json:"missing_question_ids"`
+}
+
+// FieldError is the validation error of one field of a request
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("radgifa: %d %s", e.StatusCode, e.Code)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	for _, fe := range e.Errors {
+		msg += fmt.Sprintf("; %s: %s", fe.Field, fe.Message)
+	}
+	return msg
+}
+
+// newError decodes the body of a failed response. Responses that are not
+// problem documents, from a proxy for instance, keep their status only.
+func newError(resp *http.Response, body []byte) *Error {
+	e := &Error{}
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/problem+json") {
+		_ = json.Unmarshal(body, e)
+	}
+	e.StatusCode = resp.StatusCode
+	if e.Code == "" {
+		e.Code = strings.ReplaceAll(strings.ToLower(http.StatusText(resp.StatusCode)), " ", "_")
+	}
+	if e.Detail == "" && e.Title == "" {
+		e.Title = http.StatusText(resp.StatusCode)
+	}
+	return e
+}
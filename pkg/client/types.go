@@ -0,0 +1,371 @@
+package client
+
+import "encoding/json"
+
+// The types below mirror the response types of the API. Timestamps are Unix
+// milliseconds. Related entities come under Edges, only when the route
+// loads them, and a member reading a questionnaire gets a subset of what its
+// owner gets: the fields reserved to the owner are then left empty.
+
+// Questionnaire is a questionnaire. ClosedAt is nil until it is closed.
+// ETag is set by GetQuestionnaire and the calls updating it, for use with
+// If-Match.
+type Questionnaire struct {
+	ID              string             `json:"id"`
+	Title           string             `json:"title"`
+	Description     string             `json:"description"`
+	IsPublished     bool               `json:"is_published"`
+	LockAfterSubmit bool               `json:"lock_after_submit"`
+	CreatedAt       int64              `json:"created_at"`
+	ClosedAt        *int64             `json:"closed_at"`
+	Version         int                `json:"version"`
+	Edges           QuestionnaireEdges `json:"edges"`
+	ETag            string             `json:"-"`
+}
+
+// QuestionnaireEdges holds the loaded relations of a questionnaire. Members
+// only holds the caller when they are a member.
+type QuestionnaireEdges struct {
+	Owner     *User      `json:"owner,omitempty"`
+	Members   []Member   `json:"members,omitempty"`
+	Questions []Question `json:"questions,omitempty"`
+	Sections  []Section  `json:"sections,omitempty"`
+}
+
+// User is the profile of a user. Members only see its ID and DisplayName.
+type User struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Username    string `json:"username,omitempty"`
+	DisplayName string `json:"display_name"`
+	CreatedAt   int64  `json:"created_at,omitempty"`
+}
+
+// Member is a member of a questionnaire. UniqueIdentifier and Edges are
+// only sent to the owner, and CompletedAt is nil until they submit.
+type Member struct {
+	ID               string      `json:"id"`
+	DisplayName      string      `json:"display_name"`
+	UniqueIdentifier string      `json:"unique_identifier,omitempty"`
+	CreatedAt        int64       `json:"created_at,omitempty"`
+	CompletedAt      *int64      `json:"completed_at"`
+	Edges            MemberEdges `json:"edges"`
+}
+
+// MemberEdges holds the loaded relations of a member
+type MemberEdges struct {
+	User          *User          `json:"user,omitempty"`
+	Questionnaire *Questionnaire `json:"questionnaire,omitempty"`
+	Answers       []Answer       `json:"answers,omitempty"`
+}
+
+// Question is a question. A member only gets their own answer under Edges.
+type Question struct {
+	ID        string        `json:"id"`
+	Text      string        `json:"text"`
+	Required  bool          `json:"required"`
+	Position  int           `json:"position"`
+	Version   int           `json:"version"`
+	CreatedAt int64         `json:"created_at"`
+	Edges     QuestionEdges `json:"edges"`
+}
+
+// QuestionEdges holds the loaded relations of a question
+type QuestionEdges struct {
+	Questionnaire *Questionnaire `json:"questionnaire,omitempty"`
+	Section       *Section       `json:"section,omitempty"`
+	Answers       []Answer       `json:"answers,omitempty"`
+	Rules         []Rule         `json:"rules,omitempty"`
+	Dependents    []Rule         `json:"dependents,omitempty"`
+}
+
+// Section is a section of a questionnaire
+type Section struct {
+	ID          string       `json:"id"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Position    int          `json:"position"`
+	CreatedAt   int64        `json:"created_at"`
+	Edges       SectionEdges `json:"edges"`
+}
+
+// SectionEdges holds the loaded relations of a section
+type SectionEdges struct {
+	Questionnaire *Questionnaire `json:"questionnaire,omitempty"`
+	Questions     []Question     `json:"questions,omitempty"`
+}
+
+// Answer is the answer of a member to a question
+type Answer struct {
+	ID          string      `json:"id"`
+	AnswerValue AnswerValue `json:"answer_value"`
+	CreatedAt   int64       `json:"created_at"`
+	UpdatedAt   int64       `json:"updated_at"`
+	Edges       AnswerEdges `json:"edges"`
+}
+
+// AnswerEdges holds the loaded relations of an answer
+type AnswerEdges struct {
+	Question *Question `json:"question,omitempty"`
+	Member   *Member   `json:"member,omitempty"`
+}
+
+// Rule shows its question to the members who answered the source question
+// with one of the answer values
+type Rule struct {
+	ID           string        `json:"id"`
+	AnswerValues []AnswerValue `json:"answer_values"`
+	CreatedAt    int64         `json:"created_at"`
+	Edges        RuleEdges     `json:"edges"`
+}
+
+// RuleEdges holds the loaded relations of a rule
+type RuleEdges struct {
+	Question *Question `json:"question,omitempty"`
+	Source   *Question `json:"source,omitempty"`
+}
+
+// AnswerValue is the value of an answer
+type AnswerValue string
+
+const (
+	Yes  AnswerValue = "Yes"
+	No   AnswerValue = "No"
+	Pass AnswerValue = "Pass"
+)
+
+// Page is a page of a listing. NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int    `json:"total"`
+	Limit      int    `json:"limit"`
+	Sort       string `json:"sort"`
+	Order      string `json:"order"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ListOptions selects a page of a listing. The zero value asks for the
+// first page in the default order of the route.
+type ListOptions struct {
+	Limit  int
+	Cursor string
+	Sort   string
+	Order  string
+	// Query is the case insensitive search of the routes supporting it
+	Query string
+}
+
+// Login is a session token
+type Login struct {
+	Token string `json:"token"`
+	Type  string `json:"type"`
+}
+
+// PublicQuestionnaire is what an invitation tells about its questionnaire
+type PublicQuestionnaire struct {
+	QuestionnaireID string `json:"questionnaire_id"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	IsPublished     bool   `json:"is_published"`
+	ClosedAt        *int64 `json:"closed_at"`
+}
+
+// Invitation is a token to join a questionnaire, valid for 24 hours
+type Invitation struct {
+	Token     string `json:"token"`
+	ExpiresIn string `json:"expires_in"`
+	JoinURL   string `json:"join_url"`
+}
+
+// JoinAction tells whether Join creates a member or logs an existing one in
+type JoinAction string
+
+const (
+	JoinRegister JoinAction = "register"
+	JoinLogin    JoinAction = "login"
+)
+
+// JoinRequest joins a questionnaire. Passcode is required to log in as an
+// anonymous member.
+type JoinRequest struct {
+	Action           JoinAction `json:"action"`
+	UniqueIdentifier string     `json:"unique_identifier"`
+	DisplayName      string     `json:"display_name,omitempty"`
+	Passcode         string     `json:"passcode,omitempty"`
+}
+
+// Membership is the outcome of Join. Passcode is only sent once, when an
+// anonymous member is created, and Token is empty when a user who already
+// is a member joins again.
+type Membership struct {
+	Token            string `json:"token"`
+	Type             string `json:"type"`
+	MemberID         string `json:"member_id"`
+	UniqueIdentifier string `json:"unique_identifier"`
+	Passcode         string `json:"passcode"`
+	AlreadyMember    bool   `json:"already_member"`
+}
+
+// NewUser registers a user
+type NewUser struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name,omitempty"`
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+}
+
+// NewQuestionnaire creates a questionnaire
+type NewQuestionnaire struct {
+	Title           string `json:"title"`
+	Description     string `json:"description,omitempty"`
+	LockAfterSubmit bool   `json:"lock_after_submit"`
+}
+
+// QuestionnaireUpdate replaces the editable fields of a questionnaire
+type QuestionnaireUpdate = NewQuestionnaire
+
+// NewSection creates or updates a section
+type NewSection struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
+
+// NewQuestion creates a question, appended unless Position is set
+type NewQuestion struct {
+	SectionID string `json:"section_id,omitempty"`
+	Text      string `json:"text"`
+	Position  *int   `json:"position,omitempty"`
+	Required  bool   `json:"required"`
+}
+
+// QuestionUpdate replaces the editable fields of a question
+type QuestionUpdate struct {
+	SectionID string `json:"section_id,omitempty"`
+	Text      string `json:"text"`
+	Required  bool   `json:"required"`
+}
+
+// CreatedQuestion is the question NewQuestion created
+type CreatedQuestion struct {
+	QuestionID string `json:"question_id"`
+	Position   int    `json:"position"`
+}
+
+// QuestionAnswer is one answer of AnswerAll
+type QuestionAnswer struct {
+	QuestionID  string      `json:"question_id"`
+	AnswerValue AnswerValue `json:"answer_value"`
+}
+
+// SavedAnswer is one answer once saved
+type SavedAnswer struct {
+	AnswerID    string      `json:"answer_id"`
+	QuestionID  string      `json:"question_id"`
+	AnswerValue AnswerValue `json:"answer_value"`
+}
+
+// SavedAnswers is the outcome of AnswerAll
+type SavedAnswers struct {
+	MemberID string        `json:"member_id"`
+	Answers  []SavedAnswer `json:"answers"`
+}
+
+// Submission is the outcome of Submit
+type Submission struct {
+	MemberID    string `json:"member_id"`
+	CompletedAt int64  `json:"completed_at"`
+}
+
+// Completion breaks down the progress of a questionnaire per section
+type Completion struct {
+	Sections  []SectionCompletion `json:"sections"`
+	Members   []MemberCompletion  `json:"members"`
+	Submitted int                 `json:"submitted"`
+}
+
+// SectionCompletion summarises a section over all the members. SectionID is
+// nil for the questions outside any section.
+type SectionCompletion struct {
+	SectionID        *string `json:"section_id"`
+	Title            string  `json:"title"`
+	Questions        int     `json:"questions"`
+	MembersCompleted int     `json:"members_completed"`
+}
+
+// MemberCompletion is the progress of one member in every section
+type MemberCompletion struct {
+	MemberID        string            `json:"member_id"`
+	DisplayName     string            `json:"display_name"`
+	CompletedAt     *int64            `json:"completed_at"`
+	MissingRequired int               `json:"missing_required"`
+	Sections        []SectionProgress `json:"sections"`
+}
+
+// SectionProgress counts the questions of a section a member answered
+type SectionProgress struct {
+	SectionID *string `json:"section_id"`
+	Answered  int     `json:"answered"`
+	Total     int     `json:"total"`
+	Complete  bool    `json:"complete"`
+}
+
+// Scopes of the personal access tokens
+const (
+	ScopeRead           = "read"
+	ScopeQuestionnaires = "questionnaires:write"
+	ScopeAnswers        = "answers:write"
+)
+
+// NewAccessToken creates a personal access token. ExpiresAt is in Unix
+// milliseconds, the token never expires when it is zero.
+type NewAccessToken struct {
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt int64    `json:"expires_at,omitempty"`
+}
+
+// AccessToken is a personal access token. Token is only set when it is
+// created.
+type AccessToken struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	CreatedAt  int64    `json:"created_at"`
+	ExpiresAt  *int64   `json:"expires_at"`
+	LastUsedAt *int64   `json:"last_used_at"`
+	Token      string   `json:"token,omitempty"`
+}
+
+// NewWebhook subscribes URL to events of a questionnaire: member.joined,
+// answers.submitted, questionnaire.published and questionnaire.closed
+type NewWebhook struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// Webhook is a webhook of a questionnaire. Secret, the key of the
+// signatures, is only set when it is created.
+type Webhook struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	CreatedAt int64    `json:"created_at"`
+	Secret    string   `json:"secret,omitempty"`
+}
+
+// WebhookDelivery is an event sent, or to send, to a webhook. Status is
+// pending, succeeded or failed; NextAttemptAt is only set while it is
+// pending.
+type WebhookDelivery struct {
+	ID             string          `json:"id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *int64          `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *int64          `json:"last_attempt_at"`
+	ResponseStatus *int            `json:"response_status"`
+	Error          string          `json:"error,omitempty"`
+	CreatedAt      int64           `json:"created_at"`
+	Payload        json.RawMessage `json:"payload"`
+}
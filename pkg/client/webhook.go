@@ -0,0 +1,26 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Headers of the requests a webhook receives. The signature is "sha256="
+// followed by the hex HMAC-SHA256 of the body keyed with the secret of the
+// webhook.
+const (
+	WebhookSignatureHeader = "X-Radgifa-Signature"
+	WebhookEventHeader     = "X-Radgifa-Event"
+	WebhookDeliveryHeader  = "X-Radgifa-Delivery"
+)
+
+// VerifyWebhookSignature tells whether signature, the value of the
+// WebhookSignatureHeader, signs body with secret. Check it on the raw body,
+// before decoding it.
+func VerifyWebhookSignature(secret string, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(want), []byte(signature))
+}
@@ -0,0 +1,90 @@
+// Package radgifa exposes the Radgifa API as an http.Handler that can be
+// mounted inside another Go service.
+//
+//	db, _ := sql.Open("pgx", dsn)
+//	service, _ := radgifa.NewService(db)
+//	kv, _ := radgifa.OpenKVManager("/var/lib/radgifa")
+//	handler, _ := radgifa.NewHandler(
+//		radgifa.WithService(service),
+//		radgifa.WithKVManager(kv),
+//		radgifa.WithLogger(logger),
+//		radgifa.WithSigningKeys([]byte(secret)),
+//		radgifa.WithRoutePrefix("/radgifa"),
+//	)
+//	mux.Handle("/radgifa/", handler)
+//
+// Webhook events are queued by the service; run a WebhookDispatcher to send
+// them:
+//
+//	go radgifa.NewWebhookDispatcher(service, logger, radgifa.WebhookOptions{}).Run(ctx)
+package radgifa
+
+import (
+	"database/sql"
+	"net/http"
+
+	"radgifa/internal/database"
+	"radgifa/internal/server"
+	"radgifa/internal/webhook"
+
+	"go.uber.org/zap"
+)
+
+type (
+	// Service is the data access layer used by the handlers
+	Service = database.Service
+	// KVManager is the key-value store used for invitation tokens. When it
+	// has Ping and Size methods, like the store OpenKVManager opens, /readyz
+	// checks it and /metrics reports its size.
+	KVManager = server.KVManager
+	// Option configures the handler built by NewHandler
+	Option = server.Option
+	// WebhookDispatcher sends the queued webhook deliveries until the
+	// context given to its Run method is cancelled
+	WebhookDispatcher = webhook.Dispatcher
+	// WebhookOptions tunes a WebhookDispatcher, the zero value takes the
+	// defaults
+	WebhookOptions = webhook.Options
+)
+
+var (
+	WithService           = server.WithService
+	WithKVManager         = server.WithKVManager
+	WithLogger            = server.WithLogger
+	WithSigningKeys       = server.WithSigningKeys
+	WithRoutePrefix       = server.WithRoutePrefix
+	WithRequestsPerSecond = server.WithRequestsPerSecond
+	WithIdempotencyTTL    = server.WithIdempotencyTTL
+	WithLegacySunset      = server.WithLegacySunset
+	WithDiskCheck         = server.WithDiskCheck
+	WithLogLevel          = server.WithLogLevel
+	WithAdminToken        = server.WithAdminToken
+	WithServiceName       = server.WithServiceName
+)
+
+// NewHandler builds the Radgifa API handler. WithService, WithKVManager and
+// WithSigningKeys are required.
+func NewHandler(opts ...Option) (http.Handler, error) {
+	s, err := server.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return s.Handler(), nil
+}
+
+// NewService builds a Service on an opened pgx (Postgres) pool, creating the
+// schema if needed. Closing the Service closes db.
+func NewService(db *sql.DB) (Service, error) {
+	return database.NewFromDB(db)
+}
+
+// OpenKVManager opens the Badger store kept under path
+func OpenKVManager(path string) (KVManager, error) {
+	return server.OpenKVManager(path)
+}
+
+// NewWebhookDispatcher builds the dispatcher of the webhook deliveries
+// queued by service. Several can run on the same database.
+func NewWebhookDispatcher(service Service, logger *zap.Logger, opts WebhookOptions) *WebhookDispatcher {
+	return webhook.New(service, logger, opts)
+}
@@ -0,0 +1,64 @@
+package radgifa
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"radgifa/ent"
+)
+
+type stubService struct {
+	Service
+}
+
+func (stubService) Client() *ent.Client {
+	return ent.NewClient()
+}
+
+func (stubService) Health() map[string]string {
+	return map[string]string{"status": "up"}
+}
+
+type stubKV struct {
+	KVManager
+}
+
+func TestNewHandlerRequiresDependencies(t *testing.T) {
+	if _, err := NewHandler(WithSigningKeys([]byte("secret"))); err == nil {
+		t.Fatal("expected an error when service and KV manager are missing")
+	}
+	if _, err := NewHandler(WithService(stubService{}), WithKVManager(stubKV{})); err == nil {
+		t.Fatal("expected an error when no signing key is given")
+	}
+	if _, err := NewHandler(WithService(stubService{}), WithKVManager(stubKV{}), WithSigningKeys([]byte{})); err == nil {
+		t.Fatal("expected an error for an empty signing key")
+	}
+}
+
+func TestNewHandlerMountsUnderPrefix(t *testing.T) {
+	handler, err := NewHandler(
+		WithService(stubService{}),
+		WithKVManager(stubKV{}),
+		WithSigningKeys([]byte("secret")),
+		WithRoutePrefix("/radgifa"),
+	)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/radgifa/", handler)
+
+	resp := httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/radgifa/health", nil))
+	if resp.Code != http.StatusOK {
+		t.Fatalf("GET /radgifa/health status = %d, want %d", resp.Code, http.StatusOK)
+	}
+
+	resp = httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/radgifa/api/questionnaires", nil))
+	if resp.Code != http.StatusUnauthorized {
+		t.Fatalf("GET /radgifa/api/questionnaires without token status = %d, want %d", resp.Code, http.StatusUnauthorized)
+	}
+}